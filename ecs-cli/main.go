@@ -14,6 +14,7 @@
 package main
 
 import (
+	"context"
 	"os"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/factory"
@@ -25,6 +26,7 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/license"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/log"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/regcreds"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/logger"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/version"
 	"github.com/sirupsen/logrus"
@@ -36,6 +38,14 @@ func main() {
 	// Setup logrus for amazon-ecr-credential-helper
 	logger.SetupLogger()
 
+	err := newApp().Run(os.Args)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+}
+
+// newApp returns the CLI app with all of its commands and global flags
+func newApp() *cli.App {
 	app := cli.NewApp()
 	app.Name = version.AppName
 	app.Usage = "Command line interface for Amazon ECS"
@@ -65,10 +75,17 @@ func main() {
 			Name:  flags.EndpointFlag,
 			Usage: "Use a custom endpoint with the ECS CLI",
 		},
+		cli.DurationFlag{
+			Name:  flags.GlobalTimeoutFlag,
+			Usage: "[Optional] Specifies, as a duration given before the command such as 10m or 1h30m, how long the command may run. Once it has passed, the command's waits on services, tasks and CloudFormation stacks, its ECS, CloudFormation and CloudWatch Logs calls, and logs --follow stop, printing the state they left behind, as Ctrl-C does during a wait. It is independent of the --timeout of 'compose service', given after the subcommand in minutes: whichever expires first ends the wait, and only the latter rolls back the deployment. Defaults to no timeout.",
+		},
 	}
 
-	err := app.Run(os.Args)
-	if err != nil {
-		logrus.Fatal(err)
+	// cancelled once the --timeout of the command expires, which is only known once the flags are parsed
+	app.Before = func(c *cli.Context) error {
+		utils.SetCommandContext(c.App, utils.WithCommandTimeout(context.Background(), c.GlobalDuration(flags.GlobalTimeoutFlag)))
+		return nil
 	}
+
+	return app
 }
@@ -0,0 +1,57 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func TestGlobalAndComposeServiceTimeoutFlags(t *testing.T) {
+	app := newApp()
+	up := findCommand(t, app.Commands, "compose", "service", "up")
+
+	called := false
+	up.Action = func(c *cli.Context) {
+		called = true
+		assert.Equal(t, 10*time.Minute, c.GlobalDuration(flags.GlobalTimeoutFlag))
+		assert.Equal(t, float64(5), c.Float64(flags.ComposeServiceTimeOutFlag))
+		_, hasDeadline := utils.CommandContext(c).Deadline()
+		assert.True(t, hasDeadline, "Expected the global timeout to set the deadline of the command")
+	}
+
+	err := app.Run([]string{"ecs-cli", "--timeout", "10m", "compose", "service", "up", "--timeout", "5"})
+	assert.NoError(t, err)
+	assert.True(t, called, "Expected compose service up to run")
+}
+
+// findCommand returns the command at the end of path, such as compose service up
+func findCommand(t *testing.T, commands []cli.Command, path ...string) *cli.Command {
+	for i := range commands {
+		if commands[i].Name != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			return &commands[i]
+		}
+		return findCommand(t, commands[i].Subcommands, path[1:]...)
+	}
+	t.Fatalf("Could not find command %s", path[0])
+	return nil
+}
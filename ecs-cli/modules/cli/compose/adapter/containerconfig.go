@@ -6,10 +6,14 @@ import "github.com/aws/aws-sdk-go/service/ecs"
 type ContainerConfig struct {
 	Name string
 
+	// Build is the build section of the compose service, nil if it has none
+	Build *BuildConfig
+
 	CapAdd                []string
 	CapDrop               []string
 	Command               []string
 	CPU                   int64
+	DependsOn             []ContainerDependency
 	Devices               []*ecs.Device
 	DNSSearchDomains      []string
 	DNSServers            []string
@@ -32,9 +36,45 @@ type ContainerConfig struct {
 	PseudoTerminal        bool
 	ReadOnly              bool
 	ShmSize               int64
+	StopTimeout           *int64
+	Sysctls               map[string]string
 	Tmpfs                 []*ecs.Tmpfs
 	Ulimits               []*ecs.Ulimit
 	VolumesFrom           []*ecs.VolumeFrom
 	User                  string
 	WorkingDirectory      string
 }
+
+// ContainerDependency is a depends_on entry of a compose service
+type ContainerDependency struct {
+	Name      string
+	Condition string
+}
+
+// BuildConfig is the build section of a compose service
+type BuildConfig struct {
+	// Context is the path of the build context directory
+	Context    string
+	Dockerfile string
+	Args       map[string]string
+}
+
+// ConvertToBuildConfig converts the build context, dockerfile and args of a
+// compose service, returning nil if it has no build context. Args without a
+// value are left out.
+func ConvertToBuildConfig(context, dockerfile string, args map[string]*string) *BuildConfig {
+	if context == "" {
+		return nil
+	}
+	build := &BuildConfig{
+		Context:    context,
+		Dockerfile: dockerfile,
+		Args:       make(map[string]string),
+	}
+	for name, value := range args {
+		if value != nil {
+			build.Args[name] = *value
+		}
+	}
+	return build
+}
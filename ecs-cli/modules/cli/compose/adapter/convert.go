@@ -45,6 +45,46 @@ const (
 	volumeFromContainerKey    = "container"
 )
 
+// Conditions of a depends_on entry in the long syntax
+const (
+	DependencyConditionStarted               = "service_started"
+	DependencyConditionHealthy               = "service_healthy"
+	DependencyConditionCompletedSuccessfully = "service_completed_successfully"
+	// DependencyConditionCompleted is not part of the compose file format; it
+	// is accepted so that the ECS COMPLETE container condition can be expressed
+	DependencyConditionCompleted = "service_completed"
+)
+
+// IsValidDependencyCondition returns true if the condition of a depends_on entry is supported
+func IsValidDependencyCondition(condition string) bool {
+	switch condition {
+	case DependencyConditionStarted, DependencyConditionHealthy, DependencyConditionCompletedSuccessfully, DependencyConditionCompleted:
+		return true
+	}
+	return false
+}
+
+// ConvertToContainerDependencies transforms the depends_on service names into
+// ContainerDependencies, using the condition recorded for the service if the
+// long syntax was used and service_started otherwise
+func ConvertToContainerDependencies(dependsOn []string, conditions map[string]string) []ContainerDependency {
+	if len(dependsOn) == 0 {
+		return nil
+	}
+	dependencies := []ContainerDependency{}
+	for _, name := range dependsOn {
+		condition := DependencyConditionStarted
+		if c, ok := conditions[name]; ok {
+			condition = c
+		}
+		dependencies = append(dependencies, ContainerDependency{
+			Name:      name,
+			Condition: condition,
+		})
+	}
+	return dependencies
+}
+
 // ConvertToDevices transforms a slice of device strings into a slice of ECS Device structs
 func ConvertToDevices(cfgDevices []string) ([]*ecs.Device, error) {
 	devices := []*ecs.Device{}
@@ -222,6 +262,18 @@ func ConvertToTimeInSeconds(d *time.Duration) *int64 {
 	return &val
 }
 
+// ConvertToStopTimeout transforms a stop_grace_period duration string (e.g. 1m30s) into seconds
+func ConvertToStopTimeout(stopGracePeriod string) (*int64, error) {
+	if stopGracePeriod == "" {
+		return nil, nil
+	}
+	duration, err := time.ParseDuration(stopGracePeriod)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid stop_grace_period '%s'", stopGracePeriod)
+	}
+	return ConvertToTimeInSeconds(&duration), nil
+}
+
 // ConvertToMountPoints transforms the yml volumes slice to ecs compatible MountPoints slice
 // It also uses the hostPath from volumes if present, else adds one to it
 func ConvertToMountPoints(cfgVolumes *yaml.Volumes, volumes *Volumes) ([]*ecs.MountPoint, error) {
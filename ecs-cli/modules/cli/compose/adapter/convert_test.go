@@ -685,3 +685,33 @@ func TestConvertToHealthCheck(t *testing.T) {
 	assert.Equal(t, aws.Int64(3), output.Retries)
 	assert.Equal(t, aws.Int64(120), output.StartPeriod)
 }
+
+func TestConvertToContainerDependencies(t *testing.T) {
+	conditions := map[string]string{
+		"db": DependencyConditionHealthy,
+	}
+	expected := []ContainerDependency{
+		{Name: "db", Condition: DependencyConditionHealthy},
+		{Name: "cache", Condition: DependencyConditionStarted},
+	}
+
+	actual := ConvertToContainerDependencies([]string{"db", "cache"}, conditions)
+	assert.Equal(t, expected, actual)
+}
+
+func TestConvertToContainerDependencies_Empty(t *testing.T) {
+	assert.Nil(t, ConvertToContainerDependencies(nil, nil))
+}
+
+func TestConvertToStopTimeout(t *testing.T) {
+	stopTimeout, err := ConvertToStopTimeout("1m30s")
+	assert.NoError(t, err, "Unexpected error converting stop_grace_period")
+	assert.Equal(t, int64(90), aws.Int64Value(stopTimeout))
+
+	stopTimeout, err = ConvertToStopTimeout("")
+	assert.NoError(t, err, "Unexpected error converting empty stop_grace_period")
+	assert.Nil(t, stopTimeout)
+
+	_, err = ConvertToStopTimeout("90")
+	assert.Error(t, err, "Expected error converting stop_grace_period without a unit")
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/container"
 	composeFactory "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/factory"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/service"
 	ecscompose "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/project"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/flynn/go-shlex"
@@ -56,6 +57,20 @@ func WithProject(factory composeFactory.ProjectFactory, action ProjectAction, is
 	}
 }
 
+// WithOfflineProject is an helper function to create a cli.Command action with
+// a ProjectFactory, for commands that do not make any calls to AWS.
+func WithOfflineProject(factory composeFactory.ProjectFactory, action ProjectAction) func(context *cli.Context) {
+	return func(context *cli.Context) {
+		p, err := factory.CreateOffline(context)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+			}).Fatal("Unable to create and read ECS Compose Project")
+		}
+		action(p, context)
+	}
+}
+
 // ProjectCreate creates the task definition required for the containers but does not start them.
 func ProjectCreate(p ecscompose.Project, c *cli.Context) {
 	err := p.Create()
@@ -80,6 +95,15 @@ func ProjectUp(p ecscompose.Project, c *cli.Context) {
 	}
 }
 
+// ProjectConvert prints the task definition and run parameters of the project without registering them.
+func ProjectConvert(p ecscompose.Project, c *cli.Context) {
+	output, err := ConvertProject(p, c.String(flags.OutputFormatFlag))
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Stdout.Write(output)
+}
+
 // ProjectPs lists the containers.
 func ProjectPs(p ecscompose.Project, c *cli.Context) {
 	allInfo, err := p.Info(c.String(flags.DesiredTaskStatus))
@@ -108,6 +132,11 @@ func ProjectRun(p ecscompose.Project, c *cli.Context) {
 		commandOverrides[args[i]] = parts
 	}
 	err := p.Run(commandOverrides)
+	// with --attach, exit with the exit code of the essential container of the task
+	if exitErr, ok := err.(cli.ExitCoder); ok {
+		log.Error(err)
+		os.Exit(exitErr.ExitCode())
+	}
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -143,3 +172,44 @@ func ProjectDown(p ecscompose.Project, c *cli.Context) {
 		log.Fatal(err)
 	}
 }
+
+// ProjectAbort scales the task sets of a deployment in progress to zero.
+func ProjectAbort(p ecscompose.Project, c *cli.Context) {
+	err := p.Abort()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// ProjectDiff prints the differences between the deployed ECS Services and the local project.
+// It exits with DiffExitCodeDifferences if there are any.
+func ProjectDiff(p ecscompose.Project, c *cli.Context) {
+	diffs, err := p.Diff()
+	if err != nil {
+		log.Fatal(err)
+	}
+	output, exitCode := FormatDiff(diffs)
+	os.Stdout.WriteString(output)
+	os.Exit(exitCode)
+}
+
+// ProjectRevisions lists the task definition revisions of the services.
+func ProjectRevisions(p ecscompose.Project, c *cli.Context) {
+	revisions, err := p.Revisions()
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Stdout.WriteString(revisions.String(service.RevisionsInfoColumns, displayTitle))
+}
+
+// ProjectRollback updates the services to a previous revision of their task definition.
+func ProjectRollback(p ecscompose.Project, c *cli.Context) {
+	revision := c.Int64(flags.ToRevisionFlag)
+	if revision < 0 {
+		log.Fatalf("Please pass a positive revision for --%s", flags.ToRevisionFlag)
+	}
+	err := p.Rollback(revision)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -79,3 +79,26 @@ func TestScale(t *testing.T) {
 
 	ProjectScale(mockProject, cliContext)
 }
+
+func TestWithOfflineProject(t *testing.T) {
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalContext := cli.NewContext(nil, globalSet, nil)
+	cliContext := cli.NewContext(nil, nil, globalContext)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProjectFactory := mock_factory.NewMockProjectFactory(ctrl)
+	mockProjectFactory.EXPECT().CreateOffline(gomock.Any()).Return(nil, nil)
+
+	testFuncVisited := false
+	testFunc := func(project ecscompose.Project, c *cli.Context) {
+		testFuncVisited = true
+	}
+
+	function := WithOfflineProject(mockProjectFactory, testFunc)
+	function(cliContext)
+
+	if !testFuncVisited {
+		t.Error("Expected test function to be visited but wasn't")
+	}
+}
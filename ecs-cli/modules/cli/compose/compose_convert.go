@@ -0,0 +1,148 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package compose
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	ecscompose "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/project"
+	utils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/docker/cli/cli/compose/loader"
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	// ConvertOutputFormatJSON prints the converted project as JSON
+	ConvertOutputFormatJSON = "json"
+	// ConvertOutputFormatYAML prints the converted project as YAML
+	ConvertOutputFormatYAML = "yaml"
+)
+
+// convertOutput is the document printed by compose convert. The task
+// definition is in the same format as the input of
+// `aws ecs register-task-definition --cli-input-json`, and the run parameters
+// are the fields of RunTask that are derived from the ecs-params file
+type convertOutput struct {
+	TaskDefinition   json.RawMessage        `json:"taskDefinition"`
+	RunParams        json.RawMessage        `json:"runParams,omitempty"`
+	ServiceDiscovery map[string]interface{} `json:"serviceDiscovery,omitempty"`
+}
+
+// ConvertProject returns the RegisterTaskDefinitionInput and run parameters
+// that would be used for the parsed project, formatted as JSON or YAML.
+func ConvertProject(p ecscompose.Project, format string) ([]byte, error) {
+	if format != ConvertOutputFormatJSON && format != ConvertOutputFormatYAML {
+		return nil, fmt.Errorf("output format must be either %s or %s, found '%s'", ConvertOutputFormatJSON, ConvertOutputFormatYAML, format)
+	}
+
+	ecsContext := p.Context()
+	ecsParams := ecsContext.ECSParams
+	if err := entity.ValidateFargateParams(ecsParams, ecsContext.CommandConfig.LaunchType); err != nil {
+		return nil, err
+	}
+	platformVersion, err := entity.GetPlatformVersion(p.Entity())
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := p.Entity().GetTags()
+	if err != nil {
+		return nil, err
+	}
+	request := entity.CreateRegisterTaskDefinitionRequest(p.Entity().TaskDefinition(), tags)
+	sorted := adapter.SortedContainerDefinitionsByName(request)
+
+	output := convertOutput{}
+	if output.TaskDefinition, err = jsonutil.BuildJSON(&sorted); err != nil {
+		return nil, errors.Wrap(err, "unable to serialize the task definition")
+	}
+	if output.RunParams, err = convertToRunParams(ecsParams, platformVersion); err != nil {
+		return nil, err
+	}
+	if ecsParams != nil {
+		if output.ServiceDiscovery, err = convertServiceDiscovery(ecsParams.RunParams.ServiceDiscovery); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if format == ConvertOutputFormatJSON {
+		return append(data, '\n'), nil
+	}
+
+	// JSON is valid YAML; unmarshalling into a MapSlice keeps the key order
+	document := yaml.MapSlice{}
+	if err = yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(document)
+}
+
+// convertToRunParams returns the network configuration, task placement and
+// platform version that would be passed to RunTask, or nil if none are set
+func convertToRunParams(ecsParams *utils.ECSParams, platformVersion string) (json.RawMessage, error) {
+	networkConfig, err := utils.ConvertToECSNetworkConfiguration(ecsParams)
+	if err != nil {
+		return nil, err
+	}
+	constraints, err := utils.ConvertToECSPlacementConstraints(ecsParams)
+	if err != nil {
+		return nil, err
+	}
+	strategy, err := utils.ConvertToECSPlacementStrategy(ecsParams)
+	if err != nil {
+		return nil, err
+	}
+	if networkConfig == nil && len(constraints) == 0 && len(strategy) == 0 && platformVersion == "" {
+		return nil, nil
+	}
+
+	runParams := &ecs.RunTaskInput{
+		NetworkConfiguration: networkConfig,
+		PlacementConstraints: constraints,
+		PlacementStrategy:    strategy,
+	}
+	if platformVersion != "" {
+		runParams.PlatformVersion = aws.String(platformVersion)
+	}
+	return jsonutil.BuildJSON(runParams)
+}
+
+// convertServiceDiscovery returns the service_discovery fields that are set in
+// the ecs-params file, keyed as they are written in the file. Fields that are
+// not set are omitted when the ecs-params types are marshalled.
+func convertServiceDiscovery(serviceDiscovery utils.ServiceDiscovery) (map[string]interface{}, error) {
+	data, err := yaml.Marshal(serviceDiscovery)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := loader.ParseYAML(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
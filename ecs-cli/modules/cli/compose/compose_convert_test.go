@@ -0,0 +1,205 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package compose
+
+import (
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/project/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	utils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func setupConvertProject(ctrl *gomock.Controller, launchType string, ecsParams *utils.ECSParams) *mock_project.MockProject {
+	taskDefinition := &ecs.TaskDefinition{
+		Family: aws.String("web-app"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{Name: aws.String("web"), Image: aws.String("nginx")},
+			{Name: aws.String("db"), Image: aws.String("redis")},
+		},
+		NetworkMode: aws.String("awsvpc"),
+	}
+	ecsContext := &context.ECSContext{
+		CommandConfig: &config.CommandConfig{LaunchType: launchType},
+		CLIContext:    cli.NewContext(nil, flag.NewFlagSet("ecs-cli", 0), nil),
+		ECSParams:     ecsParams,
+	}
+
+	mockEntity := mock_entity.NewMockProjectEntity(ctrl)
+	mockEntity.EXPECT().Context().Return(ecsContext).AnyTimes()
+	mockEntity.EXPECT().TaskDefinition().Return(taskDefinition).AnyTimes()
+	mockEntity.EXPECT().GetTags().Return([]*ecs.Tag{{Key: aws.String("team"), Value: aws.String("web")}}, nil).AnyTimes()
+
+	mockProject := mock_project.NewMockProject(ctrl)
+	mockProject.EXPECT().Context().Return(ecsContext).AnyTimes()
+	mockProject.EXPECT().Entity().Return(mockEntity).AnyTimes()
+	return mockProject
+}
+
+func TestConvertProject_JSON(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProject := setupConvertProject(ctrl, config.LaunchTypeEC2, nil)
+
+	output, err := ConvertProject(mockProject, ConvertOutputFormatJSON)
+	assert.NoError(t, err, "Unexpected error converting project")
+
+	var actual struct {
+		TaskDefinition struct {
+			Family               string `json:"family"`
+			NetworkMode          string `json:"networkMode"`
+			ContainerDefinitions []struct {
+				Name string `json:"name"`
+			} `json:"containerDefinitions"`
+			Tags []struct {
+				Key   string `json:"key"`
+				Value string `json:"value"`
+			} `json:"tags"`
+		} `json:"taskDefinition"`
+		RunParams        map[string]interface{} `json:"runParams"`
+		ServiceDiscovery map[string]interface{} `json:"serviceDiscovery"`
+	}
+	err = json.Unmarshal(output, &actual)
+	assert.NoError(t, err, "Expected output to be valid JSON")
+
+	assert.Equal(t, "web-app", actual.TaskDefinition.Family, "Expected family to match")
+	assert.Equal(t, "awsvpc", actual.TaskDefinition.NetworkMode, "Expected network mode to match")
+	if assert.Len(t, actual.TaskDefinition.ContainerDefinitions, 2) {
+		assert.Equal(t, "db", actual.TaskDefinition.ContainerDefinitions[0].Name, "Expected container definitions to be sorted by name")
+		assert.Equal(t, "web", actual.TaskDefinition.ContainerDefinitions[1].Name, "Expected container definitions to be sorted by name")
+	}
+	if assert.Len(t, actual.TaskDefinition.Tags, 1) {
+		assert.Equal(t, "team", actual.TaskDefinition.Tags[0].Key, "Expected tag key to match")
+		assert.Equal(t, "web", actual.TaskDefinition.Tags[0].Value, "Expected tag value to match")
+	}
+	assert.Nil(t, actual.RunParams, "Expected no run params without an ecs-params file")
+	assert.Nil(t, actual.ServiceDiscovery, "Expected no service discovery without an ecs-params file")
+}
+
+func TestConvertProject_YAMLWithRunParams(t *testing.T) {
+	ecsParams := &utils.ECSParams{
+		TaskDefinition: utils.EcsTaskDef{NetworkMode: "awsvpc"},
+		RunParams: utils.RunParams{
+			NetworkConfiguration: utils.NetworkConfiguration{
+				AwsVpcConfiguration: utils.AwsVpcConfiguration{
+					Subnets:        []string{"subnet-feedface"},
+					AssignPublicIp: utils.AssignPublicIp(ecs.AssignPublicIpEnabled),
+				},
+			},
+			TaskPlacement: utils.TaskPlacement{
+				Strategies: []utils.Strategy{{Type: ecs.PlacementStrategyTypeSpread, Field: "attribute:ecs.availability-zone"}},
+			},
+			ServiceDiscovery: utils.ServiceDiscovery{
+				ContainerName: "web",
+				PrivateDNSNamespace: utils.PrivateDNSNamespace{
+					VPC:       "vpc-8BAADF00D",
+					Namespace: utils.Namespace{Name: "corp"},
+				},
+			},
+			PlatformVersion: "1.3.0",
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProject := setupConvertProject(ctrl, config.LaunchTypeFargate, ecsParams)
+
+	output, err := ConvertProject(mockProject, ConvertOutputFormatYAML)
+	assert.NoError(t, err, "Unexpected error converting project")
+
+	document := yaml.MapSlice{}
+	err = yaml.Unmarshal(output, &document)
+	assert.NoError(t, err, "Expected output to be valid YAML")
+	if assert.Len(t, document, 3) {
+		assert.Equal(t, "taskDefinition", document[0].Key, "Expected task definition to be printed first")
+		assert.Equal(t, "runParams", document[1].Key, "Expected run params to be printed second")
+		assert.Equal(t, "serviceDiscovery", document[2].Key, "Expected service discovery to be printed last")
+	}
+
+	var actual struct {
+		RunParams struct {
+			NetworkConfiguration struct {
+				AwsvpcConfiguration struct {
+					Subnets        []string `yaml:"subnets"`
+					AssignPublicIp string   `yaml:"assignPublicIp"`
+				} `yaml:"awsvpcConfiguration"`
+			} `yaml:"networkConfiguration"`
+			PlacementStrategy []map[string]string `yaml:"placementStrategy"`
+			PlatformVersion   string              `yaml:"platformVersion"`
+		} `yaml:"runParams"`
+		ServiceDiscovery map[string]interface{} `yaml:"serviceDiscovery"`
+	}
+	err = yaml.Unmarshal(output, &actual)
+	assert.NoError(t, err, "Expected output to be valid YAML")
+	assert.Equal(t, []string{"subnet-feedface"}, actual.RunParams.NetworkConfiguration.AwsvpcConfiguration.Subnets, "Expected subnets to match")
+	assert.Equal(t, "ENABLED", actual.RunParams.NetworkConfiguration.AwsvpcConfiguration.AssignPublicIp, "Expected assign public ip to match")
+	assert.Equal(t, []map[string]string{{"type": "spread", "field": "attribute:ecs.availability-zone"}}, actual.RunParams.PlacementStrategy, "Expected placement strategy to match")
+	assert.Equal(t, "1.3.0", actual.RunParams.PlatformVersion, "Expected platform version to match")
+	assert.Equal(t, "web", actual.ServiceDiscovery["container_name"], "Expected container name to match")
+	assert.Equal(t, map[interface{}]interface{}{"vpc": "vpc-8BAADF00D", "name": "corp"}, actual.ServiceDiscovery["private_dns_namespace"], "Expected only the fields that are set to be printed")
+}
+
+func TestConvertProject_ErrorWithPlatformVersionAndEC2(t *testing.T) {
+	ecsParams := &utils.ECSParams{
+		RunParams: utils.RunParams{PlatformVersion: "1.3.0"},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProject := setupConvertProject(ctrl, config.LaunchTypeEC2, ecsParams)
+
+	_, err := ConvertProject(mockProject, ConvertOutputFormatJSON)
+	assert.Error(t, err, "Expected error with a platform version and launch type EC2")
+}
+
+func TestConvertProject_ErrorWithFargateAndNoECSParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProject := setupConvertProject(ctrl, config.LaunchTypeFargate, nil)
+
+	_, err := ConvertProject(mockProject, ConvertOutputFormatJSON)
+	assert.Error(t, err, "Expected error when Fargate network configuration is missing")
+}
+
+func TestConvertProject_ErrorWithNoSubnets(t *testing.T) {
+	ecsParams := &utils.ECSParams{
+		TaskDefinition: utils.EcsTaskDef{NetworkMode: "awsvpc"},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProject := setupConvertProject(ctrl, config.LaunchTypeEC2, ecsParams)
+
+	_, err := ConvertProject(mockProject, ConvertOutputFormatJSON)
+	assert.Error(t, err, "Expected error when awsvpc network configuration has no subnets")
+}
+
+func TestConvertProject_ErrorWithInvalidFormat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProject := setupConvertProject(ctrl, config.LaunchTypeEC2, nil)
+
+	_, err := ConvertProject(mockProject, "xml")
+	assert.Error(t, err, "Expected error with unsupported output format")
+}
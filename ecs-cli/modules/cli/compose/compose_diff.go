@@ -0,0 +1,63 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package compose
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/diff"
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// Exit codes of compose service diff. Errors exit with 1, like every other command.
+const (
+	DiffExitCodeNoDifferences = 0
+	DiffExitCodeDifferences   = 2
+)
+
+// FormatDiff returns the differences of each ECS Service of the project, one field per line,
+// and the exit code that tells whether there are any
+func FormatDiff(diffs []*diff.Service) (string, int) {
+	exitCode := DiffExitCodeNoDifferences
+	buffer := &bytes.Buffer{}
+	for _, serviceDiff := range diffs {
+		if serviceDiff.HasDifferences() {
+			exitCode = DiffExitCodeDifferences
+		}
+		switch {
+		case serviceDiff.Missing:
+			fmt.Fprintf(buffer, "Service %s: does not exist and would be created\n", serviceDiff.ServiceName)
+		case len(serviceDiff.Fields) == 0:
+			fmt.Fprintf(buffer, "Service %s: no differences\n", serviceDiff.ServiceName)
+		default:
+			fmt.Fprintf(buffer, "Service %s:\n", serviceDiff.ServiceName)
+			for _, field := range serviceDiff.Fields {
+				buffer.WriteString(formatField(field))
+			}
+		}
+	}
+	return buffer.String(), exitCode
+}
+
+// formatField prefixes fields that would be added with +, removed with - and changed with ~
+func formatField(field diff.Field) string {
+	switch {
+	case field.Deployed == nil:
+		return fmt.Sprintf("  + %s: %s\n", field.Field, aws.StringValue(field.Local))
+	case field.Local == nil:
+		return fmt.Sprintf("  - %s: %s\n", field.Field, aws.StringValue(field.Deployed))
+	}
+	return fmt.Sprintf("  ~ %s: %s -> %s\n", field.Field, aws.StringValue(field.Deployed), aws.StringValue(field.Local))
+}
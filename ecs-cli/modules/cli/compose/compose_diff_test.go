@@ -0,0 +1,52 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package compose
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/diff"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDiff(t *testing.T) {
+	output, exitCode := FormatDiff([]*diff.Service{
+		{
+			ServiceName: "web",
+			Fields: []diff.Field{
+				{Field: "containerDefinitions[web].image", Deployed: aws.String("nginx:1.15"), Local: aws.String("nginx:1.16")},
+				{Field: "containerDefinitions[web].environment.DEBUG", Local: aws.String("1")},
+				{Field: "containerDefinitions[cache]", Deployed: aws.String("redis")},
+			},
+		},
+		{ServiceName: "worker", Missing: true},
+		{ServiceName: "db"},
+	})
+
+	assert.Equal(t, DiffExitCodeDifferences, exitCode)
+	assert.Equal(t, "Service web:\n"+
+		"  ~ containerDefinitions[web].image: nginx:1.15 -> nginx:1.16\n"+
+		"  + containerDefinitions[web].environment.DEBUG: 1\n"+
+		"  - containerDefinitions[cache]: redis\n"+
+		"Service worker: does not exist and would be created\n"+
+		"Service db: no differences\n", output)
+}
+
+func TestFormatDiffWithoutDifferences(t *testing.T) {
+	output, exitCode := FormatDiff([]*diff.Service{{ServiceName: "web"}})
+
+	assert.Equal(t, DiffExitCodeNoDifferences, exitCode)
+	assert.Equal(t, "Service web: no differences\n", output)
+}
@@ -0,0 +1,132 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package compose
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	utils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	defaultComposeFileName   = "docker-compose.yml"
+	defaultECSParamsFileName = "ecs-params.yml"
+	importedFileMode         = 0644
+)
+
+// ImportTaskDefinition writes a compose file and an ecs-params file that are equivalent to an existing task definition
+func ImportTaskDefinition(c *cli.Context) {
+	rdwr, err := config.NewReadWriter()
+	if err != nil {
+		log.Fatal("Error executing 'compose import': ", err)
+	}
+	commandConfig, err := config.NewCommandConfig(c, rdwr)
+	if err != nil {
+		log.Fatal("Error executing 'compose import': ", err)
+	}
+
+	ecsClient := ecsclient.NewECSClient(commandConfig)
+	if err := importTaskDefinition(c, ecsClient); err != nil {
+		log.Fatal("Error executing 'compose import': ", err)
+	}
+}
+
+func importTaskDefinition(c *cli.Context, ecsClient ecsclient.ECSClient) error {
+	taskDefName := c.String(flags.TaskDefinitionFlag)
+	if taskDefName == "" {
+		return fmt.Errorf("--%s is required", flags.TaskDefinitionFlag)
+	}
+
+	composeFileName := defaultComposeFileName
+	if composeFiles := c.GlobalStringSlice(flags.ComposeFileNameFlag); len(composeFiles) > 1 {
+		return fmt.Errorf("only one compose file can be written, found %d", len(composeFiles))
+	} else if len(composeFiles) == 1 {
+		composeFileName = composeFiles[0]
+	}
+	ecsParamsFileName := defaultECSParamsFileName
+	if ecsParamsFiles := c.GlobalStringSlice(flags.ECSParamsFileNameFlag); len(ecsParamsFiles) > 1 {
+		return fmt.Errorf("only one ecs-params file can be written, found %d", len(ecsParamsFiles))
+	} else if len(ecsParamsFiles) == 1 {
+		ecsParamsFileName = ecsParamsFiles[0]
+	}
+
+	if !c.Bool(flags.ForceFlag) {
+		for _, fileName := range []string{composeFileName, ecsParamsFileName} {
+			if _, err := os.Stat(fileName); err == nil {
+				return fmt.Errorf("%s already exists; use --%s to overwrite it", fileName, flags.ForceFlag)
+			}
+		}
+	}
+
+	taskDefinition, err := ecsClient.DescribeTaskDefinition(taskDefName)
+	if err != nil {
+		return err
+	}
+
+	composeFile, ecsParams, err := utils.ConvertFromTaskDefinition(taskDefinition)
+	if err != nil {
+		return err
+	}
+
+	composeData, err := yaml.Marshal(composeFile)
+	if err != nil {
+		return err
+	}
+	ecsParamsData, err := yaml.Marshal(ecsParams)
+	if err != nil {
+		return err
+	}
+	// Environment variables are interpolated in ecs-params files, so a literal
+	// $ in the task definition has to be escaped. The compose file values are
+	// escaped by ConvertFromTaskDefinition.
+	ecsParamsData = bytes.Replace(ecsParamsData, []byte("$"), []byte("$$"), -1)
+
+	if err = writeImportedFile(composeFileName, composeData); err != nil {
+		return err
+	}
+	if err = writeImportedFile(ecsParamsFileName, ecsParamsData); err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"TaskDefinition": aws.StringValue(taskDefinition.TaskDefinitionArn),
+		"ComposeFile":    composeFileName,
+		"ECSParamsFile":  ecsParamsFileName,
+	}).Info("Imported task definition")
+	log.Infof("Use --%s %s to register new revisions in the same family", flags.ProjectNameFlag, aws.StringValue(taskDefinition.Family))
+	for _, compatibility := range taskDefinition.RequiresCompatibilities {
+		if aws.StringValue(compatibility) == config.LaunchTypeFargate {
+			log.Infof("Use --%s %s to register the task definition for Fargate", flags.LaunchTypeFlag, config.LaunchTypeFargate)
+		}
+	}
+	return nil
+}
+
+func writeImportedFile(fileName string, data []byte) error {
+	if err := ioutil.WriteFile(fileName, data, importedFileMode); err != nil {
+		return errors.Wrapf(err, "unable to write %s", fileName)
+	}
+	return nil
+}
@@ -0,0 +1,289 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package compose
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	composeFactory "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/factory"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func setupImportContext(t *testing.T, composeFileName, ecsParamsFileName string, force bool) *cli.Context {
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	composeFiles := &cli.StringSlice{}
+	composeFiles.Set(composeFileName)
+	globalSet.Var(composeFiles, flags.ComposeFileNameFlag, "")
+	ecsParamsFiles := &cli.StringSlice{}
+	ecsParamsFiles.Set(ecsParamsFileName)
+	globalSet.Var(ecsParamsFiles, flags.ECSParamsFileNameFlag, "")
+	globalSet.String(flags.ProjectNameFlag, "web-app", "")
+	globalContext := cli.NewContext(nil, globalSet, nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-import", 0)
+	flagSet.String(flags.TaskDefinitionFlag, "web-app:3", "")
+	flagSet.Bool(flags.ForceFlag, force, "")
+	return cli.NewContext(nil, flagSet, globalContext)
+}
+
+func setupImportDir(t *testing.T) (string, func()) {
+	tempDirName, err := ioutil.TempDir("", "test")
+	if err != nil {
+		t.Fatal("Error while creating the temporary directory")
+	}
+	// No region or credentials are needed to read the imported files
+	home := os.Getenv("HOME")
+	os.Setenv("HOME", tempDirName)
+	return tempDirName, func() {
+		os.Setenv("HOME", home)
+		os.RemoveAll(tempDirName)
+	}
+}
+
+func importedTaskDefinition() *ecs.TaskDefinition {
+	return &ecs.TaskDefinition{
+		Family:            aws.String("web-app"),
+		Revision:          aws.Int64(3),
+		TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/web-app:3"),
+		NetworkMode:       aws.String(ecs.NetworkModeBridge),
+		TaskRoleArn:       aws.String("arn:aws:iam::123456789012:role/task"),
+		ExecutionRoleArn:  aws.String("arn:aws:iam::123456789012:role/execution"),
+		Volumes: []*ecs.Volume{
+			{Name: aws.String("logs"), Host: &ecs.HostVolumeProperties{SourcePath: aws.String("/var/log/web")}},
+			{
+				Name: aws.String("data"),
+				DockerVolumeConfiguration: &ecs.DockerVolumeConfiguration{
+					Scope:         aws.String(ecs.ScopeShared),
+					Autoprovision: aws.Bool(true),
+					Driver:        aws.String("local"),
+				},
+			},
+		},
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:       aws.String("web"),
+				Image:      aws.String("nginx:1.15"),
+				Command:    aws.StringSlice([]string{"sh", "-c", "echo $HOSTNAME && nginx"}),
+				EntryPoint: aws.StringSlice([]string{"/docker-entrypoint.sh"}),
+				Environment: []*ecs.KeyValuePair{
+					{Name: aws.String("GREETING"), Value: aws.String("hello ${USER}")},
+					{Name: aws.String("EMPTY"), Value: aws.String("")},
+				},
+				Essential:         aws.Bool(true),
+				Cpu:               aws.Int64(256),
+				Memory:            aws.Int64(512),
+				MemoryReservation: aws.Int64(256),
+				PortMappings: []*ecs.PortMapping{
+					{ContainerPort: aws.Int64(80), HostPort: aws.Int64(8080), Protocol: aws.String(ecs.TransportProtocolTcp)},
+					{ContainerPort: aws.Int64(53), HostPort: aws.Int64(0), Protocol: aws.String(ecs.TransportProtocolUdp)},
+				},
+				MountPoints: []*ecs.MountPoint{
+					{SourceVolume: aws.String("logs"), ContainerPath: aws.String("/var/log/nginx"), ReadOnly: aws.Bool(false)},
+					{SourceVolume: aws.String("data"), ContainerPath: aws.String("/data"), ReadOnly: aws.Bool(true)},
+				},
+				DependsOn: []*ecs.ContainerDependency{
+					{ContainerName: aws.String("migrate"), Condition: aws.String(ecs.ContainerConditionSuccess)},
+				},
+				DockerLabels: map[string]*string{"team": aws.String("web")},
+				LogConfiguration: &ecs.LogConfiguration{
+					LogDriver: aws.String(ecs.LogDriverAwslogs),
+					Options:   map[string]*string{"awslogs-group": aws.String("web"), "awslogs-region": aws.String("us-east-1")},
+				},
+				HealthCheck: &ecs.HealthCheck{
+					Command:  aws.StringSlice([]string{"CMD-SHELL", "curl -f http://localhost:$PORT/"}),
+					Interval: aws.Int64(30),
+					Timeout:  aws.Int64(5),
+					Retries:  aws.Int64(3),
+				},
+				Secrets: []*ecs.Secret{
+					{Name: aws.String("API_KEY"), ValueFrom: aws.String("arn:aws:ssm:us-east-1:123456789012:parameter/api-key")},
+				},
+				StopTimeout:    aws.Int64(30),
+				SystemControls: []*ecs.SystemControl{{Namespace: aws.String("net.core.somaxconn"), Value: aws.String("1024")}},
+				Ulimits:        []*ecs.Ulimit{{Name: aws.String("nofile"), SoftLimit: aws.Int64(1024), HardLimit: aws.Int64(4096)}},
+				LinuxParameters: &ecs.LinuxParameters{
+					Capabilities: &ecs.KernelCapabilities{Add: aws.StringSlice([]string{"NET_ADMIN"})},
+					Tmpfs:        []*ecs.Tmpfs{{ContainerPath: aws.String("/run"), Size: aws.Int64(64), MountOptions: aws.StringSlice([]string{"rw"})}},
+				},
+			},
+			{
+				Name:      aws.String("migrate"),
+				Image:     aws.String("migrate:latest"),
+				Essential: aws.Bool(false),
+				Memory:    aws.Int64(128),
+				RepositoryCredentials: &ecs.RepositoryCredentials{
+					CredentialsParameter: aws.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:creds"),
+				},
+				LinuxParameters: &ecs.LinuxParameters{InitProcessEnabled: aws.Bool(true)},
+			},
+		},
+	}
+}
+
+func TestImportTaskDefinitionRoundTrip(t *testing.T) {
+	tempDirName, cleanup := setupImportDir(t)
+	defer cleanup()
+	composeFileName := filepath.Join(tempDirName, "docker-compose.yml")
+	ecsParamsFileName := filepath.Join(tempDirName, "ecs-params.yml")
+
+	expected := importedTaskDefinition()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockECS.EXPECT().DescribeTaskDefinition("web-app:3").Return(expected, nil)
+
+	cliContext := setupImportContext(t, composeFileName, ecsParamsFileName, false)
+	err := importTaskDefinition(cliContext, mockECS)
+	assert.NoError(t, err, "Unexpected error importing task definition")
+
+	project, err := composeFactory.NewProjectFactory().CreateOffline(cliContext)
+	if !assert.NoError(t, err, "Unexpected error reading the imported files") {
+		return
+	}
+	actual := project.Entity().TaskDefinition()
+
+	assert.Equal(t, aws.StringValue(expected.Family), aws.StringValue(actual.Family), "Expected family to match")
+	assert.Equal(t, aws.StringValue(expected.NetworkMode), aws.StringValue(actual.NetworkMode), "Expected network mode to match")
+	assert.Equal(t, aws.StringValue(expected.TaskRoleArn), aws.StringValue(actual.TaskRoleArn), "Expected task role to match")
+	assert.Equal(t, aws.StringValue(expected.ExecutionRoleArn), aws.StringValue(actual.ExecutionRoleArn), "Expected execution role to match")
+
+	actualVolumes := make(map[string]*ecs.Volume)
+	for _, volume := range actual.Volumes {
+		actualVolumes[aws.StringValue(volume.Name)] = volume
+	}
+	if assert.Len(t, actualVolumes, 2, "Expected two volumes") {
+		assert.Equal(t, expected.Volumes[1], actualVolumes["data"], "Expected docker volume to match")
+	}
+
+	actualContainers := make(map[string]*ecs.ContainerDefinition)
+	for _, containerDef := range actual.ContainerDefinitions {
+		actualContainers[aws.StringValue(containerDef.Name)] = containerDef
+	}
+	for _, expectedDef := range expected.ContainerDefinitions {
+		name := aws.StringValue(expectedDef.Name)
+		actualDef, ok := actualContainers[name]
+		if !assert.True(t, ok, "Expected container %s", name) {
+			continue
+		}
+		assert.Equal(t, expectedDef.Image, actualDef.Image, "Expected image of %s to match", name)
+		assert.Equal(t, aws.StringValueSlice(expectedDef.Command), aws.StringValueSlice(actualDef.Command), "Expected command of %s to match", name)
+		assert.Equal(t, aws.StringValueSlice(expectedDef.EntryPoint), aws.StringValueSlice(actualDef.EntryPoint), "Expected entrypoint of %s to match", name)
+		assert.Equal(t, sortedEnvironment(expectedDef.Environment), sortedEnvironment(actualDef.Environment), "Expected environment of %s to match", name)
+		assert.Equal(t, expectedDef.Essential, actualDef.Essential, "Expected essential of %s to match", name)
+		assert.Equal(t, aws.Int64Value(expectedDef.Cpu), aws.Int64Value(actualDef.Cpu), "Expected cpu of %s to match", name)
+		assert.Equal(t, expectedDef.Memory, actualDef.Memory, "Expected memory of %s to match", name)
+		assert.Equal(t, expectedDef.MemoryReservation, actualDef.MemoryReservation, "Expected memory reservation of %s to match", name)
+		assert.ElementsMatch(t, expectedDef.PortMappings, actualDef.PortMappings, "Expected port mappings of %s to match", name)
+		assert.Equal(t, expectedDef.DependsOn, actualDef.DependsOn, "Expected dependencies of %s to match", name)
+		assert.Equal(t, aws.StringValueMap(expectedDef.DockerLabels), aws.StringValueMap(actualDef.DockerLabels), "Expected labels of %s to match", name)
+		assert.Equal(t, expectedDef.LogConfiguration, actualDef.LogConfiguration, "Expected log configuration of %s to match", name)
+		assert.Equal(t, expectedDef.HealthCheck, actualDef.HealthCheck, "Expected healthcheck of %s to match", name)
+		assert.Equal(t, expectedDef.Secrets, actualDef.Secrets, "Expected secrets of %s to match", name)
+		assert.Equal(t, expectedDef.RepositoryCredentials, actualDef.RepositoryCredentials, "Expected repository credentials of %s to match", name)
+		assert.Equal(t, expectedDef.StopTimeout, actualDef.StopTimeout, "Expected stop timeout of %s to match", name)
+		assert.Equal(t, expectedDef.SystemControls, actualDef.SystemControls, "Expected system controls of %s to match", name)
+		assert.Equal(t, expectedDef.Ulimits, actualDef.Ulimits, "Expected ulimits of %s to match", name)
+		assert.Equal(t, mountedPaths(expected, expectedDef), mountedPaths(actual, actualDef), "Expected mount points of %s to match", name)
+
+		expectedParams := expectedDef.LinuxParameters
+		actualParams := actualDef.LinuxParameters
+		if expectedParams.Capabilities != nil {
+			assert.Equal(t, expectedParams.Capabilities.Add, actualParams.Capabilities.Add, "Expected added capabilities of %s to match", name)
+		}
+		assert.Equal(t, expectedParams.InitProcessEnabled, actualParams.InitProcessEnabled, "Expected init process of %s to match", name)
+		assert.Equal(t, expectedParams.Tmpfs, actualParams.Tmpfs, "Expected tmpfs of %s to match", name)
+	}
+}
+
+func TestImportTaskDefinition_ErrorWhenFileExists(t *testing.T) {
+	tempDirName, cleanup := setupImportDir(t)
+	defer cleanup()
+	composeFileName := filepath.Join(tempDirName, "docker-compose.yml")
+	ecsParamsFileName := filepath.Join(tempDirName, "ecs-params.yml")
+	err := ioutil.WriteFile(composeFileName, []byte("version: '3'\n"), 0644)
+	assert.NoError(t, err, "Unexpected error writing compose file")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+
+	err = importTaskDefinition(setupImportContext(t, composeFileName, ecsParamsFileName, false), mockECS)
+	assert.Error(t, err, "Expected error when the compose file already exists")
+
+	data, err := ioutil.ReadFile(composeFileName)
+	assert.NoError(t, err, "Unexpected error reading compose file")
+	assert.Equal(t, "version: '3'\n", string(data), "Expected compose file not to be overwritten")
+}
+
+func TestImportTaskDefinition_ForceOverwritesFiles(t *testing.T) {
+	tempDirName, cleanup := setupImportDir(t)
+	defer cleanup()
+	composeFileName := filepath.Join(tempDirName, "docker-compose.yml")
+	ecsParamsFileName := filepath.Join(tempDirName, "ecs-params.yml")
+	err := ioutil.WriteFile(composeFileName, []byte("version: '3'\n"), 0644)
+	assert.NoError(t, err, "Unexpected error writing compose file")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockECS := mock_ecs.NewMockECSClient(ctrl)
+	mockECS.EXPECT().DescribeTaskDefinition("web-app:3").Return(importedTaskDefinition(), nil)
+
+	err = importTaskDefinition(setupImportContext(t, composeFileName, ecsParamsFileName, true), mockECS)
+	assert.NoError(t, err, "Unexpected error importing task definition")
+
+	data, err := ioutil.ReadFile(composeFileName)
+	assert.NoError(t, err, "Unexpected error reading compose file")
+	assert.Contains(t, string(data), "image: nginx:1.15", "Expected compose file to be overwritten")
+}
+
+func sortedEnvironment(environment []*ecs.KeyValuePair) map[string]string {
+	output := make(map[string]string)
+	for _, env := range environment {
+		output[aws.StringValue(env.Name)] = aws.StringValue(env.Value)
+	}
+	return output
+}
+
+// mountedPaths returns each mount point as the host path or volume name, and the container path
+func mountedPaths(taskDefinition *ecs.TaskDefinition, containerDef *ecs.ContainerDefinition) []string {
+	sources := make(map[string]string)
+	for _, volume := range taskDefinition.Volumes {
+		sources[aws.StringValue(volume.Name)] = aws.StringValue(volume.Name)
+		if volume.Host != nil && aws.StringValue(volume.Host.SourcePath) != "" {
+			sources[aws.StringValue(volume.Name)] = aws.StringValue(volume.Host.SourcePath)
+		}
+	}
+	paths := []string{}
+	for _, mountPoint := range containerDef.MountPoints {
+		path := sources[aws.StringValue(mountPoint.SourceVolume)] + ":" + aws.StringValue(mountPoint.ContainerPath)
+		if aws.BoolValue(mountPoint.ReadOnly) {
+			path += ":ro"
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
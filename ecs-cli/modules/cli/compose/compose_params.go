@@ -0,0 +1,47 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package compose
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	utils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// PrintECSParams prints the ecs-params files given with --ecs-params, merged
+// in order and with environment variables interpolated
+func PrintECSParams(c *cli.Context) {
+	output, err := mergeECSParams(c)
+	if err != nil {
+		log.Fatal("Error executing 'compose params': ", err)
+	}
+	os.Stdout.Write(output)
+}
+
+func mergeECSParams(c *cli.Context) ([]byte, error) {
+	ecsParamsFileNames := c.GlobalStringSlice(flags.ECSParamsFileNameFlag)
+	ecsParams, err := utils.ReadECSParamsFiles(ecsParamsFileNames, c.GlobalBool(flags.ECSParamsLenientFlag))
+	if err != nil {
+		return nil, err
+	}
+	if ecsParams == nil {
+		return nil, fmt.Errorf("no ecs-params file found; use --%s to specify one", flags.ECSParamsFileNameFlag)
+	}
+	return yaml.Marshal(ecsParams)
+}
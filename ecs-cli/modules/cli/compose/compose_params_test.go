@@ -0,0 +1,90 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package compose
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func TestMergeECSParams(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "test")
+	assert.NoError(t, err, "Unexpected error creating the temporary directory")
+	defer os.RemoveAll(tempDirName)
+
+	baseFileName := filepath.Join(tempDirName, "ecs-params.yml")
+	err = ioutil.WriteFile(baseFileName, []byte(`version: 1
+task_definition:
+  task_role_arn: arn:aws:iam::123456789012:role/base
+  services:
+    web:
+      cpu_shares: 100
+`), 0644)
+	assert.NoError(t, err, "Unexpected error writing the base file")
+
+	overlayFileName := filepath.Join(tempDirName, "ecs-params.prod.yml")
+	err = ioutil.WriteFile(overlayFileName, []byte(`task_definition:
+  task_role_arn: arn:aws:iam::123456789012:role/prod
+  services:
+    web:
+      mem_limit: 512
+`), 0644)
+	assert.NoError(t, err, "Unexpected error writing the overlay file")
+
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	ecsParamsFiles := &cli.StringSlice{}
+	ecsParamsFiles.Set(baseFileName)
+	ecsParamsFiles.Set(overlayFileName)
+	globalSet.Var(ecsParamsFiles, flags.ECSParamsFileNameFlag, "")
+	cliContext := cli.NewContext(nil, flag.NewFlagSet("ecs-cli-params", 0), cli.NewContext(nil, globalSet, nil))
+
+	output, err := mergeECSParams(cliContext)
+
+	expected := `version: "1"
+task_definition:
+  task_role_arn: arn:aws:iam::123456789012:role/prod
+  services:
+    web:
+      essential: true
+      cpu_shares: 100
+      mem_limit: 512
+`
+	if assert.NoError(t, err, "Unexpected error merging ecs-params files") {
+		assert.Equal(t, expected, string(output))
+	}
+}
+
+func TestMergeECSParams_ErrorWhenNoFile(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "test")
+	assert.NoError(t, err, "Unexpected error creating the temporary directory")
+	defer os.RemoveAll(tempDirName)
+	wd, err := os.Getwd()
+	assert.NoError(t, err, "Unexpected error getting the working directory")
+	defer os.Chdir(wd)
+	assert.NoError(t, os.Chdir(tempDirName), "Unexpected error changing the working directory")
+
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalSet.Var(&cli.StringSlice{}, flags.ECSParamsFileNameFlag, "")
+	cliContext := cli.NewContext(nil, flag.NewFlagSet("ecs-cli-params", 0), cli.NewContext(nil, globalSet, nil))
+
+	_, err = mergeECSParams(cliContext)
+	assert.Error(t, err, "Expected error when there is no ecs-params file")
+}
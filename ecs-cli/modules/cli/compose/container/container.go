@@ -29,26 +29,34 @@ const (
 	containerPortsKey = "Ports"
 	taskDefinitionKey = "TaskDefinition"
 	healthKey         = "Health"
+	imageKey          = "Image"
+
+	// OriginalImageLabel is the docker label recording the image of a container
+	// as written in the compose file, before it was pinned to a digest
+	OriginalImageLabel = "com.amazonaws.ecs-cli.original-image"
 )
 
 // ContainerInfoColumns is the ordered list of info columns for the ps commands
-var ContainerInfoColumns = []string{containerNameKey, containerStateKey, containerPortsKey, taskDefinitionKey, healthKey}
+var ContainerInfoColumns = []string{containerNameKey, containerStateKey, containerPortsKey, taskDefinitionKey, healthKey, imageKey}
 
 // Container is a wrapper around ecsContainer
 type Container struct {
 	task            *ecs.Task
 	EC2IPAddress    string
 	networkBindings []*ecs.NetworkBinding
+	containerDef    *ecs.ContainerDefinition
 
 	ecsContainer    *ecs.Container
 }
 
-// NewContainer creates a new instance of the container and sets the task id and ecs container to it
-func NewContainer(task *ecs.Task, ec2IPAddress string, container *ecs.Container, networkBindings []*ecs.NetworkBinding) Container {
+// NewContainer creates a new instance of the container and sets the task id and ecs container to it.
+// containerDef is the definition of the container in its task definition, if known.
+func NewContainer(task *ecs.Task, ec2IPAddress string, container *ecs.Container, networkBindings []*ecs.NetworkBinding, containerDef *ecs.ContainerDefinition) Container {
 	return Container{
 		task:            task,
 		EC2IPAddress:    ec2IPAddress,
 		networkBindings: networkBindings,
+		containerDef:    containerDef,
 		ecsContainer:    container,
 	}
 }
@@ -120,6 +128,18 @@ func (c *Container) HealthStatus() string {
 	return aws.StringValue(c.ecsContainer.HealthStatus)
 }
 
+// Image returns the image of the container as written in the compose file,
+// which is recorded in a docker label when the image was pinned to a digest
+func (c *Container) Image() string {
+	if c.containerDef == nil {
+		return ""
+	}
+	if image := aws.StringValue(c.containerDef.DockerLabels[OriginalImageLabel]); image != "" {
+		return image
+	}
+	return aws.StringValue(c.containerDef.Image)
+}
+
 // ConvertContainersToInfoSet transforms the list of containers into a formatted set of fields
 func ConvertContainersToInfoSet(containers []Container) project.InfoSet {
 	result := project.InfoSet{}
@@ -131,6 +151,7 @@ func ConvertContainersToInfoSet(containers []Container) project.InfoSet {
 			containerPortsKey: cont.PortString(),
 			taskDefinitionKey: cont.TaskDefinition(),
 			healthKey:         cont.HealthStatus(),
+			imageKey:          cont.Image(),
 		}
 		result = append(result, info)
 	}
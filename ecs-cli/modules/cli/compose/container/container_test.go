@@ -136,6 +136,24 @@ func TestHealthStatus(t *testing.T) {
 	assert.Equal(t, containerHealth, container.HealthStatus())
 }
 
+func TestImage(t *testing.T) {
+	container := setupContainer()
+	assert.Empty(t, container.Image(), "Expected no image without container definition")
+
+	container.containerDef = &ecs.ContainerDefinition{
+		Image: aws.String("nginx:1.15"),
+	}
+	assert.Equal(t, "nginx:1.15", container.Image())
+
+	container.containerDef = &ecs.ContainerDefinition{
+		Image: aws.String("nginx@sha256:0123456789abcdef"),
+		DockerLabels: map[string]*string{
+			OriginalImageLabel: aws.String("nginx:1.15"),
+		},
+	}
+	assert.Equal(t, "nginx:1.15", container.Image(), "Expected the original image of a pinned image")
+}
+
 func setupContainer() Container {
 	ecsContainer := &ecs.Container{
 		ContainerArn: aws.String(contArn),
@@ -144,5 +162,5 @@ func setupContainer() Container {
 	ecsTask := &ecs.Task{
 		TaskArn: aws.String(taskArn),
 	}
-	return NewContainer(ecsTask, ec2IPAddress, ecsContainer, nil)
+	return NewContainer(ecsTask, ec2IPAddress, ecsContainer, nil, nil)
 }
@@ -47,10 +47,21 @@ type ECSContext struct {
 
 	// IsService would decide if the resource created by this compose project would be ECS Tasks directly or through ECS Services
 	IsService bool
+
+	// ComposeServiceName is the compose service this context is scoped to when
+	// each compose service is deployed as its own ECS Service. It is empty when
+	// the context covers the whole compose project.
+	ComposeServiceName string
 }
 
-// Open populates the ECSContext with new ECS and EC2 Clients
+// Open populates the ECSContext with new ECS and EC2 Clients. The clients are
+// not created when the CommandConfig has no AWS Session, as is the case for
+// commands that run offline.
 func (ecsContext *ECSContext) Open() error {
+	if ecsContext.CommandConfig.Session == nil {
+		return nil
+	}
+
 	// setup AWS service clients
 	ecsContext.ECSClient = ecsclient.NewECSClient(ecsContext.CommandConfig)
 	ecsContext.EC2Client = ec2client.NewEC2Client(ecsContext.CommandConfig)
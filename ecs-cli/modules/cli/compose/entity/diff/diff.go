@@ -0,0 +1,285 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package diff compares ECS Services and task definitions that are deployed with
+// the ones compose service up would deploy from the local project.
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+// Defaults that ECS fills in for the optional fields of a container health check
+const (
+	defaultHealthCheckInterval = 30
+	defaultHealthCheckTimeout  = 5
+	defaultHealthCheckRetries  = 3
+)
+
+// Service holds the differences between a deployed ECS Service and what
+// compose service up would deploy from the local project
+type Service struct {
+	ServiceName string
+	// Missing is true if the ECS Service does not exist yet, in which case there are no Fields
+	Missing bool
+	Fields  []Field
+}
+
+// HasDifferences returns true if compose service up would change the ECS Service
+func (d *Service) HasDifferences() bool {
+	return d.Missing || len(d.Fields) > 0
+}
+
+// Field is a field whose value differs between the deployed ECS Service
+// and the local project. Deployed is nil for fields only set locally and
+// Local is nil for fields only set on the deployed ECS Service.
+type Field struct {
+	Field    string
+	Deployed *string
+	Local    *string
+}
+
+// fieldDiffs accumulates the differences found while comparing deployed and local values
+type fieldDiffs []Field
+
+func (diffs *fieldDiffs) compare(field string, deployed, local *string) {
+	if aws.StringValue(deployed) == aws.StringValue(local) && (deployed == nil) == (local == nil) {
+		return
+	}
+	*diffs = append(*diffs, Field{Field: field, Deployed: deployed, Local: local})
+}
+
+// compareMaps compares maps of names to values, such as environment variables, name by name
+func (diffs *fieldDiffs) compareMaps(field string, deployed, local map[string]string) {
+	for _, name := range sortedKeys(deployed, local) {
+		diffs.compare(field+"."+name, mapValue(deployed, name), mapValue(local, name))
+	}
+}
+
+// TaskDefinitions returns the fields of the local task definition that differ from
+// the deployed one: the task size, and the images, environment, secrets, resources,
+// port mappings and health checks of the containers matched by name
+func TaskDefinitions(deployed, local *ecs.TaskDefinition) []Field {
+	diffs := fieldDiffs{}
+	diffs.compare("cpu", deployed.Cpu, local.Cpu)
+	diffs.compare("memory", deployed.Memory, local.Memory)
+
+	deployedContainers := containerDefinitionsByName(deployed)
+	localContainers := containerDefinitionsByName(local)
+	for _, name := range sortedContainerNames(deployedContainers, localContainers) {
+		field := fmt.Sprintf("containerDefinitions[%s]", name)
+		deployedContainer, localContainer := deployedContainers[name], localContainers[name]
+		if deployedContainer == nil || localContainer == nil {
+			diffs.compare(field, containerImage(deployedContainer), containerImage(localContainer))
+			continue
+		}
+
+		diffs.compare(field+".image", deployedContainer.Image, localContainer.Image)
+		diffs.compareMaps(field+".environment", environmentMap(deployedContainer), environmentMap(localContainer))
+		diffs.compareMaps(field+".secrets", secretsMap(deployedContainer), secretsMap(localContainer))
+		diffs.compare(field+".cpu", int64Field(deployedContainer.Cpu), int64Field(localContainer.Cpu))
+		diffs.compare(field+".memory", int64Field(deployedContainer.Memory), int64Field(localContainer.Memory))
+		diffs.compare(field+".memoryReservation", int64Field(deployedContainer.MemoryReservation), int64Field(localContainer.MemoryReservation))
+		diffs.compare(field+".portMappings",
+			portMappingsField(deployedContainer, aws.StringValue(deployed.NetworkMode)),
+			portMappingsField(localContainer, aws.StringValue(local.NetworkMode)))
+		diffs.compareMaps(field+".healthCheck", healthCheckMap(deployedContainer.HealthCheck), healthCheckMap(localContainer.HealthCheck))
+	}
+	return diffs
+}
+
+func containerDefinitionsByName(taskDefinition *ecs.TaskDefinition) map[string]*ecs.ContainerDefinition {
+	containers := make(map[string]*ecs.ContainerDefinition)
+	for _, container := range taskDefinition.ContainerDefinitions {
+		containers[aws.StringValue(container.Name)] = container
+	}
+	return containers
+}
+
+func sortedContainerNames(deployed, local map[string]*ecs.ContainerDefinition) []string {
+	names := []string{}
+	for name := range deployed {
+		names = append(names, name)
+	}
+	for name := range local {
+		if _, ok := deployed[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedKeys(deployed, local map[string]string) []string {
+	keys := []string{}
+	for key := range deployed {
+		keys = append(keys, key)
+	}
+	for key := range local {
+		if _, ok := deployed[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func mapValue(values map[string]string, key string) *string {
+	if value, ok := values[key]; ok {
+		return aws.String(value)
+	}
+	return nil
+}
+
+// containerImage identifies a container that only exists on one side by its image
+func containerImage(container *ecs.ContainerDefinition) *string {
+	if container == nil {
+		return nil
+	}
+	return aws.String(aws.StringValue(container.Image))
+}
+
+// int64Field formats a container resource, which ECS reports as 0 when it is not set
+func int64Field(value *int64) *string {
+	if aws.Int64Value(value) == 0 {
+		return nil
+	}
+	return aws.String(strconv.FormatInt(*value, 10))
+}
+
+func environmentMap(container *ecs.ContainerDefinition) map[string]string {
+	environment := make(map[string]string)
+	for _, pair := range container.Environment {
+		environment[aws.StringValue(pair.Name)] = aws.StringValue(pair.Value)
+	}
+	return environment
+}
+
+func secretsMap(container *ecs.ContainerDefinition) map[string]string {
+	secrets := make(map[string]string)
+	for _, secret := range container.Secrets {
+		secrets[aws.StringValue(secret.Name)] = aws.StringValue(secret.ValueFrom)
+	}
+	return secrets
+}
+
+// portMappingsField formats the port mappings of a container as a sorted list of
+// hostPort:containerPort/protocol, filling in the host port and protocol ECS defaults to
+func portMappingsField(container *ecs.ContainerDefinition, networkMode string) *string {
+	if len(container.PortMappings) == 0 {
+		return nil
+	}
+	mappings := []string{}
+	for _, mapping := range container.PortMappings {
+		containerPort := aws.Int64Value(mapping.ContainerPort)
+		hostPort := aws.Int64Value(mapping.HostPort)
+		if hostPort == 0 && (networkMode == ecs.NetworkModeAwsvpc || networkMode == ecs.NetworkModeHost) {
+			hostPort = containerPort
+		}
+		protocol := aws.StringValue(mapping.Protocol)
+		if protocol == "" {
+			protocol = ecs.TransportProtocolTcp
+		}
+		mappings = append(mappings, fmt.Sprintf("%d:%d/%s", hostPort, containerPort, protocol))
+	}
+	sort.Strings(mappings)
+	return aws.String(strings.Join(mappings, ", "))
+}
+
+// healthCheckMap returns the fields of a health check, filling in the values ECS defaults to
+func healthCheckMap(healthCheck *ecs.HealthCheck) map[string]string {
+	if healthCheck == nil {
+		return nil
+	}
+	command, _ := json.Marshal(aws.StringValueSlice(healthCheck.Command))
+	int64Default := func(value *int64, defaultValue int64) string {
+		if value == nil {
+			return strconv.FormatInt(defaultValue, 10)
+		}
+		return strconv.FormatInt(*value, 10)
+	}
+	return map[string]string{
+		"command":     string(command),
+		"interval":    int64Default(healthCheck.Interval, defaultHealthCheckInterval),
+		"timeout":     int64Default(healthCheck.Timeout, defaultHealthCheckTimeout),
+		"retries":     int64Default(healthCheck.Retries, defaultHealthCheckRetries),
+		"startPeriod": int64Default(healthCheck.StartPeriod, 0),
+	}
+}
+
+// ServiceUpdate returns the fields of the deployed ECS Service that the update would change:
+// its desired count, deployment configuration and network configuration. Fields that are not
+// set on the update keep their deployed value and are not compared.
+func ServiceUpdate(deployed *ecs.Service, update *ecs.UpdateServiceInput) []Field {
+	diffs := fieldDiffs{}
+	if update.DesiredCount != nil {
+		diffs.compare("desiredCount", int64Value(deployed.DesiredCount), int64Value(update.DesiredCount))
+	}
+	if update.HealthCheckGracePeriodSeconds != nil {
+		diffs.compare("healthCheckGracePeriodSeconds", int64Value(deployed.HealthCheckGracePeriodSeconds), int64Value(update.HealthCheckGracePeriodSeconds))
+	}
+	if update.PlatformVersion != nil {
+		diffs.compare("platformVersion", deployed.PlatformVersion, update.PlatformVersion)
+	}
+
+	if deploymentConfig := update.DeploymentConfiguration; deploymentConfig != nil {
+		deployedConfig := deployed.DeploymentConfiguration
+		if deployedConfig == nil {
+			deployedConfig = &ecs.DeploymentConfiguration{}
+		}
+		if deploymentConfig.MaximumPercent != nil {
+			diffs.compare("deploymentConfiguration.maximumPercent", int64Value(deployedConfig.MaximumPercent), int64Value(deploymentConfig.MaximumPercent))
+		}
+		if deploymentConfig.MinimumHealthyPercent != nil {
+			diffs.compare("deploymentConfiguration.minimumHealthyPercent", int64Value(deployedConfig.MinimumHealthyPercent), int64Value(deploymentConfig.MinimumHealthyPercent))
+		}
+	}
+
+	if update.NetworkConfiguration != nil && update.NetworkConfiguration.AwsvpcConfiguration != nil {
+		awsvpcConfig := update.NetworkConfiguration.AwsvpcConfiguration
+		deployedConfig := &ecs.AwsVpcConfiguration{}
+		if deployed.NetworkConfiguration != nil && deployed.NetworkConfiguration.AwsvpcConfiguration != nil {
+			deployedConfig = deployed.NetworkConfiguration.AwsvpcConfiguration
+		}
+		diffs.compare("networkConfiguration.awsvpcConfiguration.subnets", stringsField(deployedConfig.Subnets), stringsField(awsvpcConfig.Subnets))
+		diffs.compare("networkConfiguration.awsvpcConfiguration.securityGroups", stringsField(deployedConfig.SecurityGroups), stringsField(awsvpcConfig.SecurityGroups))
+		if awsvpcConfig.AssignPublicIp != nil {
+			diffs.compare("networkConfiguration.awsvpcConfiguration.assignPublicIp", deployedConfig.AssignPublicIp, awsvpcConfig.AssignPublicIp)
+		}
+	}
+	return diffs
+}
+
+func int64Value(value *int64) *string {
+	if value == nil {
+		return nil
+	}
+	return aws.String(strconv.FormatInt(*value, 10))
+}
+
+// stringsField formats a list whose order does not matter, such as subnets, sorted
+func stringsField(values []*string) *string {
+	if len(values) == 0 {
+		return nil
+	}
+	sorted := aws.StringValueSlice(values)
+	sort.Strings(sorted)
+	return aws.String(strings.Join(sorted, ", "))
+}
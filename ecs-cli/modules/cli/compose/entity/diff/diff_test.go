@@ -0,0 +1,184 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func deployedTestTaskDefinition() *ecs.TaskDefinition {
+	return &ecs.TaskDefinition{
+		Cpu:         aws.String("256"),
+		Memory:      aws.String("512"),
+		NetworkMode: aws.String(ecs.NetworkModeAwsvpc),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:   aws.String("web"),
+				Image:  aws.String("nginx:1.15"),
+				Cpu:    aws.Int64(0),
+				Memory: aws.Int64(256),
+				Environment: []*ecs.KeyValuePair{
+					{Name: aws.String("LOG_LEVEL"), Value: aws.String("info")},
+					{Name: aws.String("PORT"), Value: aws.String("80")},
+				},
+				Secrets: []*ecs.Secret{
+					{Name: aws.String("DB_PASSWORD"), ValueFrom: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/db")},
+				},
+				PortMappings: []*ecs.PortMapping{
+					{ContainerPort: aws.Int64(80), HostPort: aws.Int64(80), Protocol: aws.String("tcp")},
+				},
+				HealthCheck: &ecs.HealthCheck{
+					Command:     aws.StringSlice([]string{"CMD-SHELL", "curl -f http://localhost/"}),
+					Interval:    aws.Int64(30),
+					Timeout:     aws.Int64(5),
+					Retries:     aws.Int64(3),
+					StartPeriod: aws.Int64(0),
+				},
+			},
+			{
+				Name:  aws.String("cache"),
+				Image: aws.String("redis"),
+			},
+		},
+	}
+}
+
+func localTestTaskDefinition() *ecs.TaskDefinition {
+	return &ecs.TaskDefinition{
+		Cpu:         aws.String("256"),
+		Memory:      aws.String("512"),
+		NetworkMode: aws.String(ecs.NetworkModeAwsvpc),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:   aws.String("web"),
+				Image:  aws.String("nginx:1.15"),
+				Memory: aws.Int64(256),
+				Environment: []*ecs.KeyValuePair{
+					{Name: aws.String("PORT"), Value: aws.String("80")},
+					{Name: aws.String("LOG_LEVEL"), Value: aws.String("info")},
+				},
+				Secrets: []*ecs.Secret{
+					{Name: aws.String("DB_PASSWORD"), ValueFrom: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/db")},
+				},
+				PortMappings: []*ecs.PortMapping{
+					{ContainerPort: aws.Int64(80)},
+				},
+				HealthCheck: &ecs.HealthCheck{
+					Command: aws.StringSlice([]string{"CMD-SHELL", "curl -f http://localhost/"}),
+				},
+			},
+			{
+				Name:  aws.String("cache"),
+				Image: aws.String("redis"),
+			},
+		},
+	}
+}
+
+func TestTaskDefinitionsWithoutDifferences(t *testing.T) {
+	diffs := TaskDefinitions(deployedTestTaskDefinition(), localTestTaskDefinition())
+	assert.Empty(t, diffs, "Expected values ECS defaults to be equal to unset local values")
+}
+
+func TestTaskDefinitions(t *testing.T) {
+	local := localTestTaskDefinition()
+	local.Memory = aws.String("1024")
+	web := local.ContainerDefinitions[0]
+	web.Image = aws.String("nginx:1.16")
+	web.MemoryReservation = aws.Int64(128)
+	web.Environment = []*ecs.KeyValuePair{
+		{Name: aws.String("PORT"), Value: aws.String("8080")},
+		{Name: aws.String("DEBUG"), Value: aws.String("1")},
+	}
+	web.Secrets = nil
+	web.PortMappings = append(web.PortMappings, &ecs.PortMapping{ContainerPort: aws.Int64(53), Protocol: aws.String("udp")})
+	web.HealthCheck.Retries = aws.Int64(5)
+	local.ContainerDefinitions = []*ecs.ContainerDefinition{web, {Name: aws.String("worker"), Image: aws.String("worker:latest")}}
+
+	diffs := TaskDefinitions(deployedTestTaskDefinition(), local)
+	assert.Equal(t, []Field{
+		{Field: "memory", Deployed: aws.String("512"), Local: aws.String("1024")},
+		{Field: "containerDefinitions[cache]", Deployed: aws.String("redis")},
+		{Field: "containerDefinitions[web].image", Deployed: aws.String("nginx:1.15"), Local: aws.String("nginx:1.16")},
+		{Field: "containerDefinitions[web].environment.DEBUG", Local: aws.String("1")},
+		{Field: "containerDefinitions[web].environment.LOG_LEVEL", Deployed: aws.String("info")},
+		{Field: "containerDefinitions[web].environment.PORT", Deployed: aws.String("80"), Local: aws.String("8080")},
+		{Field: "containerDefinitions[web].secrets.DB_PASSWORD", Deployed: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/db")},
+		{Field: "containerDefinitions[web].memoryReservation", Local: aws.String("128")},
+		{Field: "containerDefinitions[web].portMappings", Deployed: aws.String("80:80/tcp"), Local: aws.String("53:53/udp, 80:80/tcp")},
+		{Field: "containerDefinitions[web].healthCheck.retries", Deployed: aws.String("3"), Local: aws.String("5")},
+		{Field: "containerDefinitions[worker]", Local: aws.String("worker:latest")},
+	}, diffs)
+}
+
+func TestServiceUpdate(t *testing.T) {
+	deployed := &ecs.Service{
+		DesiredCount:    aws.Int64(2),
+		PlatformVersion: aws.String("1.2.0"),
+		DeploymentConfiguration: &ecs.DeploymentConfiguration{
+			MaximumPercent:        aws.Int64(200),
+			MinimumHealthyPercent: aws.Int64(100),
+		},
+		NetworkConfiguration: &ecs.NetworkConfiguration{
+			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+				Subnets:        aws.StringSlice([]string{"subnet-2", "subnet-1"}),
+				SecurityGroups: aws.StringSlice([]string{"sg-1"}),
+				AssignPublicIp: aws.String(ecs.AssignPublicIpDisabled),
+			},
+		},
+	}
+	update := &ecs.UpdateServiceInput{
+		DesiredCount:    aws.Int64(2),
+		PlatformVersion: aws.String("1.3.0"),
+		DeploymentConfiguration: &ecs.DeploymentConfiguration{
+			MaximumPercent: aws.Int64(150),
+		},
+		NetworkConfiguration: &ecs.NetworkConfiguration{
+			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+				Subnets:        aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+				SecurityGroups: aws.StringSlice([]string{"sg-1", "sg-2"}),
+			},
+		},
+	}
+
+	diffs := ServiceUpdate(deployed, update)
+	assert.Equal(t, []Field{
+		{Field: "platformVersion", Deployed: aws.String("1.2.0"), Local: aws.String("1.3.0")},
+		{Field: "deploymentConfiguration.maximumPercent", Deployed: aws.String("200"), Local: aws.String("150")},
+		{Field: "networkConfiguration.awsvpcConfiguration.securityGroups", Deployed: aws.String("sg-1"), Local: aws.String("sg-1, sg-2")},
+	}, diffs)
+}
+
+func TestServiceUpdateOfStoppedService(t *testing.T) {
+	deployed := &ecs.Service{DesiredCount: aws.Int64(0)}
+	update := &ecs.UpdateServiceInput{
+		DesiredCount:            aws.Int64(1),
+		DeploymentConfiguration: &ecs.DeploymentConfiguration{},
+	}
+
+	diffs := ServiceUpdate(deployed, update)
+	assert.Equal(t, []Field{
+		{Field: "desiredCount", Deployed: aws.String("0"), Local: aws.String("1")},
+	}, diffs)
+}
+
+func TestServiceHasDifferences(t *testing.T) {
+	assert.False(t, (&Service{ServiceName: "web"}).HasDifferences())
+	assert.True(t, (&Service{ServiceName: "web", Missing: true}).HasDifferences())
+	assert.True(t, (&Service{ServiceName: "web", Fields: []Field{{Field: "desiredCount"}}}).HasDifferences())
+}
@@ -15,6 +15,7 @@ package entity
 
 import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/diff"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/types"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/cache"
@@ -33,10 +34,14 @@ type ProjectEntity interface {
 	Scale(count int) error
 	Stop() error
 	Down() error
+	Abort() error
+	Diff() (*diff.Service, error)
+	Revisions() (project.InfoSet, error)
+	Rollback(revision int64) error
 
 	LoadContext() error
 	Context() *context.ECSContext
-	Sleeper() *utils.TimeSleeper
+	Sleeper() utils.Sleeper
 	TaskDefinition() *ecs.TaskDefinition
 	TaskDefinitionCache() cache.Cache
 	SetTaskDefinition(taskDefinition *ecs.TaskDefinition)
@@ -99,7 +99,7 @@ func GetOrCreateTaskDefinition(entity ProjectEntity) (*ecs.TaskDefinition, error
 	}
 
 	// Unfortunately, tags are not part of the task definition, rather they are a field on the Register Task Definition API
-	request := createRegisterTaskDefinitionRequest(taskDefinition, tags)
+	request := CreateRegisterTaskDefinitionRequest(taskDefinition, tags)
 
 	resp, err := entity.Context().ECSClient.RegisterTaskDefinitionIfNeeded(request, entity.TaskDefinitionCache())
 
@@ -117,7 +117,8 @@ func GetOrCreateTaskDefinition(entity ProjectEntity) (*ecs.TaskDefinition, error
 	return resp, nil
 }
 
-func createRegisterTaskDefinitionRequest(taskDefinition *ecs.TaskDefinition, tags []*ecs.Tag) *ecs.RegisterTaskDefinitionInput {
+// CreateRegisterTaskDefinitionRequest returns the request used to register the given task definition
+func CreateRegisterTaskDefinitionRequest(taskDefinition *ecs.TaskDefinition, tags []*ecs.Tag) *ecs.RegisterTaskDefinitionInput {
 	// Valid values for network mode are none, host or bridge. If no value
 	// is passed for network mode, ECS will set it to 'bridge' on most
 	// platforms, but Windows has different network modes. Passing nil allows ECS
@@ -131,6 +132,8 @@ func createRegisterTaskDefinitionRequest(taskDefinition *ecs.TaskDefinition, tag
 		ExecutionRoleArn:        taskDefinition.ExecutionRoleArn,
 		PidMode:                 taskDefinition.PidMode,
 		IpcMode:                 taskDefinition.IpcMode,
+		ProxyConfiguration:      taskDefinition.ProxyConfiguration,
+		PlacementConstraints:    taskDefinition.PlacementConstraints,
 	}
 
 	if networkMode := taskDefinition.NetworkMode; aws.StringValue(networkMode) != "" {
@@ -179,11 +182,12 @@ func collectContainers(entity ProjectEntity, filterLocal bool, desiredStatus str
 	if err != nil {
 		return nil, err
 	}
-	info, ecsTasks, err := getContainersForTasksWithTaskNetworking(entity, ecsTasks)
+	tdStore := NewTaskDefinitionStore()
+	info, ecsTasks, err := getContainersForTasksWithTaskNetworking(entity, ecsTasks, tdStore)
 	if err != nil {
 		return nil, err
 	}
-	return getContainersForTasks(entity, ecsTasks, info)
+	return getContainersForTasks(entity, ecsTasks, info, tdStore)
 }
 
 // collectTasks gets all the desiredStatus=RUNNING and STOPPED tasks
@@ -320,10 +324,9 @@ func getPublicIPsFromENIs(entity ProjectEntity, ecsTasks []*ecs.Task) (map[strin
 	return taskPublicIPs, nil
 }
 
-func getContainersForTasksWithTaskNetworking(entity ProjectEntity, ecsTasks []*ecs.Task) ([]composecontainer.Container, []*ecs.Task, error) {
+func getContainersForTasksWithTaskNetworking(entity ProjectEntity, ecsTasks []*ecs.Task, tdStore *TaskDefinitionStore) ([]composecontainer.Container, []*ecs.Task, error) {
 	var tasksWithInstanceIPs []*ecs.Task
 	info := []composecontainer.Container{}
-	tdStore := NewTaskDefinitionStore()
 
 	if len(ecsTasks) == 0 {
 		return info, ecsTasks, nil
@@ -362,7 +365,7 @@ func getContainersForTasksWithTaskNetworking(entity ProjectEntity, ecsTasks []*e
 						}
 					}
 				}
-				info = append(info, composecontainer.NewContainer(ecsTask, ipAddress, container, bindings))
+				info = append(info, composecontainer.NewContainer(ecsTask, ipAddress, container, bindings, containerDef))
 			}
 		} else {
 			tasksWithInstanceIPs = append(tasksWithInstanceIPs, ecsTask)
@@ -373,7 +376,7 @@ func getContainersForTasksWithTaskNetworking(entity ProjectEntity, ecsTasks []*e
 
 // getContainersForTasks returns the list of containers from the list of tasks.
 // It also fetches the ip addresses of instances where the containers are running
-func getContainersForTasks(entity ProjectEntity, ecsTasks []*ecs.Task, info []composecontainer.Container) ([]composecontainer.Container, error) {
+func getContainersForTasks(entity ProjectEntity, ecsTasks []*ecs.Task, info []composecontainer.Container, tdStore *TaskDefinitionStore) ([]composecontainer.Container, error) {
 	if len(ecsTasks) == 0 {
 		return info, nil
 	}
@@ -407,8 +410,14 @@ func getContainersForTasks(entity ProjectEntity, ecsTasks []*ecs.Task, info []co
 				ec2IPAddress = aws.StringValue(ec2Instances[ec2ID].PrivateIpAddress)
 			}
 		}
+		// the task definitions were already described by getContainersForTasksWithTaskNetworking
+		taskDef := tdStore.inMemoryTaskDefStore[aws.StringValue(ecsTask.TaskDefinitionArn)]
 		for _, container := range ecsTask.Containers {
-			info = append(info, composecontainer.NewContainer(ecsTask, ec2IPAddress, container, container.NetworkBindings))
+			var containerDef *ecs.ContainerDefinition
+			if taskDef != nil {
+				containerDef, _ = getContainerDef(taskDef, aws.StringValue(container.Name))
+			}
+			info = append(info, composecontainer.NewContainer(ecsTask, ec2IPAddress, container, container.NetworkBindings, containerDef))
 		}
 	}
 	return info, nil
@@ -498,10 +507,37 @@ func ValidateFargateParams(ecsParams *utils.ECSParams, launchType string) error
 	return nil
 }
 
-// OptionallyCreateLogs creates CW log groups if the --create-log-group flag is present.
+// GetPlatformVersion returns the Fargate platform version to run tasks with: the value of the
+// --platform-version flag, or else the platform_version in the run_params of the ECS params.
+// A platform version can only be set for the FARGATE launch type.
+func GetPlatformVersion(entity ProjectEntity) (string, error) {
+	platformVersion := entity.Context().CLIContext.String(flags.PlatformVersionFlag)
+	if platformVersion == "" && entity.Context().ECSParams != nil {
+		platformVersion = entity.Context().ECSParams.RunParams.PlatformVersion
+	}
+	if platformVersion == "" {
+		return "", nil
+	}
+
+	launchType := entity.Context().CommandConfig.LaunchType
+	if launchType == "" {
+		launchType = config.LaunchTypeDefault
+	}
+	if launchType != config.LaunchTypeFargate {
+		return "", fmt.Errorf("Platform version %s can only be used with launch type %s, found launch type %s", platformVersion, config.LaunchTypeFargate, launchType)
+	}
+	return platformVersion, nil
+}
+
+// OptionallyCreateLogs creates CW log groups if the --create-log-group flag is present,
+// with the retention of default_awslogs in the ecs-params if any.
 func OptionallyCreateLogs(entity ProjectEntity) error {
 	if entity.Context().CLIContext.Bool(flags.CreateLogsFlag) {
-		err := logs.CreateLogGroups(entity.TaskDefinition(), cloudwatchlogs.NewLogClientFactory(entity.Context().CommandConfig))
+		var retentionInDays int64
+		if ecsParams := entity.Context().ECSParams; ecsParams != nil && ecsParams.TaskDefinition.DefaultAWSLogs != nil {
+			retentionInDays = ecsParams.TaskDefinition.DefaultAWSLogs.RetentionInDays
+		}
+		err := logs.CreateLogGroups(entity.TaskDefinition(), retentionInDays, cloudwatchlogs.NewLogClientFactory(entity.Context().CommandConfig))
 		if err != nil {
 			return err
 		}
@@ -120,3 +120,15 @@ func TestInfoInvalidStatus(t *testing.T) {
 // tasks with Task Networking. TODO: refactor
 
 // TODO: Backfill other tests
+
+func TestValidateFargateParams_AutoTaskSize(t *testing.T) {
+	ecsParams := &utils.ECSParams{
+		TaskDefinition: utils.EcsTaskDef{
+			NetworkMode: "awsvpc",
+			TaskSize:    utils.TaskSize{Auto: true},
+		},
+	}
+
+	err := ValidateFargateParams(ecsParams, config.LaunchTypeFargate)
+	assert.NoError(t, err, "Expected an automatic task size to be valid")
+}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"testing"
 
+	composecontainer "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/container"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/mock"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ec2/mock"
@@ -61,12 +62,53 @@ func TestGetContainersForTasks(t *testing.T) {
 	mockProjectEntity := setupMocks(t, []*string{aws.String(containerInstanceArn)}, containerInstances,
 		[]*string{aws.String(ec2InstanceID)}, ec2Instances)
 
-	containers, err := getContainersForTasks(mockProjectEntity, ecsTasks, nil)
+	containers, err := getContainersForTasks(mockProjectEntity, ecsTasks, nil, NewTaskDefinitionStore())
 	assert.NoError(t, err, "Unexpected error when calling getContainersForTasks")
 	assert.Len(t, containers, 1, "Expected to have 1 container")
 	assert.Equal(t, aws.StringValue(ec2Instance.PublicIpAddress), containers[0].EC2IPAddress, "Expects PublicIpAddress to match")
 }
 
+func TestGetContainersForTasksWithCachedTaskDefinition(t *testing.T) {
+	containerInstanceArn := "containerInstanceArn"
+	ec2InstanceID := "ec2InstanceId"
+	ec2Instance := &ec2.Instance{PublicIpAddress: aws.String(publicIPAddress)}
+
+	ecsTasks := []*ecs.Task{
+		&ecs.Task{
+			TaskDefinitionArn: aws.String(taskDefArn),
+			Containers: []*ecs.Container{
+				&ecs.Container{
+					Name: aws.String("containerName"),
+				},
+			},
+			ContainerInstanceArn: aws.String(containerInstanceArn),
+		},
+	}
+	containerInstances := map[string]string{containerInstanceArn: ec2InstanceID}
+	ec2Instances := map[string]*ec2.Instance{ec2InstanceID: ec2Instance}
+
+	mockProjectEntity := setupMocks(t, []*string{aws.String(containerInstanceArn)}, containerInstances,
+		[]*string{aws.String(ec2InstanceID)}, ec2Instances)
+
+	tdStore := NewTaskDefinitionStore()
+	tdStore.inMemoryTaskDefStore[taskDefArn] = &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			&ecs.ContainerDefinition{
+				Name:  aws.String("containerName"),
+				Image: aws.String("nginx@sha256:0123456789abcdef"),
+				DockerLabels: map[string]*string{
+					composecontainer.OriginalImageLabel: aws.String("nginx:1.15"),
+				},
+			},
+		},
+	}
+
+	containers, err := getContainersForTasks(mockProjectEntity, ecsTasks, nil, tdStore)
+	assert.NoError(t, err, "Unexpected error when calling getContainersForTasks")
+	assert.Len(t, containers, 1, "Expected to have 1 container")
+	assert.Equal(t, "nginx:1.15", containers[0].Image(), "Expected the original image of the container")
+}
+
 func ecsTask(launchType string) *ecs.Task {
 	return ecsTaskWithOptions(launchType, ENIStatusAttached, ecs.DesiredStatusRunning)
 }
@@ -152,7 +194,7 @@ func TestGetContainersForTasksWithTaskNetworkingENIProvisioning(t *testing.T) {
 		mockEcs.EXPECT().DescribeTaskDefinition(taskDefArn).Return(taskDef, nil),
 	)
 
-	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks)
+	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks, NewTaskDefinitionStore())
 	assert.NoError(t, err, "Unexpected error when calling getContainersForTasksWithTaskNetworking")
 	assert.Len(t, containers, 1, "Expected to have 1 containers")
 	assert.Len(t, tasks, 0, "Expected to have 0 tasks without task networking")
@@ -189,7 +231,7 @@ func TestGetContainersForTasksWithTaskNetworkingNoNetworkInterfaces(t *testing.T
 		mockEcs.EXPECT().DescribeTaskDefinition(taskDefArn).Return(taskDef, nil),
 	)
 
-	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks)
+	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks, NewTaskDefinitionStore())
 	assert.NoError(t, err, "Unexpected error when calling getContainersForTasksWithTaskNetworking")
 	assert.Len(t, containers, 0, "Expected to have 0 containers")
 	assert.Len(t, tasks, 1, "Expected to have 1 tasks without task networking")
@@ -216,7 +258,7 @@ func TestGetContainersForTasksWithTaskNetworkingFargateENIDeleted(t *testing.T)
 		mockEcs.EXPECT().DescribeTaskDefinition(taskDefArn).Return(taskDef, nil),
 	)
 
-	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks)
+	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks, NewTaskDefinitionStore())
 	assert.NoError(t, err, "Unexpected error when calling getContainersForTasksWithTaskNetworking")
 	assert.Len(t, containers, 1, "Expected to have 1 container")
 	assert.Len(t, tasks, 0, "Expected to have 0 tasks without task networking")
@@ -245,7 +287,7 @@ func TestGetContainersForTasksWithTaskNetworkingFargateTaskStopped(t *testing.T)
 		mockEcs.EXPECT().DescribeTaskDefinition(taskDefArn).Return(taskDef, nil),
 	)
 
-	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks)
+	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks, NewTaskDefinitionStore())
 	assert.NoError(t, err, "Unexpected error when calling getContainersForTasksWithTaskNetworking")
 	assert.Len(t, containers, 1, "Expected to have 1 container")
 	assert.Len(t, tasks, 0, "Expected to have 0 tasks without task networking")
@@ -274,7 +316,7 @@ func TestGetContainersForTasksWithTaskNetworkingEC2TaskStopped(t *testing.T) {
 		mockEcs.EXPECT().DescribeTaskDefinition(taskDefArn).Return(taskDef, nil),
 	)
 
-	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks)
+	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks, NewTaskDefinitionStore())
 	assert.NoError(t, err, "Unexpected error when calling getContainersForTasksWithTaskNetworking")
 	assert.Len(t, containers, 1, "Expected to have 1 container")
 	assert.Len(t, tasks, 0, "Expected to have 0 tasks without task networking")
@@ -304,7 +346,7 @@ func TestGetContainersForTasksWithTaskNetworkingEC2(t *testing.T) {
 		mockEcs.EXPECT().DescribeTaskDefinition(taskDefArn).Return(taskDef, nil),
 	)
 
-	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks)
+	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks, NewTaskDefinitionStore())
 	assert.NoError(t, err, "Unexpected error when calling getContainersForTasksWithTaskNetworking")
 	assert.Len(t, containers, 1, "Expected to have 1 container")
 	assert.Len(t, tasks, 0, "Expected to have 0 tasks without task networking")
@@ -346,7 +388,7 @@ func TestGetContainersForTasksWithTaskNetworkingFargate(t *testing.T) {
 		mockEcs.EXPECT().DescribeTaskDefinition(taskDefArn).Return(taskDef, nil),
 	)
 
-	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks)
+	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks, NewTaskDefinitionStore())
 	assert.NoError(t, err, "Unexpected error when calling getContainersForTasksWithTaskNetworking")
 	assert.Len(t, containers, 1, "Expected to have 1 container")
 	assert.Len(t, tasks, 0, "Expected to have 0 tasks without task networking")
@@ -381,7 +423,7 @@ func TestGetContainersForTasksWithTaskNetworkingFargateENIDescribeFails(t *testi
 		mockEcs.EXPECT().DescribeTaskDefinition(taskDefArn).Return(taskDef, nil),
 	)
 
-	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks)
+	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks, NewTaskDefinitionStore())
 	assert.NoError(t, err, "Unexpected error when calling getContainersForTasksWithTaskNetworking")
 	assert.Len(t, containers, 1, "Expected to have 1 container")
 	assert.Len(t, tasks, 0, "Expected to have 0 tasks without task networking")
@@ -420,7 +462,7 @@ func TestGetContainersForTasksWithTaskNetworkingFargateENIWithoutPublicIP(t *tes
 		mockEcs.EXPECT().DescribeTaskDefinition(taskDefArn).Return(taskDef, nil),
 	)
 
-	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks)
+	containers, tasks, err := getContainersForTasksWithTaskNetworking(mockProjectEntity, ecsTasks, NewTaskDefinitionStore())
 	assert.NoError(t, err, "Unexpected error when calling getContainersForTasksWithTaskNetworking")
 	assert.Len(t, containers, 1, "Expected to have 1 container")
 	assert.Len(t, tasks, 0, "Expected to have 0 tasks without task networking")
@@ -452,7 +494,7 @@ func TestGetContainersForTasksPrivateIP(t *testing.T) {
 	mockProjectEntity := setupMocks(t, []*string{aws.String(containerInstanceArn)}, containerInstances,
 		[]*string{aws.String(ec2InstanceID)}, ec2Instances)
 
-	containers, err := getContainersForTasks(mockProjectEntity, ecsTasks, nil)
+	containers, err := getContainersForTasks(mockProjectEntity, ecsTasks, nil, NewTaskDefinitionStore())
 	assert.NoError(t, err, "Unexpected error when calling getContainersForTasks")
 	assert.Len(t, containers, 1, "Expected to have 1 container")
 	assert.Equal(t, aws.StringValue(ec2Instance.PrivateIpAddress), containers[0].EC2IPAddress, "Expects PublicIpAddress to match")
@@ -481,7 +523,7 @@ func TestGetContainersForTasksWithoutEc2InstanceID(t *testing.T) {
 	projectEntity := setupMocks(t, []*string{aws.String(containerInstanceArn)}, containerInstances,
 		[]*string{aws.String(ec2InstanceID)}, ec2Instances)
 
-	containers, err := getContainersForTasks(projectEntity, ecsTasks, nil)
+	containers, err := getContainersForTasks(projectEntity, ecsTasks, nil, NewTaskDefinitionStore())
 	assert.NoError(t, err, "Unexpected error when calling getContainersForTasks")
 	assert.Len(t, containers, 1, "Expects to have 1 containers")
 	assert.Empty(t, containers[0].EC2IPAddress, "Expects ec2IpAddress to be empty")
@@ -515,7 +557,7 @@ func TestGetContainersForTasksErrorCases(t *testing.T) {
 		mockEcs.EXPECT().GetEC2InstanceIDs(gomock.Any()).Return(nil, errors.New("something wrong")),
 	)
 
-	_, err := getContainersForTasks(mockProjectEntity, ecsTasks, nil)
+	_, err := getContainersForTasks(mockProjectEntity, ecsTasks, nil, NewTaskDefinitionStore())
 	assert.Error(t, err, "Expected error when calling getContainersForTasks")
 
 	// DescribeInstances failed
@@ -525,7 +567,7 @@ func TestGetContainersForTasksErrorCases(t *testing.T) {
 		mockProjectEntity.EXPECT().Context().Return(mockContext),
 		mockEc2.EXPECT().DescribeInstances(gomock.Any()).Return(nil, errors.New("something wrong")),
 	)
-	_, err = getContainersForTasks(mockProjectEntity, ecsTasks, nil)
+	_, err = getContainersForTasks(mockProjectEntity, ecsTasks, nil, NewTaskDefinitionStore())
 	assert.Error(t, err, "Expected error when calling getContainersForTasks")
 }
 
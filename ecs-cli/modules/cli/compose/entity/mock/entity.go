@@ -21,6 +21,7 @@ import (
 	reflect "reflect"
 
 	context "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	diff "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/diff"
 	types "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/types"
 	utils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
 	cache "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/cache"
@@ -52,6 +53,18 @@ func (m *MockProjectEntity) EXPECT() *MockProjectEntityMockRecorder {
 	return m.recorder
 }
 
+// Abort mocks base method
+func (m *MockProjectEntity) Abort() error {
+	ret := m.ctrl.Call(m, "Abort")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Abort indicates an expected call of Abort
+func (mr *MockProjectEntityMockRecorder) Abort() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Abort", reflect.TypeOf((*MockProjectEntity)(nil).Abort))
+}
+
 // Context mocks base method
 func (m *MockProjectEntity) Context() *context.ECSContext {
 	ret := m.ctrl.Call(m, "Context")
@@ -76,6 +89,19 @@ func (mr *MockProjectEntityMockRecorder) Create() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectEntity)(nil).Create))
 }
 
+// Diff mocks base method
+func (m *MockProjectEntity) Diff() (*diff.Service, error) {
+	ret := m.ctrl.Call(m, "Diff")
+	ret0, _ := ret[0].(*diff.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Diff indicates an expected call of Diff
+func (mr *MockProjectEntityMockRecorder) Diff() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Diff", reflect.TypeOf((*MockProjectEntity)(nil).Diff))
+}
+
 // Down mocks base method
 func (m *MockProjectEntity) Down() error {
 	ret := m.ctrl.Call(m, "Down")
@@ -138,6 +164,31 @@ func (mr *MockProjectEntityMockRecorder) LoadContext() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadContext", reflect.TypeOf((*MockProjectEntity)(nil).LoadContext))
 }
 
+// Revisions mocks base method
+func (m *MockProjectEntity) Revisions() (project.InfoSet, error) {
+	ret := m.ctrl.Call(m, "Revisions")
+	ret0, _ := ret[0].(project.InfoSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revisions indicates an expected call of Revisions
+func (mr *MockProjectEntityMockRecorder) Revisions() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revisions", reflect.TypeOf((*MockProjectEntity)(nil).Revisions))
+}
+
+// Rollback mocks base method
+func (m *MockProjectEntity) Rollback(arg0 int64) error {
+	ret := m.ctrl.Call(m, "Rollback", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback
+func (mr *MockProjectEntityMockRecorder) Rollback(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockProjectEntity)(nil).Rollback), arg0)
+}
+
 // Run mocks base method
 func (m *MockProjectEntity) Run(arg0 map[string][]string) error {
 	ret := m.ctrl.Call(m, "Run", arg0)
@@ -173,9 +224,9 @@ func (mr *MockProjectEntityMockRecorder) SetTaskDefinition(arg0 interface{}) *go
 }
 
 // Sleeper mocks base method
-func (m *MockProjectEntity) Sleeper() *utils.TimeSleeper {
+func (m *MockProjectEntity) Sleeper() utils.Sleeper {
 	ret := m.ctrl.Call(m, "Sleeper")
-	ret0, _ := ret[0].(*utils.TimeSleeper)
+	ret0, _ := ret[0].(utils.Sleeper)
 	return ret0
 }
 
@@ -20,6 +20,7 @@ import (
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/diff"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/types"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/servicediscovery"
 	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
@@ -43,13 +44,15 @@ type Service struct {
 	taskDef           *ecs.TaskDefinition
 	cache             cache.Cache
 	ecsContext        *context.ECSContext
-	timeSleeper       *utils.TimeSleeper
+	timeSleeper       utils.Sleeper
 	deploymentConfig  *ecs.DeploymentConfiguration
 	loadBalancer      *ecs.LoadBalancer
 	role              string
 	healthCheckGP     *int64
 	serviceRegistries []*ecs.ServiceRegistry
 	tags              []*ecs.Tag
+	externalDeploy    bool
+	progress          deploymentProgress
 }
 
 const (
@@ -95,6 +98,13 @@ func (s *Service) LoadContext() error {
 		MinimumHealthyPercent: minHealthyPercent,
 	}
 
+	// the progress view is checked before anything is deployed
+	progress, err := newDeploymentProgress(s.Context().CLIContext.String(flags.ProgressFlag), progressOutput)
+	if err != nil {
+		return err
+	}
+	s.progress = progress
+
 	// Load Balancer
 	role := s.Context().CLIContext.String(flags.RoleFlag)
 	targetGroupArn := s.Context().CLIContext.String(flags.TargetGroupArnFlag)
@@ -136,6 +146,14 @@ func (s *Service) LoadContext() error {
 		}
 		s.role = role
 	}
+
+	// With --split-services the load balancer is attached only to the ECS
+	// Service of the compose service named by --container-name
+	if composeServiceName := s.Context().ComposeServiceName; composeServiceName != "" && s.loadBalancer != nil && containerName != composeServiceName {
+		s.loadBalancer = nil
+		s.role = ""
+		s.healthCheckGP = nil
+	}
 	return nil
 }
 
@@ -163,7 +181,7 @@ func (s *Service) Context() *context.ECSContext {
 }
 
 // Sleeper returs an instance of TimeSleeper used to wait until Service has gone to a stable state
-func (s *Service) Sleeper() *utils.TimeSleeper {
+func (s *Service) Sleeper() utils.Sleeper {
 	return s.timeSleeper
 }
 
@@ -316,6 +334,10 @@ func (s *Service) buildUpdateServiceInput(count *int64, serviceName, taskDefinit
 	if err != nil {
 		return nil, err
 	}
+	platformVersion, err := entity.GetPlatformVersion(s)
+	if err != nil {
+		return nil, err
+	}
 
 	input := &ecs.UpdateServiceInput{
 		DesiredCount:            count,
@@ -329,10 +351,20 @@ func (s *Service) buildUpdateServiceInput(count *int64, serviceName, taskDefinit
 		input.HealthCheckGracePeriodSeconds = aws.Int64(*s.healthCheckGP)
 	}
 
+	// the network configuration of services using the EXTERNAL deployment controller belongs to their task sets
+	if s.externalDeploy {
+		input.ForceNewDeployment = nil
+		return input, nil
+	}
+
 	if networkConfig != nil {
 		input.NetworkConfiguration = networkConfig
 	}
 
+	if platformVersion != "" {
+		input.PlatformVersion = aws.String(platformVersion)
+	}
+
 	if taskDefinition != "" {
 		input.TaskDefinition = aws.String(taskDefinition)
 	}
@@ -351,32 +383,35 @@ func (s *Service) updateService(ecsService *ecs.Service, newTaskDefinition *ecs.
 	}
 
 	ecsServiceName := aws.StringValue(ecsService.ServiceName)
-	if s.loadBalancer != nil {
+	if s.loadBalancer != nil && !usesTaskSets(ecsService) {
 		log.WithFields(log.Fields{
 			"serviceName": ecsServiceName,
 		}).Warn("You cannot update the load balancer configuration on an existing service.")
 	}
 
-	oldCount := aws.Int64Value(ecsService.DesiredCount)
-	newCount := int64(1)
-	count := &newCount
-	if oldCount != 0 {
-		count = &oldCount // get the current non-zero count
-	}
+	count := s.updateCount(ecsService)
 
 	// if both the task definitions are the same, call update with the new count
 	oldTaskDefinitionId := entity.GetIdFromArn(ecsService.TaskDefinition)
 	newTaskDefinitionId := entity.GetIdFromArn(newTaskDefinition.TaskDefinitionArn)
 
-	if aws.StringValue(ecsService.SchedulingStrategy) == ecs.SchedulingStrategyDaemon {
-		count = nil
+	// services using the EXTERNAL deployment controller are deployed with task sets
+	if usesTaskSets(ecsService) {
+		return s.deployTaskSet(ecsService, newTaskDefinition, count)
 	}
 
-	if oldTaskDefinitionId == newTaskDefinitionId {
+	platformVersion, err := entity.GetPlatformVersion(s)
+	if err != nil {
+		return err
+	}
+	// the platform version only changes if one is set, the service keeps its version otherwise
+	platformVersionChanged := platformVersion != "" && platformVersion != aws.StringValue(ecsService.PlatformVersion)
+
+	if oldTaskDefinitionId == newTaskDefinitionId && !platformVersionChanged {
 		return s.updateServiceCount(count)
 	}
 
-	// if the task definitions were different, updateService with new task definition
+	// if the task definitions or platform versions were different, updateService with new task definition
 	// this creates a deployment in ECS and slowly takes down the containers with old ones and starts new ones
 
 	updateServiceInput, err := s.buildUpdateServiceInput(count, ecsServiceName, newTaskDefinitionId)
@@ -393,7 +428,64 @@ func (s *Service) updateService(ecsService *ecs.Service, newTaskDefinition *ecs.
 		"Old containers will be stopped automatically, and replaced with new ones"
 	s.logUpdateService(updateServiceInput, message)
 
-	return waitForServiceTasks(s, ecsServiceName)
+	err = waitForServiceDeployment(s, ecsServiceName, aws.StringValue(newTaskDefinition.TaskDefinitionArn))
+	// only a deployment that did not become stable or whose tasks kept stopping is rolled back; errors
+	// calling ECS and interrupted deployments are left as they are for the user to decide what to do
+	if err == nil || s.Context().CLIContext.Bool(flags.NoRollbackFlag) || !isDeploymentFailure(err) {
+		return err
+	}
+	return s.rollbackService(ecsService, newTaskDefinitionId, err)
+}
+
+// updateCount returns the desired count an existing service is updated with: its
+// current count if it is running, otherwise the count a stopped service is started with
+func (s *Service) updateCount(ecsService *ecs.Service) *int64 {
+	if aws.StringValue(ecsService.SchedulingStrategy) == ecs.SchedulingStrategyDaemon {
+		return nil
+	}
+	if oldCount := aws.Int64Value(ecsService.DesiredCount); oldCount != 0 {
+		return aws.Int64(oldCount) // get the current non-zero count
+	}
+	return s.initialCount()
+}
+
+// rollbackService updates the service back to the task definition, desired count and deployment configuration
+// it had before a deployment of newTaskDefinitionId failed, and waits for it to become stable again
+func (s *Service) rollbackService(previous *ecs.Service, newTaskDefinitionId string, deploymentErr error) error {
+	ecsServiceName := aws.StringValue(previous.ServiceName)
+	previousTaskDefinitionId := entity.GetIdFromArn(previous.TaskDefinition)
+	log.WithFields(log.Fields{
+		"service":                ecsServiceName,
+		"failedTaskDefinition":   newTaskDefinitionId,
+		"previousTaskDefinition": previousTaskDefinitionId,
+	}).WithError(deploymentErr).Warn("Deployment failed, rolling back the ECS service")
+
+	rollbackInput := &ecs.UpdateServiceInput{
+		Cluster:                       aws.String(s.Context().CommandConfig.Cluster),
+		Service:                       aws.String(ecsServiceName),
+		TaskDefinition:                aws.String(previousTaskDefinitionId),
+		NetworkConfiguration:          previous.NetworkConfiguration,
+		HealthCheckGracePeriodSeconds: previous.HealthCheckGracePeriodSeconds,
+		PlatformVersion:               previous.PlatformVersion,
+		DeploymentConfiguration:       previous.DeploymentConfiguration,
+	}
+	if aws.StringValue(previous.SchedulingStrategy) != ecs.SchedulingStrategyDaemon {
+		rollbackInput.DesiredCount = previous.DesiredCount
+	}
+
+	if err := s.Context().ECSClient.UpdateService(rollbackInput); err != nil {
+		return errors.Wrapf(err, "deployment of task definition %s failed (%s) and service %s could not be rolled back to task definition %s",
+			newTaskDefinitionId, deploymentErr, ecsServiceName, previousTaskDefinitionId)
+	}
+	s.logUpdateService(rollbackInput, "Rolled back the ECS service to its previous task definition")
+
+	if err := waitForServiceTasks(s, ecsServiceName); err != nil {
+		return errors.Wrapf(err, "deployment of task definition %s failed (%s) and service %s was rolled back to task definition %s, which has not become stable",
+			newTaskDefinitionId, deploymentErr, ecsServiceName, previousTaskDefinitionId)
+	}
+
+	return fmt.Errorf("deployment of task definition %s failed and service %s was rolled back to task definition %s with desired count %d: %s",
+		newTaskDefinitionId, ecsServiceName, previousTaskDefinitionId, aws.Int64Value(previous.DesiredCount), deploymentErr)
 }
 
 // Info returns a formatted list of containers (running and stopped) started by this service
@@ -404,14 +496,53 @@ func (s *Service) Info(filterProjectTasks bool, desiredStatus string) (project.I
 	return entity.Info(s, false, desiredStatus)
 }
 
+// Diff compares the ECS Service and its task definition with what Up would deploy
+// from the local project, without registering the local task definition
+func (s *Service) Diff() (*diff.Service, error) {
+	serviceDiff := &diff.Service{ServiceName: entity.GetServiceName(s)}
+	ecsService, err := s.describeService()
+	if err != nil {
+		if isServiceMissing(err) {
+			serviceDiff.Missing = true
+			return serviceDiff, nil
+		}
+		return nil, err
+	}
+	if aws.StringValue(ecsService.Status) != ecsActiveResourceCode {
+		serviceDiff.Missing = true
+		return serviceDiff, nil
+	}
+
+	deployedTaskDefinition := &ecs.TaskDefinition{}
+	if taskDefinitionArn := deployedTaskDefinitionArn(ecsService); taskDefinitionArn != "" {
+		if deployedTaskDefinition, err = s.Context().ECSClient.DescribeTaskDefinition(taskDefinitionArn); err != nil {
+			return nil, err
+		}
+	}
+	serviceDiff.Fields = diff.TaskDefinitions(deployedTaskDefinition, s.TaskDefinition())
+
+	updateServiceInput, err := s.buildUpdateServiceInput(s.updateCount(ecsService), aws.StringValue(ecsService.ServiceName), "")
+	if err != nil {
+		return nil, err
+	}
+	serviceDiff.Fields = append(serviceDiff.Fields, diff.ServiceUpdate(ecsService, updateServiceInput)...)
+	return serviceDiff, nil
+}
+
 // Scale the service desired count to be the specified count
 func (s *Service) Scale(count int) error {
+	if _, err := s.describeService(); err != nil {
+		return err
+	}
 	return s.updateServiceCount(aws.Int64(int64(count)))
 }
 
 // Stop stops all the containers in the service by calling ECS.UpdateService(count=0)
 // TODO, Store the current desiredCount in a cache, so that number of tasks(group of containers) can be started again
 func (s *Service) Stop() error {
+	if _, err := s.describeService(); err != nil {
+		return err
+	}
 	return s.updateServiceCount(aws.Int64(0))
 }
 
@@ -440,6 +571,13 @@ func (s *Service) Down() error {
 		}
 	}
 
+	// the task sets of a service using the EXTERNAL deployment controller must be deleted first
+	for _, taskSet := range ecsService.TaskSets {
+		if err = s.Context().ECSClient.DeleteTaskSet(ecsServiceName, aws.StringValue(taskSet.Id)); err != nil {
+			return err
+		}
+	}
+
 	// deleteService
 	if err = s.Context().ECSClient.DeleteService(ecsServiceName); err != nil {
 		return err
@@ -526,6 +664,11 @@ func (s *Service) buildCreateServiceInput(serviceName, taskDefName string) (*ecs
 		return nil, fmt.Errorf("--%v is only valid for services configured to use load balancers", flags.HealthCheckGracePeriodFlag)
 	}
 
+	platformVersion, err := entity.GetPlatformVersion(s)
+	if err != nil {
+		return nil, err
+	}
+
 	createServiceInput := &ecs.CreateServiceInput{
 		DesiredCount:            aws.Int64(0),            // Required unless DAEMON schedulingStrategy
 		ServiceName:             aws.String(serviceName), // Required
@@ -567,10 +710,32 @@ func (s *Service) buildCreateServiceInput(serviceName, taskDefName string) (*ecs
 		createServiceInput.LaunchType = aws.String(launchType)
 	}
 
+	if platformVersion != "" {
+		createServiceInput.PlatformVersion = aws.String(platformVersion)
+	}
+
 	if err = createServiceInput.Validate(); err != nil {
 		return nil, err
 	}
 
+	deploymentController, err := s.deploymentController()
+	if err != nil {
+		return nil, err
+	}
+	if deploymentController != nil {
+		createServiceInput.DeploymentController = deploymentController
+	}
+	// with the EXTERNAL deployment controller, these are set on the task sets of the service
+	if deploymentController != nil && aws.StringValue(deploymentController.Type) == ecs.DeploymentControllerTypeExternal {
+		createServiceInput.TaskDefinition = nil
+		createServiceInput.LaunchType = nil
+		createServiceInput.PlatformVersion = nil
+		createServiceInput.NetworkConfiguration = nil
+		createServiceInput.LoadBalancers = nil
+		createServiceInput.Role = nil
+		createServiceInput.ServiceRegistries = nil
+	}
+
 	tags, err := s.GetTags()
 	if err != nil {
 		return nil, err
@@ -671,6 +836,9 @@ func (s *Service) createService() error {
 		return err
 	}
 
+	if createServiceInput.TaskDefinition == nil {
+		return s.createPrimaryTaskSet(serviceName, taskDefName)
+	}
 	return nil
 }
 
@@ -684,10 +852,11 @@ func (s *Service) describeService() (*ecs.Service, error) {
 	}
 	if len(output.Failures) > 0 {
 		reason := aws.StringValue(output.Failures[0].Reason)
-		return nil, fmt.Errorf("Got an error describing service '%s' : '%s'", serviceName, reason)
+		return nil, &describeServiceFailureError{serviceName: serviceName, reason: reason}
 	} else if len(output.Services) == 0 {
 		return nil, fmt.Errorf("Got an empty list of services while describing the service '%s'", serviceName)
 	}
+	s.externalDeploy = usesTaskSets(output.Services[0])
 	return output.Services[0], nil
 }
 
@@ -731,7 +900,19 @@ func (s *Service) startService() error {
 
 		return waitForServiceTasks(s, serviceName)
 	}
-	return s.updateServiceCount(aws.Int64(1))
+	return s.updateServiceCount(s.initialCount())
+}
+
+// initialCount returns the desired count a stopped service is started with:
+// the desired_count of the compose service in the ecs-params service_overrides
+// if there is one, otherwise 1
+func (s *Service) initialCount() *int64 {
+	if composeServiceName := s.Context().ComposeServiceName; composeServiceName != "" {
+		if count := s.Context().ECSParams.DesiredCount(composeServiceName); count != nil {
+			return aws.Int64(*count)
+		}
+	}
+	return aws.Int64(1)
 }
 
 // updateServiceCount calls the underlying ECS.UpdateService with the specified count
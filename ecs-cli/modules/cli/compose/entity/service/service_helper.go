@@ -18,10 +18,13 @@ import (
 	"sort"
 	"time"
 
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/waiters"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -35,8 +38,50 @@ const (
 	// which were created since roughly when the user entered the command in their
 	// terminal. Units = seconds.
 	latestEventWindow = 2
+
+	// deploymentFailedTasksThreshold is the number of tasks of a new task definition
+	// that may stop during a deployment before it is considered failed. Services
+	// with a lower desired count fail once that many tasks have stopped.
+	deploymentFailedTasksThreshold = 3
+
+	// deploymentStatusPrimary is the status of the most recent deployment of a service
+	deploymentStatusPrimary = "PRIMARY"
 )
 
+// deploymentFailedError is returned when a deployment has not become stable before the timeout or
+// too many of its tasks stopped, which are the failures a deployment is rolled back on
+type deploymentFailedError struct {
+	message string
+}
+
+func (e *deploymentFailedError) Error() string {
+	return e.message
+}
+
+// isDeploymentFailure returns true if err means the deployment failed, rather than an error calling
+// ECS or an interruption, which leave the deployment as it is
+func isDeploymentFailure(err error) bool {
+	_, ok := errors.Cause(err).(*deploymentFailedError)
+	return ok
+}
+
+// describeServiceFailureError is returned when DescribeServices reports a failure for the service
+// instead of returning it
+type describeServiceFailureError struct {
+	serviceName string
+	reason      string
+}
+
+func (e *describeServiceFailureError) Error() string {
+	return fmt.Sprintf("Got an error describing service '%s' : '%s'", e.serviceName, e.reason)
+}
+
+// isServiceMissing returns true if err is the failure DescribeServices reports for a service that does not exist
+func isServiceMissing(err error) bool {
+	failure, ok := errors.Cause(err).(*describeServiceFailureError)
+	return ok && failure.reason == ecsMissingResourceCode
+}
+
 // serviceEvents is a wrapper for []*ecs.ServiceEvent
 // that allows us to sort it by the timestamp
 type serviceEvents []*ecs.ServiceEvent
@@ -55,27 +100,36 @@ func (s serviceEvents) Less(i, j int) bool {
 
 // logNewServiceEvents logs events that have not been logged yet
 func logNewServiceEvents(loggedEvents map[string]bool, events []*ecs.ServiceEvent, actionInvokedAt time.Time) {
+	for _, event := range newServiceEvents(loggedEvents, events, actionInvokedAt) {
+		log.WithFields(log.Fields{
+			"timestamp": *event.CreatedAt},
+		).Info(aws.StringValue(event.Message))
+	}
+}
+
+// newServiceEvents returns the events created since the action was invoked that have not been seen yet,
+// oldest first
+func newServiceEvents(seenEvents map[string]bool, events []*ecs.ServiceEvent, actionInvokedAt time.Time) []*ecs.ServiceEvent {
+	var newEvents []*ecs.ServiceEvent
 
 	// sort the events so that newer ones are printed last
 	sort.Sort(serviceEvents(events))
 	for _, event := range events {
-		if _, ok := loggedEvents[*event.Id]; !ok {
-			// New event that has not been logged yet
-			loggedEvents[*event.Id] = true
+		if _, ok := seenEvents[*event.Id]; !ok {
+			// New event that has not been seen yet
+			seenEvents[*event.Id] = true
 			if actionInvokedAt.Sub(*event.CreatedAt).Seconds() < latestEventWindow {
-				log.WithFields(log.Fields{
-					"timestamp": *event.CreatedAt},
-				).Info(aws.StringValue(event.Message))
+				newEvents = append(newEvents, event)
 			}
 		}
 	}
-
+	return newEvents
 }
 
 func waitForServiceDescribable(service *Service) error {
 	eventsLogged := make(map[string]bool)
 	timeOut := float64(DefaultUpdateServiceTimeout)
-	actionInvokedAt := time.Now()
+	actionInvokedAt := now()
 
 	if val := service.Context().CLIContext.Float64(flags.ComposeServiceTimeOutFlag); val > 0 {
 		timeOut = val
@@ -86,7 +140,7 @@ func waitForServiceDescribable(service *Service) error {
 		return nil
 	}
 
-	return waiters.ServiceWaitUntilComplete(func(retryCount int) (bool, error) {
+	return waiters.ServiceWaitUntilComplete(service.Context().CommandConfig.Context(), func(retryCount int) (bool, error) {
 		if ecsService, err := service.describeService(); err == nil {
 			// log new service events
 			if len(ecsService.Events) > 0 {
@@ -95,7 +149,7 @@ func waitForServiceDescribable(service *Service) error {
 			return true, nil
 		}
 
-		if time.Since(actionInvokedAt).Minutes() > timeOut {
+		if now().Sub(actionInvokedAt).Minutes() > timeOut {
 			return false, fmt.Errorf("Deployment has not completed: Service can't be described after %.2f minutes", timeOut)
 		}
 
@@ -107,11 +161,17 @@ func waitForServiceDescribable(service *Service) error {
 // waitForServiceTasks continuously polls ECS (by calling describeService) and waits for service to get stable
 // with desiredCount == runningCount
 func waitForServiceTasks(service *Service, ecsServiceName string) error {
+	return waitForServiceDeployment(service, ecsServiceName, "")
+}
+
+// waitForServiceDeployment waits for the service to get stable like waitForServiceTasks. If taskDefinitionArn
+// is set, it also fails once too many tasks of that task definition started by its deployment have stopped.
+func waitForServiceDeployment(service *Service, ecsServiceName, taskDefinitionArn string) error {
 	eventsLogged := make(map[string]bool)
 	var lastRunningCount int64
-	lastRunningCountChangedAt := time.Now()
+	lastRunningCountChangedAt := now()
 	timeOut := float64(DefaultUpdateServiceTimeout)
-	actionInvokedAt := time.Now()
+	actionInvokedAt := now()
 
 	if val := service.Context().CLIContext.Float64(flags.ComposeServiceTimeOutFlag); val > 0 {
 		timeOut = val
@@ -122,11 +182,18 @@ func waitForServiceTasks(service *Service, ecsServiceName string) error {
 		return nil
 	}
 
-	return waiters.ServiceWaitUntilComplete(func(retryCount int) (bool, error) {
+	progress := service.progress
+	if progress != nil {
+		defer progress.Close()
+	}
+
+	var lastService *ecs.Service
+	err := waiters.ServiceWaitUntilComplete(service.Context().CommandConfig.Context(), func(retryCount int) (bool, error) {
 		ecsService, err := service.describeService()
 		if err != nil {
 			return false, err
 		}
+		lastService = ecsService
 
 		desiredCount := aws.Int64Value(ecsService.DesiredCount)
 		runningCount := aws.Int64Value(ecsService.RunningCount)
@@ -140,26 +207,149 @@ func waitForServiceTasks(service *Service, ecsServiceName string) error {
 		// Log if running count has changed
 		if runningCount != lastRunningCount {
 			lastRunningCount = runningCount
-			lastRunningCountChangedAt = time.Now()
-			log.WithFields(logFields).Info("Service status")
+			lastRunningCountChangedAt = now()
+			if progress == nil {
+				log.WithFields(logFields).Info("Service status")
+			}
 		}
 
-		// log new service events
-		if len(ecsService.Events) > 0 {
+		// show the deployments, or the task sets, and new service events
+		if progress != nil {
+			newEvents := newServiceEvents(eventsLogged, ecsService.Events, actionInvokedAt)
+			if usesTaskSets(ecsService) {
+				progress.UpdateTaskSets(ecsServiceName, ecsService.TaskSets, newEvents)
+			} else {
+				progress.UpdateDeployments(ecsServiceName, ecsService.Deployments, newEvents)
+			}
+		} else if len(ecsService.Events) > 0 {
 			logNewServiceEvents(eventsLogged, ecsService.Events, actionInvokedAt)
 		}
 
 		// The deployment was successful
-		if len(ecsService.Deployments) == 1 && desiredCount == runningCount {
+		if deploymentCompleted(ecsService) && desiredCount == runningCount {
 			log.WithFields(logFields).Info("ECS Service has reached a stable state")
 			return true, nil
 		}
 
-		if time.Since(lastRunningCountChangedAt).Minutes() > timeOut {
-			return false, fmt.Errorf("Deployment has not completed: Running count has not changed for %.2f minutes", timeOut)
+		if deployment := primaryDeployment(ecsService); taskDefinitionArn != "" && deployment != nil &&
+			aws.StringValue(deployment.TaskDefinition) == taskDefinitionArn {
+			createdAt := aws.TimeValue(deployment.CreatedAt)
+			if err := checkStoppedTasks(service, ecsServiceName, taskDefinitionArn, createdAt, desiredCount); err != nil {
+				return false, err
+			}
+		}
+
+		if now().Sub(lastRunningCountChangedAt).Minutes() > timeOut {
+			return false, &deploymentFailedError{fmt.Sprintf("Deployment has not completed: Running count has not changed for %.2f minutes", timeOut)}
 		}
 
 		return false, nil
 
 	}, service)
+
+	if utils.IsInterrupted(err) && lastService != nil {
+		logServiceLeftBehind(lastService)
+	}
+	return err
+}
+
+// logServiceLeftBehind logs the deployments and task sets of the service once the CLI stopped
+// waiting for it because the command was interrupted or its --timeout expired. ECS carries on
+// with the deployment in the background.
+func logServiceLeftBehind(ecsService *ecs.Service) {
+	serviceName := aws.StringValue(ecsService.ServiceName)
+	for _, deployment := range ecsService.Deployments {
+		log.WithFields(log.Fields{
+			"serviceName":    serviceName,
+			"deployment":     aws.StringValue(deployment.Id),
+			"status":         aws.StringValue(deployment.Status),
+			"taskDefinition": entity.GetIdFromArn(deployment.TaskDefinition),
+			"desiredCount":   aws.Int64Value(deployment.DesiredCount),
+			"pendingCount":   aws.Int64Value(deployment.PendingCount),
+			"runningCount":   aws.Int64Value(deployment.RunningCount),
+		}).Warn("Stopped waiting for the service deployment")
+	}
+	for _, taskSet := range ecsService.TaskSets {
+		logTaskSetLeftBehind(serviceName, taskSet)
+	}
+}
+
+// logTaskSetLeftBehind logs the task set once the CLI stopped waiting for it
+func logTaskSetLeftBehind(serviceName string, taskSet *ecs.TaskSet) {
+	log.WithFields(log.Fields{
+		"serviceName":    serviceName,
+		"taskSet":        aws.StringValue(taskSet.Id),
+		"status":         aws.StringValue(taskSet.Status),
+		"taskDefinition": entity.GetIdFromArn(taskSet.TaskDefinition),
+		"desiredCount":   aws.Int64Value(taskSet.ComputedDesiredCount),
+		"pendingCount":   aws.Int64Value(taskSet.PendingCount),
+		"runningCount":   aws.Int64Value(taskSet.RunningCount),
+	}).Warn("Stopped waiting for the task set")
+}
+
+// primaryDeployment returns the most recent deployment of the service
+func primaryDeployment(ecsService *ecs.Service) *ecs.Deployment {
+	for _, deployment := range ecsService.Deployments {
+		if aws.StringValue(deployment.Status) == deploymentStatusPrimary {
+			return deployment
+		}
+	}
+	return nil
+}
+
+// checkStoppedTasks returns an error describing why the tasks of the task definition keep stopping if
+// at least deploymentFailedTasksThreshold of them, or desiredCount if lower, were created since createdAt.
+// createdAt is the time ECS created the deployment, so that it compares with the times ECS created the tasks.
+func checkStoppedTasks(service *Service, ecsServiceName, taskDefinitionArn string, createdAt time.Time, desiredCount int64) error {
+	threshold := int64(deploymentFailedTasksThreshold)
+	if desiredCount < threshold {
+		threshold = desiredCount
+	}
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	input := &ecs.ListTasksInput{
+		ServiceName:   aws.String(ecsServiceName),
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+	}
+	var stoppedTasks []*ecs.Task
+	err := service.Context().ECSClient.GetTasksPages(input, func(tasks []*ecs.Task) error {
+		for _, task := range tasks {
+			if aws.StringValue(task.TaskDefinitionArn) == taskDefinitionArn && task.CreatedAt != nil && !task.CreatedAt.Before(createdAt) {
+				stoppedTasks = append(stoppedTasks, task)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if int64(len(stoppedTasks)) < threshold {
+		return nil
+	}
+	return &deploymentFailedError{fmt.Sprintf("Deployment has failed: %d tasks of the new task definition have stopped; %s", len(stoppedTasks), stoppedTaskReason(stoppedTasks[0]))}
+}
+
+// stoppedTaskReason describes why a task stopped, including the exit codes of its containers that failed
+func stoppedTaskReason(task *ecs.Task) string {
+	reason := fmt.Sprintf("task %s stopped: %s", entity.GetIdFromArn(task.TaskArn), aws.StringValue(task.StoppedReason))
+	for _, container := range task.Containers {
+		if container.ExitCode != nil && aws.Int64Value(container.ExitCode) != 0 {
+			reason += fmt.Sprintf(", container %s exited with code %d", aws.StringValue(container.Name), aws.Int64Value(container.ExitCode))
+		} else if container.Reason != nil {
+			reason += fmt.Sprintf(", container %s: %s", aws.StringValue(container.Name), aws.StringValue(container.Reason))
+		}
+	}
+	return reason
+}
+
+// deploymentCompleted returns true once a single deployment, or a single task set for services
+// using the EXTERNAL deployment controller, is left in the service
+func deploymentCompleted(ecsService *ecs.Service) bool {
+	if usesTaskSets(ecsService) {
+		return len(ecsService.TaskSets) <= 1
+	}
+	return len(ecsService.Deployments) == 1
 }
@@ -0,0 +1,351 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Values of the --progress flag
+const (
+	progressAuto  = "auto"
+	progressTTY   = "tty"
+	progressPlain = "plain"
+	progressJSON  = "json"
+)
+
+// make the progress output and the clock easily mockable in tests
+var progressOutput io.Writer = os.Stdout
+var now = time.Now
+
+// deploymentProgress shows the deployments of a service, or its task sets for services
+// using the EXTERNAL deployment controller, and its new events while the CLI waits for
+// the service to become stable
+type deploymentProgress interface {
+	UpdateDeployments(serviceName string, deployments []*ecs.Deployment, newEvents []*ecs.ServiceEvent)
+	UpdateTaskSets(serviceName string, taskSets []*ecs.TaskSet, newEvents []*ecs.ServiceEvent)
+	Close()
+}
+
+// newDeploymentProgress returns how progress is shown for the value of the --progress flag.
+// It returns nil when the flag is not set, in which case progress is logged.
+func newDeploymentProgress(mode string, out io.Writer) (deploymentProgress, error) {
+	switch strings.ToLower(mode) {
+	case "":
+		return nil, nil
+	case progressAuto:
+		if isTerminal(out) {
+			return &tableProgress{out: out}, nil
+		}
+		return newLineProgress(out), nil
+	case progressTTY:
+		return &tableProgress{out: out}, nil
+	case progressPlain:
+		return newLineProgress(out), nil
+	case progressJSON:
+		return newJSONProgress(out), nil
+	}
+	return nil, fmt.Errorf("progress must be one of %s, %s, %s or %s, found '%s'", progressAuto, progressTTY, progressPlain, progressJSON, mode)
+}
+
+func isTerminal(out io.Writer) bool {
+	file, ok := out.(*os.File)
+	return ok && terminal.IsTerminal(int(file.Fd()))
+}
+
+// deploymentAge returns how long ago the deployment was created, rounded down to the second
+func deploymentAge(deployment *ecs.Deployment) time.Duration {
+	if deployment.CreatedAt == nil {
+		return 0
+	}
+	return now().Sub(*deployment.CreatedAt).Truncate(time.Second)
+}
+
+// taskSetScale returns the scale of the task set as a percentage of the desired count of its service
+func taskSetScale(taskSet *ecs.TaskSet) string {
+	if taskSet.Scale == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%g%%", aws.Float64Value(taskSet.Scale.Value))
+}
+
+// tableProgress redraws a table of the deployments or task sets in place, with new
+// events printed above it as they appear
+type tableProgress struct {
+	out        io.Writer
+	tableLines int
+}
+
+func (p *tableProgress) UpdateDeployments(serviceName string, deployments []*ecs.Deployment, newEvents []*ecs.ServiceEvent) {
+	rows := make([]string, 0, len(deployments))
+	for _, deployment := range deployments {
+		rows = append(rows, fmt.Sprintf("%s\t%s\t%s\t%d\t%d\t%d\t%s",
+			aws.StringValue(deployment.Id),
+			aws.StringValue(deployment.Status),
+			entity.GetIdFromArn(deployment.TaskDefinition),
+			aws.Int64Value(deployment.DesiredCount),
+			aws.Int64Value(deployment.PendingCount),
+			aws.Int64Value(deployment.RunningCount),
+			deploymentAge(deployment)))
+	}
+	p.redraw("DEPLOYMENT\tSTATUS\tTASK DEFINITION\tDESIRED\tPENDING\tRUNNING\tAGE", rows, newEvents)
+}
+
+func (p *tableProgress) UpdateTaskSets(serviceName string, taskSets []*ecs.TaskSet, newEvents []*ecs.ServiceEvent) {
+	rows := make([]string, 0, len(taskSets))
+	for _, taskSet := range taskSets {
+		rows = append(rows, fmt.Sprintf("%s\t%s\t%s\t%s\t%d\t%d\t%d\t%s",
+			aws.StringValue(taskSet.Id),
+			aws.StringValue(taskSet.Status),
+			entity.GetIdFromArn(taskSet.TaskDefinition),
+			taskSetScale(taskSet),
+			aws.Int64Value(taskSet.ComputedDesiredCount),
+			aws.Int64Value(taskSet.PendingCount),
+			aws.Int64Value(taskSet.RunningCount),
+			aws.StringValue(taskSet.StabilityStatus)))
+	}
+	p.redraw("TASK SET\tSTATUS\tTASK DEFINITION\tSCALE\tDESIRED\tPENDING\tRUNNING\tSTABILITY", rows, newEvents)
+}
+
+// redraw replaces the previous table with the new events and the given header and rows
+func (p *tableProgress) redraw(header string, rows []string, newEvents []*ecs.ServiceEvent) {
+	if p.tableLines > 0 {
+		// move the cursor up to the first line of the table and clear the screen below it
+		fmt.Fprintf(p.out, "\x1b[%dA\x1b[J", p.tableLines)
+	}
+	for _, event := range newEvents {
+		fmt.Fprintf(p.out, "%s %s\n", aws.TimeValue(event.CreatedAt).Format(time.RFC3339), aws.StringValue(event.Message))
+	}
+
+	w := tabwriter.NewWriter(p.out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, header)
+	for _, row := range rows {
+		fmt.Fprintln(w, row)
+	}
+	w.Flush()
+	p.tableLines = len(rows) + 1
+}
+
+// Close leaves the last table on the screen
+func (p *tableProgress) Close() {
+	p.tableLines = 0
+}
+
+// deploymentState is what changes in a deployment as it progresses
+func deploymentState(deployment *ecs.Deployment) string {
+	return fmt.Sprintf("%s/%d/%d/%d", aws.StringValue(deployment.Status),
+		aws.Int64Value(deployment.DesiredCount), aws.Int64Value(deployment.PendingCount), aws.Int64Value(deployment.RunningCount))
+}
+
+// changedDeployments returns the deployments whose state changed since they were last shown
+func changedDeployments(shown map[string]string, deployments []*ecs.Deployment) []*ecs.Deployment {
+	var changed []*ecs.Deployment
+	for _, deployment := range deployments {
+		id := aws.StringValue(deployment.Id)
+		if state := deploymentState(deployment); shown[id] != state {
+			shown[id] = state
+			changed = append(changed, deployment)
+		}
+	}
+	return changed
+}
+
+// taskSetState is what changes in a task set as it progresses
+func taskSetState(taskSet *ecs.TaskSet) string {
+	return fmt.Sprintf("%s/%s/%d/%d/%d/%s", aws.StringValue(taskSet.Status), taskSetScale(taskSet),
+		aws.Int64Value(taskSet.ComputedDesiredCount), aws.Int64Value(taskSet.PendingCount), aws.Int64Value(taskSet.RunningCount),
+		aws.StringValue(taskSet.StabilityStatus))
+}
+
+// changedTaskSets returns the task sets whose state changed since they were last shown
+func changedTaskSets(shown map[string]string, taskSets []*ecs.TaskSet) []*ecs.TaskSet {
+	var changed []*ecs.TaskSet
+	for _, taskSet := range taskSets {
+		id := aws.StringValue(taskSet.Id)
+		if state := taskSetState(taskSet); shown[id] != state {
+			shown[id] = state
+			changed = append(changed, taskSet)
+		}
+	}
+	return changed
+}
+
+// lineProgress appends a line for every change of a deployment or task set and every
+// new event, for output that is not a terminal
+type lineProgress struct {
+	out   io.Writer
+	shown map[string]string
+}
+
+func newLineProgress(out io.Writer) *lineProgress {
+	return &lineProgress{out: out, shown: make(map[string]string)}
+}
+
+func (p *lineProgress) UpdateDeployments(serviceName string, deployments []*ecs.Deployment, newEvents []*ecs.ServiceEvent) {
+	timestamp := now().Format(time.RFC3339)
+	for _, deployment := range changedDeployments(p.shown, deployments) {
+		fmt.Fprintf(p.out, "%s service=%s deployment=%s status=%s taskDefinition=%s desired=%d pending=%d running=%d age=%s\n",
+			timestamp,
+			serviceName,
+			aws.StringValue(deployment.Id),
+			aws.StringValue(deployment.Status),
+			entity.GetIdFromArn(deployment.TaskDefinition),
+			aws.Int64Value(deployment.DesiredCount),
+			aws.Int64Value(deployment.PendingCount),
+			aws.Int64Value(deployment.RunningCount),
+			deploymentAge(deployment))
+	}
+	p.writeEvents(serviceName, newEvents)
+}
+
+func (p *lineProgress) UpdateTaskSets(serviceName string, taskSets []*ecs.TaskSet, newEvents []*ecs.ServiceEvent) {
+	timestamp := now().Format(time.RFC3339)
+	for _, taskSet := range changedTaskSets(p.shown, taskSets) {
+		fmt.Fprintf(p.out, "%s service=%s taskSet=%s status=%s taskDefinition=%s scale=%s desired=%d pending=%d running=%d stability=%s\n",
+			timestamp,
+			serviceName,
+			aws.StringValue(taskSet.Id),
+			aws.StringValue(taskSet.Status),
+			entity.GetIdFromArn(taskSet.TaskDefinition),
+			taskSetScale(taskSet),
+			aws.Int64Value(taskSet.ComputedDesiredCount),
+			aws.Int64Value(taskSet.PendingCount),
+			aws.Int64Value(taskSet.RunningCount),
+			aws.StringValue(taskSet.StabilityStatus))
+	}
+	p.writeEvents(serviceName, newEvents)
+}
+
+func (p *lineProgress) writeEvents(serviceName string, newEvents []*ecs.ServiceEvent) {
+	for _, event := range newEvents {
+		fmt.Fprintf(p.out, "%s service=%s event=%q\n", aws.TimeValue(event.CreatedAt).Format(time.RFC3339), serviceName, aws.StringValue(event.Message))
+	}
+}
+
+func (p *lineProgress) Close() {}
+
+// deploymentRecord is the NDJSON record of a change of a deployment
+type deploymentRecord struct {
+	Type           string    `json:"type"`
+	Time           time.Time `json:"time"`
+	Service        string    `json:"service"`
+	Deployment     string    `json:"deployment"`
+	Status         string    `json:"status"`
+	TaskDefinition string    `json:"taskDefinition"`
+	DesiredCount   int64     `json:"desiredCount"`
+	PendingCount   int64     `json:"pendingCount"`
+	RunningCount   int64     `json:"runningCount"`
+	AgeSeconds     int64     `json:"ageSeconds"`
+}
+
+// taskSetRecord is the NDJSON record of a change of a task set
+type taskSetRecord struct {
+	Type            string    `json:"type"`
+	Time            time.Time `json:"time"`
+	Service         string    `json:"service"`
+	TaskSet         string    `json:"taskSet"`
+	Status          string    `json:"status"`
+	TaskDefinition  string    `json:"taskDefinition"`
+	ScalePercent    *float64  `json:"scalePercent,omitempty"`
+	DesiredCount    int64     `json:"desiredCount"`
+	PendingCount    int64     `json:"pendingCount"`
+	RunningCount    int64     `json:"runningCount"`
+	StabilityStatus string    `json:"stabilityStatus"`
+}
+
+// eventRecord is the NDJSON record of a service event
+type eventRecord struct {
+	Type    string    `json:"type"`
+	Time    time.Time `json:"time"`
+	Service string    `json:"service"`
+	ID      string    `json:"id"`
+	Message string    `json:"message"`
+}
+
+// jsonProgress writes the same data as lineProgress as newline delimited JSON
+type jsonProgress struct {
+	encoder *json.Encoder
+	shown   map[string]string
+}
+
+func newJSONProgress(out io.Writer) *jsonProgress {
+	return &jsonProgress{encoder: json.NewEncoder(out), shown: make(map[string]string)}
+}
+
+func (p *jsonProgress) UpdateDeployments(serviceName string, deployments []*ecs.Deployment, newEvents []*ecs.ServiceEvent) {
+	timestamp := now().UTC()
+	for _, deployment := range changedDeployments(p.shown, deployments) {
+		p.encoder.Encode(deploymentRecord{
+			Type:           "deployment",
+			Time:           timestamp,
+			Service:        serviceName,
+			Deployment:     aws.StringValue(deployment.Id),
+			Status:         aws.StringValue(deployment.Status),
+			TaskDefinition: aws.StringValue(deployment.TaskDefinition),
+			DesiredCount:   aws.Int64Value(deployment.DesiredCount),
+			PendingCount:   aws.Int64Value(deployment.PendingCount),
+			RunningCount:   aws.Int64Value(deployment.RunningCount),
+			AgeSeconds:     int64(deploymentAge(deployment).Seconds()),
+		})
+	}
+	p.writeEvents(serviceName, newEvents)
+}
+
+func (p *jsonProgress) UpdateTaskSets(serviceName string, taskSets []*ecs.TaskSet, newEvents []*ecs.ServiceEvent) {
+	timestamp := now().UTC()
+	for _, taskSet := range changedTaskSets(p.shown, taskSets) {
+		record := taskSetRecord{
+			Type:            "taskSet",
+			Time:            timestamp,
+			Service:         serviceName,
+			TaskSet:         aws.StringValue(taskSet.Id),
+			Status:          aws.StringValue(taskSet.Status),
+			TaskDefinition:  aws.StringValue(taskSet.TaskDefinition),
+			DesiredCount:    aws.Int64Value(taskSet.ComputedDesiredCount),
+			PendingCount:    aws.Int64Value(taskSet.PendingCount),
+			RunningCount:    aws.Int64Value(taskSet.RunningCount),
+			StabilityStatus: aws.StringValue(taskSet.StabilityStatus),
+		}
+		if taskSet.Scale != nil {
+			record.ScalePercent = taskSet.Scale.Value
+		}
+		p.encoder.Encode(record)
+	}
+	p.writeEvents(serviceName, newEvents)
+}
+
+func (p *jsonProgress) writeEvents(serviceName string, newEvents []*ecs.ServiceEvent) {
+	for _, event := range newEvents {
+		p.encoder.Encode(eventRecord{
+			Type:    "event",
+			Time:    aws.TimeValue(event.CreatedAt).UTC(),
+			Service: serviceName,
+			ID:      aws.StringValue(event.Id),
+			Message: aws.StringValue(event.Message),
+		})
+	}
+}
+
+func (p *jsonProgress) Close() {}
@@ -0,0 +1,265 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+var progressTestTime = time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func mockProgressClock() func() {
+	now = func() time.Time { return progressTestTime }
+	return func() { now = time.Now }
+}
+
+func progressTestDeployments(primaryRunning int64) []*ecs.Deployment {
+	return []*ecs.Deployment{
+		{
+			Id:             aws.String("ecs-svc/2"),
+			Status:         aws.String("PRIMARY"),
+			TaskDefinition: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/web:2"),
+			DesiredCount:   aws.Int64(2),
+			PendingCount:   aws.Int64(2 - primaryRunning),
+			RunningCount:   aws.Int64(primaryRunning),
+			CreatedAt:      aws.Time(progressTestTime.Add(-90 * time.Second)),
+		},
+		{
+			Id:             aws.String("ecs-svc/1"),
+			Status:         aws.String("ACTIVE"),
+			TaskDefinition: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/web:1"),
+			DesiredCount:   aws.Int64(2),
+			PendingCount:   aws.Int64(0),
+			RunningCount:   aws.Int64(2),
+			CreatedAt:      aws.Time(progressTestTime.Add(-time.Hour)),
+		},
+	}
+}
+
+func progressTestTaskSets(canaryRunning int64) []*ecs.TaskSet {
+	return []*ecs.TaskSet{
+		{
+			Id:                   aws.String("ecs-svc/2"),
+			Status:               aws.String("ACTIVE"),
+			TaskDefinition:       aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/web:2"),
+			Scale:                &ecs.Scale{Unit: aws.String(ecs.ScaleUnitPercent), Value: aws.Float64(50)},
+			ComputedDesiredCount: aws.Int64(1),
+			PendingCount:         aws.Int64(1 - canaryRunning),
+			RunningCount:         aws.Int64(canaryRunning),
+			StabilityStatus:      aws.String(ecs.StabilityStatusStabilizing),
+		},
+		{
+			Id:                   aws.String("ecs-svc/1"),
+			Status:               aws.String("PRIMARY"),
+			TaskDefinition:       aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/web:1"),
+			Scale:                &ecs.Scale{Unit: aws.String(ecs.ScaleUnitPercent), Value: aws.Float64(100)},
+			ComputedDesiredCount: aws.Int64(2),
+			PendingCount:         aws.Int64(0),
+			RunningCount:         aws.Int64(2),
+			StabilityStatus:      aws.String(ecs.StabilityStatusSteadyState),
+		},
+	}
+}
+
+func progressTestEvent(id, message string) *ecs.ServiceEvent {
+	return &ecs.ServiceEvent{
+		Id:        aws.String(id),
+		Message:   aws.String(message),
+		CreatedAt: aws.Time(progressTestTime.Add(-10 * time.Second)),
+	}
+}
+
+func TestNewDeploymentProgress(t *testing.T) {
+	out := &bytes.Buffer{}
+
+	progress, err := newDeploymentProgress("", out)
+	assert.NoError(t, err, "Unexpected error when progress is not set")
+	assert.Nil(t, progress, "Expected progress to be logged when it is not set")
+
+	progress, err = newDeploymentProgress("auto", out)
+	assert.NoError(t, err, "Unexpected error for auto progress")
+	assert.IsType(t, &lineProgress{}, progress, "Expected lines when the output is not a terminal")
+
+	progress, err = newDeploymentProgress("TTY", out)
+	assert.NoError(t, err, "Unexpected error for tty progress")
+	assert.IsType(t, &tableProgress{}, progress)
+
+	progress, err = newDeploymentProgress("plain", out)
+	assert.NoError(t, err, "Unexpected error for plain progress")
+	assert.IsType(t, &lineProgress{}, progress)
+
+	progress, err = newDeploymentProgress("json", out)
+	assert.NoError(t, err, "Unexpected error for json progress")
+	assert.IsType(t, &jsonProgress{}, progress)
+
+	_, err = newDeploymentProgress("fancy", out)
+	assert.Error(t, err, "Expected error for invalid progress")
+}
+
+func TestTableProgress(t *testing.T) {
+	defer mockProgressClock()()
+	out := &bytes.Buffer{}
+	progress := &tableProgress{out: out}
+
+	progress.UpdateDeployments("web", progressTestDeployments(0), nil)
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	assert.Len(t, lines, 3, "Expected a header and a line per deployment")
+	assert.Equal(t, []string{"DEPLOYMENT", "STATUS", "TASK", "DEFINITION", "DESIRED", "PENDING", "RUNNING", "AGE"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"ecs-svc/2", "PRIMARY", "web:2", "2", "2", "0", "1m30s"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"ecs-svc/1", "ACTIVE", "web:1", "2", "0", "2", "1h0m0s"}, strings.Fields(lines[2]))
+
+	out.Reset()
+	progress.UpdateDeployments("web", progressTestDeployments(1), []*ecs.ServiceEvent{progressTestEvent("1", "(service web) has started 1 tasks")})
+	output := out.String()
+	assert.True(t, strings.HasPrefix(output, "\x1b[3A\x1b[J"), "Expected the previous table to be cleared")
+	lines = strings.Split(strings.TrimSuffix(strings.TrimPrefix(output, "\x1b[3A\x1b[J"), "\n"), "\n")
+	assert.Len(t, lines, 4, "Expected the event above the table")
+	assert.Equal(t, "2019-03-01T11:59:50Z (service web) has started 1 tasks", lines[0])
+	assert.Equal(t, []string{"ecs-svc/2", "PRIMARY", "web:2", "2", "1", "1", "1m30s"}, strings.Fields(lines[2]))
+
+	progress.Close()
+	out.Reset()
+	progress.UpdateDeployments("web", progressTestDeployments(2), nil)
+	assert.False(t, strings.HasPrefix(out.String(), "\x1b["), "Expected the table to be kept after progress is closed")
+}
+
+func TestLineProgress(t *testing.T) {
+	defer mockProgressClock()()
+	out := &bytes.Buffer{}
+	progress := newLineProgress(out)
+
+	progress.UpdateDeployments("web", progressTestDeployments(0), nil)
+	assert.Equal(t,
+		"2019-03-01T12:00:00Z service=web deployment=ecs-svc/2 status=PRIMARY taskDefinition=web:2 desired=2 pending=2 running=0 age=1m30s\n"+
+			"2019-03-01T12:00:00Z service=web deployment=ecs-svc/1 status=ACTIVE taskDefinition=web:1 desired=2 pending=0 running=2 age=1h0m0s\n",
+		out.String())
+
+	out.Reset()
+	progress.UpdateDeployments("web", progressTestDeployments(0), nil)
+	assert.Empty(t, out.String(), "Expected no lines when nothing changed")
+
+	progress.UpdateDeployments("web", progressTestDeployments(1), []*ecs.ServiceEvent{progressTestEvent("1", "(service web) has started 1 tasks")})
+	assert.Equal(t,
+		"2019-03-01T12:00:00Z service=web deployment=ecs-svc/2 status=PRIMARY taskDefinition=web:2 desired=2 pending=1 running=1 age=1m30s\n"+
+			"2019-03-01T11:59:50Z service=web event=\"(service web) has started 1 tasks\"\n",
+		out.String())
+}
+
+func TestJSONProgress(t *testing.T) {
+	defer mockProgressClock()()
+	out := &bytes.Buffer{}
+	progress := newJSONProgress(out)
+
+	progress.UpdateDeployments("web", progressTestDeployments(1), []*ecs.ServiceEvent{progressTestEvent("1", "(service web) has started 1 tasks")})
+	progress.UpdateDeployments("web", progressTestDeployments(1), nil)
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	assert.Len(t, lines, 3, "Expected a record per deployment and event")
+
+	deployment := deploymentRecord{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &deployment), "Unexpected error decoding deployment record")
+	assert.Equal(t, deploymentRecord{
+		Type:           "deployment",
+		Time:           progressTestTime,
+		Service:        "web",
+		Deployment:     "ecs-svc/2",
+		Status:         "PRIMARY",
+		TaskDefinition: "arn:aws:ecs:us-west-2:123456789012:task-definition/web:2",
+		DesiredCount:   2,
+		PendingCount:   1,
+		RunningCount:   1,
+		AgeSeconds:     90,
+	}, deployment)
+
+	event := eventRecord{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[2]), &event), "Unexpected error decoding event record")
+	assert.Equal(t, eventRecord{
+		Type:    "event",
+		Time:    progressTestTime.Add(-10 * time.Second),
+		Service: "web",
+		ID:      "1",
+		Message: "(service web) has started 1 tasks",
+	}, event)
+}
+
+func TestTableProgressWithTaskSets(t *testing.T) {
+	out := &bytes.Buffer{}
+	progress := &tableProgress{out: out}
+
+	progress.UpdateTaskSets("web", progressTestTaskSets(0), nil)
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	assert.Len(t, lines, 3, "Expected a header and a line per task set")
+	assert.Equal(t, []string{"TASK", "SET", "STATUS", "TASK", "DEFINITION", "SCALE", "DESIRED", "PENDING", "RUNNING", "STABILITY"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"ecs-svc/2", "ACTIVE", "web:2", "50%", "1", "1", "0", "STABILIZING"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"ecs-svc/1", "PRIMARY", "web:1", "100%", "2", "0", "2", "STEADY_STATE"}, strings.Fields(lines[2]))
+
+	out.Reset()
+	progress.UpdateTaskSets("web", progressTestTaskSets(1), nil)
+	assert.True(t, strings.HasPrefix(out.String(), "\x1b[3A\x1b[J"), "Expected the previous table to be cleared")
+}
+
+func TestLineProgressWithTaskSets(t *testing.T) {
+	defer mockProgressClock()()
+	out := &bytes.Buffer{}
+	progress := newLineProgress(out)
+
+	progress.UpdateTaskSets("web", progressTestTaskSets(0), nil)
+	assert.Equal(t,
+		"2019-03-01T12:00:00Z service=web taskSet=ecs-svc/2 status=ACTIVE taskDefinition=web:2 scale=50% desired=1 pending=1 running=0 stability=STABILIZING\n"+
+			"2019-03-01T12:00:00Z service=web taskSet=ecs-svc/1 status=PRIMARY taskDefinition=web:1 scale=100% desired=2 pending=0 running=2 stability=STEADY_STATE\n",
+		out.String())
+
+	out.Reset()
+	progress.UpdateTaskSets("web", progressTestTaskSets(1), []*ecs.ServiceEvent{progressTestEvent("1", "(service web) has started 1 tasks")})
+	assert.Equal(t,
+		"2019-03-01T12:00:00Z service=web taskSet=ecs-svc/2 status=ACTIVE taskDefinition=web:2 scale=50% desired=1 pending=0 running=1 stability=STABILIZING\n"+
+			"2019-03-01T11:59:50Z service=web event=\"(service web) has started 1 tasks\"\n",
+		out.String())
+}
+
+func TestJSONProgressWithTaskSets(t *testing.T) {
+	defer mockProgressClock()()
+	out := &bytes.Buffer{}
+	progress := newJSONProgress(out)
+
+	progress.UpdateTaskSets("web", progressTestTaskSets(1), nil)
+	progress.UpdateTaskSets("web", progressTestTaskSets(1), nil)
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	assert.Len(t, lines, 2, "Expected a record per task set")
+
+	taskSet := taskSetRecord{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &taskSet), "Unexpected error decoding task set record")
+	assert.Equal(t, taskSetRecord{
+		Type:            "taskSet",
+		Time:            progressTestTime,
+		Service:         "web",
+		TaskSet:         "ecs-svc/2",
+		Status:          "ACTIVE",
+		TaskDefinition:  "arn:aws:ecs:us-west-2:123456789012:task-definition/web:2",
+		ScalePercent:    aws.Float64(50),
+		DesiredCount:    1,
+		PendingCount:    0,
+		RunningCount:    1,
+		StabilityStatus: "STABILIZING",
+	}, taskSet)
+}
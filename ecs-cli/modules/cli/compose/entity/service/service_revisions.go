@@ -0,0 +1,202 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/docker/libcompose/project"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultRevisionsLimit and MaxRevisionsLimit bound the number of revisions listed, since
+	// each of them is described with its own DescribeTaskDefinition call
+	DefaultRevisionsLimit = 10
+	MaxRevisionsLimit     = 100
+
+	revisionsServiceKey    = "Service"
+	revisionsRevisionKey   = "Revision"
+	revisionsDeploymentKey = "Deployment"
+	revisionsRegisteredKey = "Registered"
+	revisionsImagesKey     = "Images"
+)
+
+// RevisionsInfoColumns is the ordered list of info columns for the revisions command
+var RevisionsInfoColumns = []string{revisionsServiceKey, revisionsRevisionKey, revisionsDeploymentKey, revisionsRegisteredKey, revisionsImagesKey}
+
+// Revisions returns the newest active revisions of the task definition family of the service,
+// with the images of their containers and when they were registered, whether or not the service
+// has run them. Revisions the service is deploying have the status of their deployment or task set.
+func (s *Service) Revisions() (project.InfoSet, error) {
+	limit := s.Context().CLIContext.Int(flags.RevisionsLimitFlag)
+	if limit < 1 || limit > MaxRevisionsLimit {
+		return nil, fmt.Errorf("--%s must be between 1 and %d, got %d", flags.RevisionsLimitFlag, MaxRevisionsLimit, limit)
+	}
+
+	ecsService, err := s.describeService()
+	if err != nil {
+		return nil, err
+	}
+	serviceName := aws.StringValue(ecsService.ServiceName)
+	family, _ := splitTaskDefinitionID(entity.GetIdFromArn(aws.String(deployedTaskDefinitionArn(ecsService))))
+
+	arns, err := s.Context().ECSClient.ListTaskDefinitionRevisions(family)
+	if err != nil {
+		return nil, err
+	}
+	if len(arns) > limit {
+		arns = arns[:limit]
+	}
+
+	deployments := deploymentStatuses(ecsService)
+	revisions := project.InfoSet{}
+	for _, arn := range arns {
+		revision, err := s.Context().ECSClient.DescribeTaskDefinition(arn)
+		if err != nil {
+			return nil, err
+		}
+		registered := ""
+		if revision.RegisteredAt != nil {
+			registered = revision.RegisteredAt.Format(time.RFC3339)
+		}
+		id := entity.GetIdFromArn(revision.TaskDefinitionArn)
+		revisions = append(revisions, project.Info{
+			revisionsServiceKey:    serviceName,
+			revisionsRevisionKey:   id,
+			revisionsDeploymentKey: deployments[id],
+			revisionsRegisteredKey: registered,
+			revisionsImagesKey:     containerImages(revision.ContainerDefinitions),
+		})
+	}
+	return revisions, nil
+}
+
+// Rollback updates the service to a revision of its task definition family and waits for it
+// to become stable. A revision of 0 rolls back to the newest active revision older than the
+// one the service is running.
+func (s *Service) Rollback(revision int64) error {
+	ecsService, err := s.describeService()
+	if err != nil {
+		return err
+	}
+	serviceName := aws.StringValue(ecsService.ServiceName)
+	currentID := entity.GetIdFromArn(aws.String(deployedTaskDefinitionArn(ecsService)))
+	family, currentRevision := splitTaskDefinitionID(currentID)
+
+	targetID := fmt.Sprintf("%s:%d", family, revision)
+	if revision == 0 {
+		if targetID, err = s.previousRevision(family, currentRevision); err != nil {
+			return err
+		}
+	}
+	if targetID == currentID {
+		log.WithFields(log.Fields{
+			"service":        serviceName,
+			"taskDefinition": currentID,
+		}).Info("ECS Service is already running this task definition")
+		return nil
+	}
+
+	taskDefinition, err := s.Context().ECSClient.DescribeTaskDefinition(targetID)
+	if err != nil {
+		return err
+	}
+
+	// services using the EXTERNAL deployment controller are deployed with task sets
+	if usesTaskSets(ecsService) {
+		return s.deployTaskSet(ecsService, taskDefinition, ecsService.DesiredCount)
+	}
+
+	input := &ecs.UpdateServiceInput{
+		Cluster:        aws.String(s.Context().CommandConfig.Cluster),
+		Service:        aws.String(serviceName),
+		TaskDefinition: aws.String(targetID),
+	}
+	if err = s.Context().ECSClient.UpdateService(input); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"service":                serviceName,
+		"taskDefinition":         targetID,
+		"previousTaskDefinition": currentID,
+	}).Info("Rolled back the ECS service")
+
+	return waitForServiceDeployment(s, serviceName, aws.StringValue(taskDefinition.TaskDefinitionArn))
+}
+
+// previousRevision returns the newest active revision of the family older than currentRevision
+func (s *Service) previousRevision(family string, currentRevision int64) (string, error) {
+	arns, err := s.Context().ECSClient.ListTaskDefinitionRevisions(family)
+	if err != nil {
+		return "", err
+	}
+	for _, arn := range arns {
+		id := entity.GetIdFromArn(aws.String(arn))
+		if _, revision := splitTaskDefinitionID(id); revision < currentRevision {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("No active revision of task definition %s is older than revision %d", family, currentRevision)
+}
+
+// deployedTaskDefinitionArn returns the task definition the service runs, which is the task
+// definition of the primary task set for services using the EXTERNAL deployment controller
+func deployedTaskDefinitionArn(ecsService *ecs.Service) string {
+	if primary := primaryTaskSet(ecsService); usesTaskSets(ecsService) && primary != nil {
+		return aws.StringValue(primary.TaskDefinition)
+	}
+	return aws.StringValue(ecsService.TaskDefinition)
+}
+
+// deploymentStatuses maps the task definitions of the deployments or task sets of the service to their status
+func deploymentStatuses(ecsService *ecs.Service) map[string]string {
+	statuses := make(map[string]string)
+	for _, deployment := range ecsService.Deployments {
+		statuses[entity.GetIdFromArn(deployment.TaskDefinition)] = aws.StringValue(deployment.Status)
+	}
+	for _, taskSet := range ecsService.TaskSets {
+		statuses[entity.GetIdFromArn(taskSet.TaskDefinition)] = aws.StringValue(taskSet.Status)
+	}
+	return statuses
+}
+
+// splitTaskDefinitionID splits a task definition ID of the form family:revision
+func splitTaskDefinitionID(id string) (string, int64) {
+	separator := strings.LastIndex(id, ":")
+	if separator < 0 {
+		return id, 0
+	}
+	revision, err := strconv.ParseInt(id[separator+1:], 10, 64)
+	if err != nil {
+		return id, 0
+	}
+	return id[:separator], revision
+}
+
+// containerImages summarizes the images of the containers as name=image
+func containerImages(containers []*ecs.ContainerDefinition) string {
+	images := []string{}
+	for _, container := range containers {
+		images = append(images, fmt.Sprintf("%s=%s", aws.StringValue(container.Name), aws.StringValue(container.Image)))
+	}
+	return strings.Join(images, ", ")
+}
@@ -0,0 +1,216 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/docker/libcompose/project"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const revisionsServiceName = "web"
+
+var familyRevisions = []string{arnPrefix + "web:3", arnPrefix + "web:2", arnPrefix + "web:1"}
+
+func getRevisionsTestService(taskDefinitionID string) *ecs.Service {
+	return &ecs.Service{
+		ServiceName:    aws.String(revisionsServiceName),
+		Status:         aws.String(ecsActiveResourceCode),
+		TaskDefinition: aws.String(arnPrefix + taskDefinitionID),
+		DesiredCount:   aws.Int64(2),
+		RunningCount:   aws.Int64(2),
+		Deployments: []*ecs.Deployment{
+			{Status: aws.String("PRIMARY"), TaskDefinition: aws.String(arnPrefix + taskDefinitionID)},
+		},
+	}
+}
+
+func newRevisionsTestService(t *testing.T, mockEcs *mock_ecs.MockECSClient, flagSet *flag.FlagSet) *Service {
+	ecsContext := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{Cluster: "cluster"},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+		ECSParams:     &utils.ECSParams{},
+		ProjectName:   revisionsServiceName,
+	}
+	service := NewService(ecsContext).(*Service)
+	assert.NoError(t, service.LoadContext(), "Unexpected error while loading context in revisions test")
+	return service
+}
+
+func TestServiceRevisions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	flagSet := flag.NewFlagSet("ecs-cli-revisions", 0)
+	flagSet.Int(flags.RevisionsLimitFlag, 2, "")
+
+	registeredAt := time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService(revisionsServiceName).Return(getDescribeServiceTestResponse(getRevisionsTestService("web:2")), nil),
+		mockEcs.EXPECT().ListTaskDefinitionRevisions("web").Return(familyRevisions, nil),
+		mockEcs.EXPECT().DescribeTaskDefinition(arnPrefix+"web:3").Return(&ecs.TaskDefinition{
+			TaskDefinitionArn: aws.String(arnPrefix + "web:3"),
+			RegisteredAt:      aws.Time(registeredAt),
+			ContainerDefinitions: []*ecs.ContainerDefinition{
+				{Name: aws.String("web"), Image: aws.String("nginx:1.16")},
+				{Name: aws.String("cache"), Image: aws.String("redis")},
+			},
+		}, nil),
+		mockEcs.EXPECT().DescribeTaskDefinition(arnPrefix+"web:2").Return(&ecs.TaskDefinition{
+			TaskDefinitionArn: aws.String(arnPrefix + "web:2"),
+			RegisteredAt:      aws.Time(registeredAt.Add(-time.Hour)),
+			ContainerDefinitions: []*ecs.ContainerDefinition{
+				{Name: aws.String("web"), Image: aws.String("nginx:1.15")},
+			},
+		}, nil),
+	)
+
+	service := newRevisionsTestService(t, mockEcs, flagSet)
+	revisions, err := service.Revisions()
+	assert.NoError(t, err, "Unexpected error listing the revisions of the service")
+	assert.Equal(t, project.InfoSet{
+		{
+			revisionsServiceKey:    revisionsServiceName,
+			revisionsRevisionKey:   "web:3",
+			revisionsDeploymentKey: "",
+			revisionsRegisteredKey: "2019-03-01T12:00:00Z",
+			revisionsImagesKey:     "web=nginx:1.16, cache=redis",
+		},
+		{
+			revisionsServiceKey:    revisionsServiceName,
+			revisionsRevisionKey:   "web:2",
+			revisionsDeploymentKey: "PRIMARY",
+			revisionsRegisteredKey: "2019-03-01T11:00:00Z",
+			revisionsImagesKey:     "web=nginx:1.15",
+		},
+	}, revisions)
+}
+
+func TestServiceRevisionsWithInvalidLimit(t *testing.T) {
+	for _, limit := range []int{0, -1, MaxRevisionsLimit + 1} {
+		t.Run(fmt.Sprintf("limit %d", limit), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			flagSet := flag.NewFlagSet("ecs-cli-revisions", 0)
+			flagSet.Int(flags.RevisionsLimitFlag, limit, "")
+
+			// no ECS call is expected
+			mockEcs := mock_ecs.NewMockECSClient(ctrl)
+			service := newRevisionsTestService(t, mockEcs, flagSet)
+			_, err := service.Revisions()
+			assert.Error(t, err, "Expected an error for a limit out of bounds")
+		})
+	}
+}
+
+func TestServiceRollbackToPreviousRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	flagSet := flag.NewFlagSet("ecs-cli-rollback", 0)
+	flagSet.Float64(flags.ComposeServiceTimeOutFlag, 5, "")
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService(revisionsServiceName).Return(getDescribeServiceTestResponse(getRevisionsTestService("web:3")), nil),
+		mockEcs.EXPECT().ListTaskDefinitionRevisions("web").Return(familyRevisions, nil),
+		mockEcs.EXPECT().DescribeTaskDefinition("web:2").Return(&ecs.TaskDefinition{TaskDefinitionArn: aws.String(arnPrefix + "web:2")}, nil),
+		mockEcs.EXPECT().UpdateService(gomock.Any()).Do(func(x interface{}) {
+			input := x.(*ecs.UpdateServiceInput)
+			assert.Equal(t, revisionsServiceName, aws.StringValue(input.Service))
+			assert.Equal(t, "web:2", aws.StringValue(input.TaskDefinition))
+			assert.Nil(t, input.DesiredCount, "Expected the desired count not to change")
+		}).Return(nil),
+		mockEcs.EXPECT().DescribeService(revisionsServiceName).Return(getDescribeServiceTestResponse(getRevisionsTestService("web:2")), nil),
+	)
+
+	service := newRevisionsTestService(t, mockEcs, flagSet)
+	err := service.Rollback(0)
+	assert.NoError(t, err, "Unexpected error rolling back the service")
+}
+
+func TestServiceRollbackToRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	flagSet := flag.NewFlagSet("ecs-cli-rollback", 0)
+	flagSet.Float64(flags.ComposeServiceTimeOutFlag, 5, "")
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService(revisionsServiceName).Return(getDescribeServiceTestResponse(getRevisionsTestService("web:3")), nil),
+		mockEcs.EXPECT().DescribeTaskDefinition("web:1").Return(&ecs.TaskDefinition{TaskDefinitionArn: aws.String(arnPrefix + "web:1")}, nil),
+		mockEcs.EXPECT().UpdateService(gomock.Any()).Do(func(x interface{}) {
+			assert.Equal(t, "web:1", aws.StringValue(x.(*ecs.UpdateServiceInput).TaskDefinition))
+		}).Return(nil),
+		mockEcs.EXPECT().DescribeService(revisionsServiceName).Return(getDescribeServiceTestResponse(getRevisionsTestService("web:1")), nil),
+	)
+
+	service := newRevisionsTestService(t, mockEcs, flagSet)
+	err := service.Rollback(1)
+	assert.NoError(t, err, "Unexpected error rolling back the service")
+}
+
+func TestServiceRollbackToCurrentRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	mockEcs.EXPECT().DescribeService(revisionsServiceName).Return(getDescribeServiceTestResponse(getRevisionsTestService("web:3")), nil)
+
+	service := newRevisionsTestService(t, mockEcs, flag.NewFlagSet("ecs-cli-rollback", 0))
+	err := service.Rollback(3)
+	assert.NoError(t, err, "Expected no update when the service already runs the revision")
+}
+
+func TestServiceRollbackWithoutOlderRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService(revisionsServiceName).Return(getDescribeServiceTestResponse(getRevisionsTestService("web:1")), nil),
+		mockEcs.EXPECT().ListTaskDefinitionRevisions("web").Return(familyRevisions, nil),
+	)
+
+	service := newRevisionsTestService(t, mockEcs, flag.NewFlagSet("ecs-cli-rollback", 0))
+	err := service.Rollback(0)
+	assert.Error(t, err, "Expected error rolling back the first revision")
+}
+
+func TestSplitTaskDefinitionID(t *testing.T) {
+	family, revision := splitTaskDefinitionID("web-app:12")
+	assert.Equal(t, "web-app", family)
+	assert.Equal(t, int64(12), revision)
+
+	family, revision = splitTaskDefinitionID("web-app")
+	assert.Equal(t, "web-app", family)
+	assert.Equal(t, int64(0), revision)
+}
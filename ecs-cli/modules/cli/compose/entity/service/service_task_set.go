@@ -0,0 +1,398 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/waiters"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// fullScalePercent is the scale of a task set running the whole desired count of its service
+const fullScalePercent = 100
+
+// usesTaskSets returns true if the tasks of the service are managed with task sets,
+// which is the case of services using the EXTERNAL deployment controller
+func usesTaskSets(ecsService *ecs.Service) bool {
+	return ecsService != nil && ecsService.DeploymentController != nil &&
+		aws.StringValue(ecsService.DeploymentController.Type) == ecs.DeploymentControllerTypeExternal
+}
+
+// primaryTaskSet returns the task set of the service serving production traffic, if any
+func primaryTaskSet(ecsService *ecs.Service) *ecs.TaskSet {
+	for _, taskSet := range ecsService.TaskSets {
+		if aws.StringValue(taskSet.Status) == ecsclient.TaskSetStatusPrimary {
+			return taskSet
+		}
+	}
+	return nil
+}
+
+// deploymentController returns the deployment controller new services are created with
+func (s *Service) deploymentController() (*ecs.DeploymentController, error) {
+	controllerType := strings.ToUpper(s.Context().CLIContext.String(flags.DeploymentControllerFlag))
+	switch controllerType {
+	case "":
+		return nil, nil
+	case ecs.DeploymentControllerTypeEcs, ecs.DeploymentControllerTypeExternal:
+		return &ecs.DeploymentController{Type: aws.String(controllerType)}, nil
+	}
+	return nil, fmt.Errorf("--%s must be %s or %s", flags.DeploymentControllerFlag, ecs.DeploymentControllerTypeEcs, ecs.DeploymentControllerTypeExternal)
+}
+
+// canaryPercent returns the scale of the task set created by a deployment, as a percent of the desired count
+func (s *Service) canaryPercent() (float64, error) {
+	percent := s.Context().CLIContext.Float64(flags.CanaryPercentFlag)
+	if percent == 0 {
+		return fullScalePercent, nil
+	}
+	if percent < 0 || percent > fullScalePercent {
+		return 0, fmt.Errorf("--%s must be greater than 0 and at most %d", flags.CanaryPercentFlag, fullScalePercent)
+	}
+	return percent, nil
+}
+
+// bakeTime returns how long a healthy canary task set runs before it is promoted
+func (s *Service) bakeTime() (time.Duration, error) {
+	minutes := s.Context().CLIContext.Float64(flags.BakeTimeFlag)
+	if minutes < 0 {
+		return 0, fmt.Errorf("Error with %s flag: %f is not a valid bake time", flags.BakeTimeFlag, minutes)
+	}
+	return time.Duration(minutes * float64(time.Minute)), nil
+}
+
+// buildCreateTaskSetInput sets the parameters that belong to the task sets of services using the
+// EXTERNAL deployment controller, rather than to the service
+func (s *Service) buildCreateTaskSetInput(serviceName, taskDefinition string, scalePercent float64) (*ecs.CreateTaskSetInput, error) {
+	networkConfig, err := composeutils.ConvertToECSNetworkConfiguration(s.ecsContext.ECSParams)
+	if err != nil {
+		return nil, err
+	}
+	platformVersion, err := entity.GetPlatformVersion(s)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &ecs.CreateTaskSetInput{
+		Cluster:              aws.String(s.Context().CommandConfig.Cluster),
+		Service:              aws.String(serviceName),
+		TaskDefinition:       aws.String(taskDefinition),
+		NetworkConfiguration: networkConfig,
+		Scale: &ecs.Scale{
+			Unit:  aws.String(ecs.ScaleUnitPercent),
+			Value: aws.Float64(scalePercent),
+		},
+	}
+	if launchType := s.Context().CommandConfig.LaunchType; launchType != "" {
+		input.LaunchType = aws.String(launchType)
+	}
+	if platformVersion != "" {
+		input.PlatformVersion = aws.String(platformVersion)
+	}
+	if s.loadBalancer != nil {
+		input.LoadBalancers = []*ecs.LoadBalancer{s.loadBalancer}
+	}
+	if len(s.serviceRegistries) > 0 {
+		input.ServiceRegistries = s.serviceRegistries
+	}
+	return input, nil
+}
+
+// createTaskSet creates a task set running taskDefinition at scalePercent of the desired count of the service
+func (s *Service) createTaskSet(serviceName, taskDefinition string, scalePercent float64) (*ecs.TaskSet, error) {
+	input, err := s.buildCreateTaskSetInput(serviceName, taskDefinition, scalePercent)
+	if err != nil {
+		return nil, err
+	}
+	taskSet, err := s.Context().ECSClient.CreateTaskSet(input)
+	if err != nil {
+		return nil, err
+	}
+	log.WithFields(log.Fields{
+		"service":        serviceName,
+		"taskSet":        aws.StringValue(taskSet.Id),
+		"taskDefinition": taskDefinition,
+		"scale":          scalePercent,
+	}).Info("Created a task set")
+	return taskSet, nil
+}
+
+// createPrimaryTaskSet creates the first task set of a new service using the EXTERNAL deployment controller
+func (s *Service) createPrimaryTaskSet(serviceName, taskDefinition string) error {
+	taskSet, err := s.createTaskSet(serviceName, taskDefinition, fullScalePercent)
+	if err != nil {
+		return err
+	}
+	return s.Context().ECSClient.UpdateServicePrimaryTaskSet(serviceName, aws.StringValue(taskSet.Id))
+}
+
+// deployTaskSet deploys a new task definition to a service using the EXTERNAL deployment controller.
+// A task set is created at the canary scale and, once it is healthy and has baked, scaled to the
+// desired count of the service and promoted to primary. The previous task sets are then deleted.
+func (s *Service) deployTaskSet(ecsService *ecs.Service, newTaskDefinition *ecs.TaskDefinition, count *int64) error {
+	serviceName := aws.StringValue(ecsService.ServiceName)
+	newTaskDefinitionId := entity.GetIdFromArn(newTaskDefinition.TaskDefinitionArn)
+
+	primary := primaryTaskSet(ecsService)
+	if primary != nil && entity.GetIdFromArn(primary.TaskDefinition) == newTaskDefinitionId {
+		return s.updateServiceCount(count)
+	}
+
+	canaryPercent, err := s.canaryPercent()
+	if err != nil {
+		return err
+	}
+	bakeTime, err := s.bakeTime()
+	if err != nil {
+		return err
+	}
+
+	taskSet, err := s.createTaskSet(serviceName, newTaskDefinitionId, canaryPercent)
+	if err != nil {
+		return err
+	}
+
+	err = s.promoteTaskSet(serviceName, taskSet, canaryPercent, bakeTime)
+	if err != nil {
+		// errors calling ECS and interrupted deployments are left as they are for the user to decide what to do
+		if s.Context().CLIContext.Bool(flags.NoRollbackFlag) || !isDeploymentFailure(err) {
+			return err
+		}
+		return s.rollbackTaskSet(serviceName, taskSet, primary, err)
+	}
+
+	for _, oldTaskSet := range ecsService.TaskSets {
+		if err = s.Context().ECSClient.DeleteTaskSet(serviceName, aws.StringValue(oldTaskSet.Id)); err != nil {
+			return err
+		}
+		log.WithFields(log.Fields{
+			"service":        serviceName,
+			"taskSet":        aws.StringValue(oldTaskSet.Id),
+			"taskDefinition": entity.GetIdFromArn(oldTaskSet.TaskDefinition),
+		}).Info("Deleted the previous task set")
+	}
+
+	if aws.Int64Value(ecsService.DesiredCount) != aws.Int64Value(count) {
+		return s.updateServiceCount(count)
+	}
+	return waitForServiceTasks(s, serviceName)
+}
+
+// promoteTaskSet waits for the new task set to be healthy, lets it bake, scales it to the
+// desired count of the service and makes it the primary task set
+func (s *Service) promoteTaskSet(serviceName string, taskSet *ecs.TaskSet, canaryPercent float64, bakeTime time.Duration) error {
+	taskSetID := aws.StringValue(taskSet.Id)
+	taskDefinitionArn := aws.StringValue(taskSet.TaskDefinition)
+	createdAt := aws.TimeValue(taskSet.CreatedAt)
+
+	if err := waitForTaskSet(s, serviceName, taskSetID, taskDefinitionArn, createdAt); err != nil {
+		return err
+	}
+
+	if bakeTime > 0 {
+		log.WithFields(log.Fields{
+			"service":  serviceName,
+			"taskSet":  taskSetID,
+			"bakeTime": bakeTime,
+		}).Info("Baking the task set")
+		ctx, cancel := utils.WithInterrupt(s.Context().CommandConfig.Context())
+		err := s.Sleeper().Sleep(ctx, bakeTime)
+		cancel()
+		if err != nil {
+			return errors.Wrap(err, "Stopped baking the task set")
+		}
+		// the task set must still be healthy after baking
+		if err := waitForTaskSet(s, serviceName, taskSetID, taskDefinitionArn, createdAt); err != nil {
+			return err
+		}
+	}
+
+	if canaryPercent < fullScalePercent {
+		if err := s.Context().ECSClient.UpdateTaskSetScale(serviceName, taskSetID, fullScalePercent); err != nil {
+			return err
+		}
+		log.WithFields(log.Fields{
+			"service": serviceName,
+			"taskSet": taskSetID,
+			"scale":   fullScalePercent,
+		}).Info("Scaled up the task set")
+		if err := waitForTaskSet(s, serviceName, taskSetID, taskDefinitionArn, createdAt); err != nil {
+			return err
+		}
+	}
+
+	if err := s.Context().ECSClient.UpdateServicePrimaryTaskSet(serviceName, taskSetID); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"service":        serviceName,
+		"taskSet":        taskSetID,
+		"taskDefinition": entity.GetIdFromArn(taskSet.TaskDefinition),
+	}).Info("Promoted the task set to primary")
+	return nil
+}
+
+// rollbackTaskSet deletes the task set of a failed deployment, leaving the primary task set serving
+func (s *Service) rollbackTaskSet(serviceName string, taskSet, primary *ecs.TaskSet, deploymentErr error) error {
+	taskSetID := aws.StringValue(taskSet.Id)
+	newTaskDefinitionId := entity.GetIdFromArn(taskSet.TaskDefinition)
+	log.WithFields(log.Fields{
+		"service":              serviceName,
+		"taskSet":              taskSetID,
+		"failedTaskDefinition": newTaskDefinitionId,
+	}).WithError(deploymentErr).Warn("Deployment failed, deleting its task set")
+
+	if err := s.Context().ECSClient.DeleteTaskSet(serviceName, taskSetID); err != nil {
+		return errors.Wrapf(err, "deployment of task definition %s failed (%s) and its task set %s could not be deleted",
+			newTaskDefinitionId, deploymentErr, taskSetID)
+	}
+
+	if primary == nil {
+		return fmt.Errorf("deployment of task definition %s failed and its task set %s was deleted: %s",
+			newTaskDefinitionId, taskSetID, deploymentErr)
+	}
+	return fmt.Errorf("deployment of task definition %s failed and its task set %s was deleted; service %s is still served by task set %s with task definition %s: %s",
+		newTaskDefinitionId, taskSetID, serviceName, aws.StringValue(primary.Id), entity.GetIdFromArn(primary.TaskDefinition), deploymentErr)
+}
+
+// Abort scales the task sets of a deployment in progress to zero. It applies only to services using
+// the EXTERNAL deployment controller, whose primary task set keeps serving production traffic.
+func (s *Service) Abort() error {
+	ecsService, err := s.describeService()
+	if err != nil {
+		return err
+	}
+	serviceName := aws.StringValue(ecsService.ServiceName)
+	if !usesTaskSets(ecsService) {
+		return fmt.Errorf("Service '%s' does not use the %s deployment controller", serviceName, ecs.DeploymentControllerTypeExternal)
+	}
+
+	aborted := false
+	for _, taskSet := range ecsService.TaskSets {
+		if aws.StringValue(taskSet.Status) != ecsclient.TaskSetStatusActive || taskSet.Scale == nil || aws.Float64Value(taskSet.Scale.Value) == 0 {
+			continue
+		}
+		taskSetID := aws.StringValue(taskSet.Id)
+		if err = s.Context().ECSClient.UpdateTaskSetScale(serviceName, taskSetID, 0); err != nil {
+			return err
+		}
+		log.WithFields(log.Fields{
+			"service":        serviceName,
+			"taskSet":        taskSetID,
+			"taskDefinition": entity.GetIdFromArn(taskSet.TaskDefinition),
+		}).Info("Scaled the task set of the deployment to zero")
+		if err = waitForTaskSet(s, serviceName, taskSetID, "", time.Time{}); err != nil {
+			return err
+		}
+		aborted = true
+	}
+
+	if !aborted {
+		log.WithFields(log.Fields{
+			"service": serviceName,
+		}).Info("No deployment in progress")
+	}
+	return nil
+}
+
+// waitForTaskSet continuously polls ECS (by calling DescribeTaskSets) and waits for the task set to reach
+// a steady state with its computed desired count running. If taskDefinitionArn is set, it fails once too
+// many tasks of the task set created at createdAt have stopped, or if it was scaled to zero by an abort.
+func waitForTaskSet(service *Service, serviceName, taskSetID, taskDefinitionArn string, createdAt time.Time) error {
+	var lastRunningCount int64
+	lastRunningCountChangedAt := now()
+	timeOut := float64(DefaultUpdateServiceTimeout)
+
+	if val := service.Context().CLIContext.Float64(flags.ComposeServiceTimeOutFlag); val > 0 {
+		timeOut = val
+	} else if val < 0 {
+		return fmt.Errorf("Error with timeout flag: %f is not a valid timeout value", val)
+	} else {
+		log.Warn("Timeout was specified as zero. Your task set may not have reached a steady state yet.")
+		return nil
+	}
+
+	progress := service.progress
+	if progress != nil {
+		defer progress.Close()
+	}
+
+	var lastTaskSet *ecs.TaskSet
+	err := waiters.ServiceWaitUntilComplete(service.Context().CommandConfig.Context(), func(retryCount int) (bool, error) {
+		taskSet, err := service.Context().ECSClient.DescribeTaskSet(serviceName, taskSetID)
+		if err != nil {
+			return false, err
+		}
+		lastTaskSet = taskSet
+
+		desiredCount := aws.Int64Value(taskSet.ComputedDesiredCount)
+		runningCount := aws.Int64Value(taskSet.RunningCount)
+
+		logFields := log.Fields{
+			"serviceName":  serviceName,
+			"taskSet":      taskSetID,
+			"desiredCount": desiredCount,
+			"runningCount": runningCount,
+		}
+
+		if runningCount != lastRunningCount {
+			lastRunningCount = runningCount
+			lastRunningCountChangedAt = now()
+			if progress == nil {
+				log.WithFields(logFields).Info("Task set status")
+			}
+		}
+
+		if progress != nil {
+			progress.UpdateTaskSets(serviceName, []*ecs.TaskSet{taskSet}, nil)
+		}
+
+		if taskDefinitionArn != "" && taskSet.Scale != nil && aws.Float64Value(taskSet.Scale.Value) == 0 {
+			return false, &deploymentFailedError{fmt.Sprintf("Deployment has been aborted: task set %s was scaled to zero", taskSetID)}
+		}
+
+		if aws.StringValue(taskSet.StabilityStatus) == ecs.StabilityStatusSteadyState && desiredCount == runningCount {
+			log.WithFields(logFields).Info("Task set has reached a steady state")
+			return true, nil
+		}
+
+		if taskDefinitionArn != "" {
+			if err := checkStoppedTasks(service, serviceName, taskDefinitionArn, createdAt, desiredCount); err != nil {
+				return false, err
+			}
+		}
+
+		if now().Sub(lastRunningCountChangedAt).Minutes() > timeOut {
+			return false, &deploymentFailedError{fmt.Sprintf("Deployment has not completed: Running count of task set %s has not changed for %.2f minutes", taskSetID, timeOut)}
+		}
+
+		return false, nil
+	}, service)
+
+	if utils.IsInterrupted(err) && lastTaskSet != nil {
+		logTaskSetLeftBehind(serviceName, lastTaskSet)
+	}
+	return err
+}
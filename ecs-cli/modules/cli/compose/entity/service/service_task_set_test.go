@@ -0,0 +1,360 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	stdcontext "context"
+	"flag"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const (
+	externalServiceName = "test-service"
+	oldTaskSetID        = "ecs-svc/old"
+	newTaskSetID        = "ecs-svc/new"
+)
+
+func TestCreateWithExternalDeploymentController(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-create", 0)
+	flagSet.String(flags.DeploymentControllerFlag, "external", "")
+	flagSet.String(flags.TargetGroupArnFlag, "arn:aws:elasticloadbalancing:us-west-2:accountId:targetgroup/web", "")
+	flagSet.String(flags.ContainerNameFlag, "web", "")
+	flagSet.String(flags.ContainerPortFlag, "80", "")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskDefArn, taskDefinition, registerTaskDefResponse := getTestTaskDef(externalServiceName)
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	gomock.InOrder(
+		mockEcs.EXPECT().RegisterTaskDefinitionIfNeeded(gomock.Any(), gomock.Any()).Return(&registerTaskDefResponse, nil),
+		mockEcs.EXPECT().ListAccountSettings(gomock.Any()).Return(&ecs.ListAccountSettingsOutput{
+			Settings: []*ecs.Setting{{Value: aws.String(ecsSettingDisabled)}},
+		}, nil),
+		mockEcs.EXPECT().CreateService(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.CreateServiceInput)
+			assert.Equal(t, ecs.DeploymentControllerTypeExternal, aws.StringValue(req.DeploymentController.Type))
+			assert.Nil(t, req.TaskDefinition, "Task definition belongs to the task set")
+			assert.Nil(t, req.LoadBalancers, "Load balancers belong to the task set")
+		}).Return(nil),
+		mockEcs.EXPECT().CreateTaskSet(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.CreateTaskSetInput)
+			assert.Equal(t, externalServiceName, aws.StringValue(req.Service))
+			assert.Equal(t, externalServiceName, aws.StringValue(req.TaskDefinition))
+			assert.Equal(t, float64(100), aws.Float64Value(req.Scale.Value))
+			if assert.Len(t, req.LoadBalancers, 1) {
+				assert.Equal(t, "web", aws.StringValue(req.LoadBalancers[0].ContainerName))
+			}
+		}).Return(&ecs.TaskSet{Id: aws.String(newTaskSetID)}, nil),
+		mockEcs.EXPECT().UpdateServicePrimaryTaskSet(externalServiceName, newTaskSetID).Return(nil),
+	)
+
+	service := newExternalTestService(t, mockEcs, flagSet)
+	service.SetTaskDefinition(&taskDefinition)
+	err := service.Create()
+	assert.NoError(t, err, "Unexpected error creating service with EXTERNAL deployment controller")
+	assert.Equal(t, taskDefArn, aws.StringValue(service.TaskDefinition().TaskDefinitionArn), "TaskDefArn should match")
+}
+
+func TestCreateWithInvalidDeploymentController(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-create", 0)
+	flagSet.String(flags.DeploymentControllerFlag, "CODE_DEPLOY", "")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, taskDefinition, registerTaskDefResponse := getTestTaskDef(externalServiceName)
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	mockEcs.EXPECT().RegisterTaskDefinitionIfNeeded(gomock.Any(), gomock.Any()).Return(&registerTaskDefResponse, nil)
+
+	service := newExternalTestService(t, mockEcs, flagSet)
+	service.SetTaskDefinition(&taskDefinition)
+	err := service.Create()
+	assert.Error(t, err, "Expected error with an unsupported deployment controller")
+}
+
+func TestUpdateExternalServiceDeploysCanaryTaskSet(t *testing.T) {
+	flagSet := externalUpdateFlagSet(false)
+	flagSet.Float64(flags.CanaryPercentFlag, 10, "")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, taskDefinition, registerTaskDefResponse := getExternalTestTaskDef()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService(externalServiceName).Return(getDescribeServiceTestResponse(getExternalTestService()), nil),
+		mockEcs.EXPECT().RegisterTaskDefinitionIfNeeded(gomock.Any(), gomock.Any()).Return(&registerTaskDefResponse, nil),
+		mockEcs.EXPECT().CreateTaskSet(gomock.Any()).Do(func(input interface{}) {
+			req := input.(*ecs.CreateTaskSetInput)
+			assert.Equal(t, externalServiceName+":2", aws.StringValue(req.TaskDefinition))
+			assert.Equal(t, float64(10), aws.Float64Value(req.Scale.Value))
+		}).Return(getNewTestTaskSet(), nil),
+		mockEcs.EXPECT().DescribeTaskSet(externalServiceName, newTaskSetID).Return(getTestTaskSet(ecs.StabilityStatusSteadyState, 10, 1, 1), nil),
+		mockEcs.EXPECT().UpdateTaskSetScale(externalServiceName, newTaskSetID, float64(100)).Return(nil),
+		mockEcs.EXPECT().DescribeTaskSet(externalServiceName, newTaskSetID).Return(getTestTaskSet(ecs.StabilityStatusSteadyState, 100, 2, 2), nil),
+		mockEcs.EXPECT().UpdateServicePrimaryTaskSet(externalServiceName, newTaskSetID).Return(nil),
+		mockEcs.EXPECT().DeleteTaskSet(externalServiceName, oldTaskSetID).Return(nil),
+		mockEcs.EXPECT().DescribeService(externalServiceName).Return(getDescribeServiceTestResponse(&ecs.Service{
+			ServiceName:          aws.String(externalServiceName),
+			DeploymentController: &ecs.DeploymentController{Type: aws.String(ecs.DeploymentControllerTypeExternal)},
+			TaskSets:             []*ecs.TaskSet{getNewTestTaskSet()},
+			DesiredCount:         aws.Int64(2),
+			RunningCount:         aws.Int64(2),
+		}), nil),
+	)
+
+	service := newExternalTestService(t, mockEcs, flagSet)
+	service.SetTaskDefinition(&taskDefinition)
+	err := service.Up()
+	assert.NoError(t, err, "Unexpected error deploying a canary task set")
+}
+
+func TestUpdateExternalServiceRollsBackFailedCanary(t *testing.T) {
+	err := updateExternalServiceFailedCanaryTest(t, false)
+	if assert.Error(t, err, "Expected error when the canary fails") {
+		assert.Contains(t, err.Error(), "task set ecs-svc/new was deleted")
+		assert.Contains(t, err.Error(), "still served by task set ecs-svc/old with task definition test-service:1")
+	}
+}
+
+func TestUpdateExternalServiceWithNoRollbackFlag(t *testing.T) {
+	err := updateExternalServiceFailedCanaryTest(t, true)
+	if assert.Error(t, err, "Expected error when the canary fails") {
+		assert.NotContains(t, err.Error(), "was deleted")
+	}
+}
+
+func TestAbortExternalService(t *testing.T) {
+	flagSet := externalUpdateFlagSet(false)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	existingService := getExternalTestService()
+	existingService.TaskSets = append(existingService.TaskSets, getTestTaskSet(ecs.StabilityStatusSteadyState, 10, 1, 1))
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService(externalServiceName).Return(getDescribeServiceTestResponse(existingService), nil),
+		mockEcs.EXPECT().UpdateTaskSetScale(externalServiceName, newTaskSetID, float64(0)).Return(nil),
+		mockEcs.EXPECT().DescribeTaskSet(externalServiceName, newTaskSetID).Return(getTestTaskSet(ecs.StabilityStatusSteadyState, 0, 0, 0), nil),
+	)
+
+	service := newExternalTestService(t, mockEcs, flagSet)
+	err := service.Abort()
+	assert.NoError(t, err, "Unexpected error aborting the deployment")
+}
+
+func TestAbortServiceWithoutTaskSets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	mockEcs.EXPECT().DescribeService(externalServiceName).Return(getDescribeServiceTestResponse(&ecs.Service{
+		ServiceName: aws.String(externalServiceName),
+		Status:      aws.String(ecsActiveResourceCode),
+	}), nil)
+
+	service := newExternalTestService(t, mockEcs, flag.NewFlagSet("ecs-cli-abort", 0))
+	err := service.Abort()
+	assert.Error(t, err, "Expected error aborting a service using the ECS deployment controller")
+}
+
+// updateExternalServiceFailedCanaryTest deploys a new task definition whose canary tasks stop
+func TestWaitForTaskSetTimesOut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer mockProgressClock()()
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	mockEcs.EXPECT().DescribeTaskSet(externalServiceName, newTaskSetID).Return(getTestTaskSet(ecs.StabilityStatusStabilizing, 100, 2, 1), nil).MinTimes(2)
+
+	service := newExternalTestService(t, mockEcs, externalUpdateFlagSet(false))
+	service.timeSleeper = &mockClockSleeper{}
+
+	err := waitForTaskSet(service, externalServiceName, newTaskSetID, "", time.Time{})
+	assert.True(t, isDeploymentFailure(err), "Expected the task set wait to time out")
+	waited := now().Sub(progressTestTime)
+	assert.True(t, waited > 5*time.Minute && waited < 6*time.Minute, "Expected the task set wait to stop once its timeout has passed on the clock")
+}
+
+func TestWaitForExternalServiceShowsTaskSets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer mockProgressClock()()
+
+	existingService := getExternalTestService()
+	existingService.TaskSets[0].ComputedDesiredCount = aws.Int64(2)
+	existingService.TaskSets[0].RunningCount = aws.Int64(2)
+	existingService.TaskSets[0].StabilityStatus = aws.String(ecs.StabilityStatusSteadyState)
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	mockEcs.EXPECT().DescribeService(externalServiceName).Return(getDescribeServiceTestResponse(existingService), nil)
+
+	out := &bytes.Buffer{}
+	service := newExternalTestService(t, mockEcs, externalUpdateFlagSet(false))
+	service.progress = newLineProgress(out)
+
+	err := waitForServiceTasks(service, externalServiceName)
+	assert.NoError(t, err, "Unexpected error waiting for the service")
+	assert.Equal(t,
+		"2019-03-01T12:00:00Z service=test-service taskSet=ecs-svc/old status=PRIMARY taskDefinition=test-service:1 scale=100% desired=2 pending=0 running=2 stability=STEADY_STATE\n",
+		out.String())
+}
+
+func TestWaitForTaskSetShowsProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer mockProgressClock()()
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeTaskSet(externalServiceName, newTaskSetID).Return(getTestTaskSet(ecs.StabilityStatusStabilizing, 10, 1, 0), nil),
+		mockEcs.EXPECT().DescribeTaskSet(externalServiceName, newTaskSetID).Return(getTestTaskSet(ecs.StabilityStatusSteadyState, 10, 1, 1), nil),
+	)
+
+	out := &bytes.Buffer{}
+	service := newExternalTestService(t, mockEcs, externalUpdateFlagSet(false))
+	service.timeSleeper = &mockClockSleeper{}
+	service.progress = newJSONProgress(out)
+
+	err := waitForTaskSet(service, externalServiceName, newTaskSetID, "", time.Time{})
+	assert.NoError(t, err, "Unexpected error waiting for the task set")
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if assert.Len(t, lines, 2, "Expected a record per change of the task set") {
+		assert.Contains(t, lines[0], `"stabilityStatus":"STABILIZING"`)
+		assert.Contains(t, lines[1], `"runningCount":1,"stabilityStatus":"STEADY_STATE"`)
+	}
+}
+
+func updateExternalServiceFailedCanaryTest(t *testing.T, noRollback bool) error {
+	flagSet := externalUpdateFlagSet(noRollback)
+	flagSet.Float64(flags.CanaryPercentFlag, 10, "")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, taskDefinition, registerTaskDefResponse := getExternalTestTaskDef()
+	stoppedTask := &ecs.Task{
+		TaskArn:           aws.String("arn:aws:ecs:us-west-2:accountId:task/task1"),
+		TaskDefinitionArn: registerTaskDefResponse.TaskDefinitionArn,
+		CreatedAt:         aws.Time(time.Now().Add(time.Minute)),
+		StoppedReason:     aws.String("Essential container in task exited"),
+	}
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	calls := []*gomock.Call{
+		mockEcs.EXPECT().DescribeService(externalServiceName).Return(getDescribeServiceTestResponse(getExternalTestService()), nil),
+		mockEcs.EXPECT().RegisterTaskDefinitionIfNeeded(gomock.Any(), gomock.Any()).Return(&registerTaskDefResponse, nil),
+		mockEcs.EXPECT().CreateTaskSet(gomock.Any()).Return(getNewTestTaskSet(), nil),
+		mockEcs.EXPECT().DescribeTaskSet(externalServiceName, newTaskSetID).Return(getTestTaskSet(ecs.StabilityStatusStabilizing, 10, 1, 0), nil),
+		mockEcs.EXPECT().GetTasksPages(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
+			funct := y.(ecsclient.ProcessTasksAction)
+			funct([]*ecs.Task{stoppedTask})
+		}).Return(nil),
+	}
+	if !noRollback {
+		calls = append(calls, mockEcs.EXPECT().DeleteTaskSet(externalServiceName, newTaskSetID).Return(nil))
+	}
+	gomock.InOrder(calls...)
+
+	service := newExternalTestService(t, mockEcs, flagSet)
+	service.SetTaskDefinition(&taskDefinition)
+	return service.Up()
+}
+
+func externalUpdateFlagSet(noRollback bool) *flag.FlagSet {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Float64(flags.ComposeServiceTimeOutFlag, 5, "")
+	flagSet.Bool(flags.NoRollbackFlag, noRollback, "")
+	return flagSet
+}
+
+func newExternalTestService(t *testing.T, mockEcs *mock_ecs.MockECSClient, flagSet *flag.FlagSet) *Service {
+	ecsContext := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+		ECSParams:     &utils.ECSParams{},
+		ProjectName:   externalServiceName,
+	}
+	service := NewService(ecsContext).(*Service)
+	assert.NoError(t, service.LoadContext(), "Unexpected error while loading context")
+	return service
+}
+
+func getExternalTestTaskDef() (taskDefArn string, taskDefinition, registerTaskDefResponse ecs.TaskDefinition) {
+	taskDefArn, taskDefinition, registerTaskDefResponse = getTestTaskDef(externalServiceName)
+	taskDefArn += ":2"
+	registerTaskDefResponse.TaskDefinitionArn = aws.String(taskDefArn)
+	return
+}
+
+func getExternalTestService() *ecs.Service {
+	return &ecs.Service{
+		ServiceName:          aws.String(externalServiceName),
+		Status:               aws.String(ecsActiveResourceCode),
+		DeploymentController: &ecs.DeploymentController{Type: aws.String(ecs.DeploymentControllerTypeExternal)},
+		DesiredCount:         aws.Int64(2),
+		RunningCount:         aws.Int64(2),
+		TaskSets: []*ecs.TaskSet{{
+			Id:             aws.String(oldTaskSetID),
+			Status:         aws.String(ecsclient.TaskSetStatusPrimary),
+			TaskDefinition: aws.String(arnPrefix + externalServiceName + ":1"),
+			Scale:          &ecs.Scale{Value: aws.Float64(100)},
+		}},
+	}
+}
+
+func getNewTestTaskSet() *ecs.TaskSet {
+	return &ecs.TaskSet{
+		Id:             aws.String(newTaskSetID),
+		Status:         aws.String(ecsclient.TaskSetStatusActive),
+		TaskDefinition: aws.String(arnPrefix + externalServiceName + ":2"),
+	}
+}
+
+func getTestTaskSet(stabilityStatus string, scale float64, desiredCount, runningCount int64) *ecs.TaskSet {
+	taskSet := getNewTestTaskSet()
+	taskSet.StabilityStatus = aws.String(stabilityStatus)
+	taskSet.Scale = &ecs.Scale{Value: aws.Float64(scale)}
+	taskSet.ComputedDesiredCount = aws.Int64(desiredCount)
+	taskSet.RunningCount = aws.Int64(runningCount)
+	return taskSet
+}
+
+// mockClockSleeper moves the mocked clock forward instead of sleeping
+type mockClockSleeper struct{}
+
+func (s *mockClockSleeper) Sleep(ctx stdcontext.Context, d time.Duration) error {
+	sleptUntil := now().Add(d)
+	now = func() time.Time { return sleptUntil }
+	return ctx.Err()
+}
@@ -14,14 +14,18 @@
 package service
 
 import (
+	gocontext "context"
 	"flag"
 	"fmt"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/diff"
+	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/tagging"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/tagging/mock"
@@ -32,6 +36,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecs"
 	taggingSDK "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli"
 )
@@ -210,6 +215,41 @@ func TestCreateFargateNetworkModeNotAWSVPC(t *testing.T) {
 	assert.Error(t, err, "Expected error creating service")
 }
 
+func TestCreateFargateWithPlatformVersion(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	ecsParams := ecsParamsWithFargateNetworkConfig()
+	ecsParams.RunParams.PlatformVersion = "1.3.0"
+
+	createServiceTest(
+		t,
+		flagSet,
+		&config.CommandConfig{LaunchType: "FARGATE"},
+		ecsParams,
+		func(input *ecs.CreateServiceInput) {
+			assert.Equal(t, "1.3.0", aws.StringValue(input.PlatformVersion), "Expected platform version to match")
+		},
+		ecsSettingDisabled,
+	)
+}
+
+func TestCreateFargateWithPlatformVersionFlag(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.PlatformVersionFlag, "LATEST", "")
+	ecsParams := ecsParamsWithFargateNetworkConfig()
+	ecsParams.RunParams.PlatformVersion = "1.3.0"
+
+	createServiceTest(
+		t,
+		flagSet,
+		&config.CommandConfig{LaunchType: "FARGATE"},
+		ecsParams,
+		func(input *ecs.CreateServiceInput) {
+			assert.Equal(t, "LATEST", aws.StringValue(input.PlatformVersion), "Expected the flag to override the ecs params")
+		},
+		ecsSettingDisabled,
+	)
+}
+
 func TestCreateEC2Explicitly(t *testing.T) {
 	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
 
@@ -884,6 +924,19 @@ func TestLoadContextForIncorrectInput(t *testing.T) {
 	assert.Error(t, err, "Expected error to load context when flag is a string but got done")
 }
 
+func TestLoadContextForInvalidProgress(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.ProgressFlag, "bogus", "")
+	cliContext := cli.NewContext(nil, flagSet, nil)
+	service := &Service{
+		ecsContext: &context.ECSContext{CLIContext: cliContext},
+	}
+
+	// the flag is rejected before the service is deployed, rather than while waiting for the deployment
+	err := service.LoadContext()
+	assert.Error(t, err, "Expected error to load context when the progress view is not supported")
+}
+
 func TestLoadContextForLoadBalancerInputError(t *testing.T) {
 	targetGroupArn := "targetGroupArn"
 	loadBalancerName := "loadBalancerName"
@@ -900,6 +953,54 @@ func TestLoadContextForLoadBalancerInputError(t *testing.T) {
 	assert.Error(t, err, "Expected error to load context when flag is a string but got done")
 }
 
+func TestLoadContextWithSplitServicesKeepsLoadBalancerOfItsContainer(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.TargetGroupArnFlag, "targetGroupArn", "")
+	flagSet.String(flags.ContainerNameFlag, "web", "")
+	flagSet.String(flags.ContainerPortFlag, "80", "")
+	flagSet.String(flags.HealthCheckGracePeriodFlag, "30", "")
+	cliContext := cli.NewContext(nil, flagSet, nil)
+
+	web := &Service{
+		ecsContext: &context.ECSContext{CLIContext: cliContext, ComposeServiceName: "web"},
+	}
+	assert.NoError(t, web.LoadContext(), "Unexpected error while loading context")
+	if assert.NotNil(t, web.loadBalancer, "Expected the load balancer on the service of --container-name") {
+		assert.Equal(t, "targetGroupArn", aws.StringValue(web.loadBalancer.TargetGroupArn))
+	}
+	assert.Equal(t, int64(30), aws.Int64Value(web.healthCheckGP))
+
+	worker := &Service{
+		ecsContext: &context.ECSContext{CLIContext: cliContext, ComposeServiceName: "worker"},
+	}
+	assert.NoError(t, worker.LoadContext(), "Unexpected error while loading context")
+	assert.Nil(t, worker.loadBalancer, "Expected no load balancer on the other services")
+	assert.Nil(t, worker.healthCheckGP, "Expected no health check grace period on the other services")
+}
+
+func TestInitialCount(t *testing.T) {
+	ecsParams := &utils.ECSParams{
+		ServiceOverrides: map[string]utils.ServiceOverride{
+			"web": {DesiredCount: aws.Int64(3)},
+		},
+	}
+
+	testCases := map[string]int64{
+		"web":    3,
+		"worker": 1,
+		"":       1,
+	}
+	for composeServiceName, expected := range testCases {
+		service := &Service{
+			ecsContext: &context.ECSContext{ECSParams: ecsParams, ComposeServiceName: composeServiceName},
+		}
+		assert.Equal(t, expected, aws.Int64Value(service.initialCount()), "Unexpected initial count for %q", composeServiceName)
+	}
+
+	service := &Service{ecsContext: &context.ECSContext{ComposeServiceName: "web"}}
+	assert.Equal(t, int64(1), aws.Int64Value(service.initialCount()), "Expected 1 without ecs-params")
+}
+
 /////////////////
 // Info tests //
 ////////////////
@@ -913,6 +1014,115 @@ func TestServiceInfo(t *testing.T) {
 	}, t, true, "")
 }
 
+////////////////
+// Diff tests //
+////////////////
+
+func TestServiceDiff(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	flagSet := flag.NewFlagSet("ecs-cli-diff", 0)
+	flagSet.String(flags.DeploymentMinHealthyPercentFlag, "50", "")
+
+	serviceName := "test-service"
+	deployedTaskDefinition := &ecs.TaskDefinition{
+		TaskDefinitionArn: aws.String(arnPrefix + "test-service:1"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{Name: aws.String("web"), Image: aws.String("nginx:1.15")},
+		},
+	}
+	localTaskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{Name: aws.String("web"), Image: aws.String("nginx:1.16")},
+		},
+	}
+	existingService := &ecs.Service{
+		ServiceName:    aws.String(serviceName),
+		Status:         aws.String(ecsActiveResourceCode),
+		TaskDefinition: deployedTaskDefinition.TaskDefinitionArn,
+		DesiredCount:   aws.Int64(0),
+		DeploymentConfiguration: &ecs.DeploymentConfiguration{
+			MaximumPercent:        aws.Int64(200),
+			MinimumHealthyPercent: aws.Int64(100),
+		},
+	}
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService(serviceName).Return(getDescribeServiceTestResponse(existingService), nil),
+		mockEcs.EXPECT().DescribeTaskDefinition(arnPrefix+"test-service:1").Return(deployedTaskDefinition, nil),
+	)
+
+	ecsContext := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+		ECSParams:     &utils.ECSParams{},
+		ProjectName:   serviceName,
+	}
+	service := NewService(ecsContext)
+	assert.NoError(t, service.LoadContext(), "Unexpected error while loading context in diff test")
+	service.SetTaskDefinition(localTaskDefinition)
+
+	serviceDiff, err := service.Diff()
+	assert.NoError(t, err, "Unexpected error diffing service")
+	if assert.NotNil(t, serviceDiff, "Expected the differences of the service") {
+		assert.Equal(t, serviceName, serviceDiff.ServiceName)
+		assert.False(t, serviceDiff.Missing, "Expected the service to exist")
+		assert.Equal(t, []diff.Field{
+			{Field: "containerDefinitions[web].image", Deployed: aws.String("nginx:1.15"), Local: aws.String("nginx:1.16")},
+			{Field: "desiredCount", Deployed: aws.String("0"), Local: aws.String("1")},
+			{Field: "deploymentConfiguration.minimumHealthyPercent", Deployed: aws.String("100"), Local: aws.String("50")},
+		}, serviceDiff.Fields)
+	}
+}
+
+func TestServiceDiffWithMissingService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	mockEcs.EXPECT().DescribeService(gomock.Any()).Return(getDescribeServiceTestResponse(nil), nil)
+
+	ecsContext := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+		CLIContext:    cli.NewContext(nil, flag.NewFlagSet("ecs-cli-diff", 0), nil),
+		ProjectName:   "test-service",
+	}
+	service := NewService(ecsContext)
+	assert.NoError(t, service.LoadContext(), "Unexpected error while loading context in diff test")
+
+	serviceDiff, err := service.Diff()
+	assert.NoError(t, err, "Unexpected error diffing missing service")
+	if assert.NotNil(t, serviceDiff, "Expected the differences of the service") {
+		assert.True(t, serviceDiff.Missing, "Expected the service to be missing")
+		assert.Empty(t, serviceDiff.Fields, "Expected no fields for a missing service")
+	}
+}
+
+func TestServiceDiffWithDescribeServiceError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	mockEcs.EXPECT().DescribeService(gomock.Any()).Return(nil, errors.New("AccessDeniedException: role is MISSING permissions"))
+
+	ecsContext := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+		CLIContext:    cli.NewContext(nil, flag.NewFlagSet("ecs-cli-diff", 0), nil),
+		ProjectName:   "test-service",
+	}
+	service := NewService(ecsContext)
+	assert.NoError(t, service.LoadContext(), "Unexpected error while loading context in diff test")
+
+	serviceDiff, err := service.Diff()
+	assert.Error(t, err, "Expected the describe error to be returned")
+	assert.Nil(t, serviceDiff, "Expected no differences when describing the service fails")
+}
+
 ////////////////
 // Run tests //
 ///////////////
@@ -935,6 +1145,7 @@ type UpdateServiceParams struct {
 	networkConfig          *ecs.NetworkConfiguration
 	healthCheckGracePeriod *int64
 	forceDeployment        bool
+	platformVersion        string
 }
 
 // For an existing service
@@ -1157,6 +1368,82 @@ func TestUpdateExistingServiceWithNewHCGP(t *testing.T) {
 	updateServiceTest(t, flagSet, &config.CommandConfig{}, &utils.ECSParams{}, expectedInput, existingService, true)
 }
 
+func TestUpdateExistingServiceWithNewPlatformVersion(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	ecsParams := ecsParamsWithFargateNetworkConfig()
+	ecsParams.RunParams.PlatformVersion = "1.3.0"
+
+	// define existing service, running the same task definition on an older platform version
+	serviceName := "test-service"
+	existingService := &ecs.Service{
+		TaskDefinition:  aws.String("arn/test-task-def"),
+		Status:          aws.String("ACTIVE"),
+		DesiredCount:    aws.Int64(2),
+		ServiceName:     aws.String(serviceName),
+		PlatformVersion: aws.String("1.2.0"),
+	}
+
+	// the new platform version is deployed even though the task definition did not change
+	expectedInput := getDefaultUpdateInput()
+	expectedInput.serviceName = serviceName
+	expectedInput.taskDefinition = "test-task-def"
+	expectedInput.count = aws.Int64(2)
+	expectedInput.platformVersion = "1.3.0"
+	expectedInput.networkConfig = &ecs.NetworkConfiguration{
+		AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+			Subnets:        aws.StringSlice([]string{"sg-bafff1ed", "sg-c0ffeefe"}),
+			SecurityGroups: []*string{},
+			AssignPublicIp: aws.String(string(utils.Enabled)),
+		},
+	}
+
+	updateServiceTest(t, flagSet, &config.CommandConfig{LaunchType: "FARGATE"}, ecsParams, expectedInput, existingService, true)
+}
+
+func TestUpdateExistingServiceWithSamePlatformVersion(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.PlatformVersionFlag, "1.3.0", "")
+	ecsParams := ecsParamsWithFargateNetworkConfig()
+
+	serviceName := "test-service"
+	existingService := &ecs.Service{
+		TaskDefinition:  aws.String("arn/test-task-def"),
+		Status:          aws.String("ACTIVE"),
+		DesiredCount:    aws.Int64(2),
+		ServiceName:     aws.String(serviceName),
+		PlatformVersion: aws.String("1.3.0"),
+	}
+
+	// only the count is updated, without a new task definition
+	expectedInput := getDefaultUpdateInput()
+	expectedInput.serviceName = serviceName
+	expectedInput.count = aws.Int64(2)
+	expectedInput.platformVersion = "1.3.0"
+	expectedInput.networkConfig = &ecs.NetworkConfiguration{
+		AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+			Subnets:        aws.StringSlice([]string{"sg-bafff1ed", "sg-c0ffeefe"}),
+			SecurityGroups: []*string{},
+			AssignPublicIp: aws.String(string(utils.Enabled)),
+		},
+	}
+
+	updateServiceTest(t, flagSet, &config.CommandConfig{LaunchType: "FARGATE"}, ecsParams, expectedInput, existingService, true)
+}
+
+func TestUpdateExistingServiceWithPlatformVersionAndEC2(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.String(flags.PlatformVersionFlag, "1.3.0", "")
+
+	existingService := &ecs.Service{
+		TaskDefinition: aws.String("arn/test-task-def"),
+		Status:         aws.String("ACTIVE"),
+		DesiredCount:   aws.Int64(2),
+		ServiceName:    aws.String("test-service"),
+	}
+
+	updateServiceExceptionTest(t, flagSet, &config.CommandConfig{LaunchType: "EC2"}, &utils.ECSParams{}, existingService)
+}
+
 func TestUpdateExistingServiceWithDesiredCountOverOne(t *testing.T) {
 	// define test values
 	existingDesiredCount := 2
@@ -1248,6 +1535,216 @@ func TestUpdateExistingServiceWithServiceDiscoveryFlag(t *testing.T) {
 //  Update Service Helper functions  //
 ///////////////////////////////////////
 
+func TestUpdateExistingServiceRollsBackFailedDeployment(t *testing.T) {
+	err := updateServiceRollbackTest(t, false)
+	if assert.Error(t, err, "Expected error when the deployment fails") {
+		assert.Contains(t, err.Error(), "rolled back to task definition test-task-def:1 with desired count 2")
+		assert.Contains(t, err.Error(), "container web exited with code 1")
+	}
+}
+
+func TestUpdateExistingServiceWithNoRollbackFlag(t *testing.T) {
+	err := updateServiceRollbackTest(t, true)
+	if assert.Error(t, err, "Expected error when the deployment fails") {
+		assert.NotContains(t, err.Error(), "rolled back")
+		assert.Contains(t, err.Error(), "3 tasks of the new task definition have stopped")
+	}
+}
+
+func TestUpdateExistingServiceInterruptedDeploymentIsNotRolledBack(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Float64(flags.ComposeServiceTimeOutFlag, 5, "")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	existingService := &ecs.Service{
+		TaskDefinition: aws.String(arnPrefix + "test-task-def:1"),
+		Status:         aws.String("ACTIVE"),
+		DesiredCount:   aws.Int64(2),
+		RunningCount:   aws.Int64(2),
+		ServiceName:    aws.String("test-service"),
+	}
+	_, taskDefinition, registerTaskDefResponse := getTestTaskDef("test-task-def")
+	registerTaskDefResponse.TaskDefinitionArn = aws.String(arnPrefix + "test-task-def:2")
+
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService(gomock.Any()).Return(getDescribeServiceTestResponse(existingService), nil),
+		mockEcs.EXPECT().RegisterTaskDefinitionIfNeeded(gomock.Any(), gomock.Any()).Return(&registerTaskDefResponse, nil),
+		// the command is interrupted once the deployment has started
+		mockEcs.EXPECT().UpdateService(gomock.Any()).Do(func(input interface{}) {
+			cancel()
+		}).Return(nil),
+	)
+
+	ecsContext := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{Ctx: ctx},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+		ECSParams:     &utils.ECSParams{},
+		ProjectName:   "test-service",
+	}
+	service := NewService(ecsContext)
+	assert.NoError(t, service.LoadContext(), "Unexpected error while loading context in interrupt test")
+
+	service.SetTaskDefinition(&taskDefinition)
+	err := service.Up()
+	if assert.Error(t, err, "Expected error when the deployment is interrupted") {
+		assert.Equal(t, gocontext.Canceled, errors.Cause(err))
+		assert.NotContains(t, err.Error(), "rolled back")
+	}
+}
+
+func TestUpdateExistingServiceDescribeErrorIsNotRolledBack(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Float64(flags.ComposeServiceTimeOutFlag, 5, "")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	existingService := &ecs.Service{
+		TaskDefinition: aws.String(arnPrefix + "test-task-def:1"),
+		Status:         aws.String("ACTIVE"),
+		DesiredCount:   aws.Int64(2),
+		RunningCount:   aws.Int64(2),
+		ServiceName:    aws.String("test-service"),
+	}
+	_, taskDefinition, registerTaskDefResponse := getTestTaskDef("test-task-def")
+	registerTaskDefResponse.TaskDefinitionArn = aws.String(arnPrefix + "test-task-def:2")
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	gomock.InOrder(
+		mockEcs.EXPECT().DescribeService(gomock.Any()).Return(getDescribeServiceTestResponse(existingService), nil),
+		mockEcs.EXPECT().RegisterTaskDefinitionIfNeeded(gomock.Any(), gomock.Any()).Return(&registerTaskDefResponse, nil),
+		mockEcs.EXPECT().UpdateService(gomock.Any()).Return(nil),
+		// a throttled describe call while waiting does not mean the deployment failed
+		mockEcs.EXPECT().DescribeService(gomock.Any()).Return(nil, errors.New("ThrottlingException: Rate exceeded")),
+	)
+
+	ecsContext := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+		ECSParams:     &utils.ECSParams{},
+		ProjectName:   "test-service",
+	}
+	service := NewService(ecsContext)
+	assert.NoError(t, service.LoadContext(), "Unexpected error while loading context in describe error test")
+
+	service.SetTaskDefinition(&taskDefinition)
+	err := service.Up()
+	if assert.Error(t, err, "Expected error when the service cannot be described") {
+		assert.Contains(t, err.Error(), "ThrottlingException")
+		assert.NotContains(t, err.Error(), "rolled back")
+	}
+}
+
+// updateServiceRollbackTest deploys a new task definition whose tasks keep stopping to an existing service
+func updateServiceRollbackTest(t *testing.T, noRollback bool) error {
+	flagSet := flag.NewFlagSet("ecs-cli-up", 0)
+	flagSet.Float64(flags.ComposeServiceTimeOutFlag, 5, "")
+	flagSet.Bool(flags.NoRollbackFlag, noRollback, "")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceName := "test-service"
+	existingService := &ecs.Service{
+		TaskDefinition: aws.String(arnPrefix + "test-task-def:1"),
+		Status:         aws.String("ACTIVE"),
+		DesiredCount:   aws.Int64(2),
+		RunningCount:   aws.Int64(2),
+		ServiceName:    aws.String(serviceName),
+		DeploymentConfiguration: &ecs.DeploymentConfiguration{
+			MaximumPercent:        aws.Int64(200),
+			MinimumHealthyPercent: aws.Int64(50),
+		},
+	}
+	_, taskDefinition, registerTaskDefResponse := getTestTaskDef("test-task-def")
+	newTaskDefArn := arnPrefix + "test-task-def:2"
+	registerTaskDefResponse.TaskDefinitionArn = aws.String(newTaskDefArn)
+
+	// the times are set by ECS, which may not agree with the local clock
+	deploymentCreatedAt := time.Now().Add(-time.Hour)
+	deployingService := &ecs.Service{
+		ServiceName:  aws.String(serviceName),
+		DesiredCount: aws.Int64(2),
+		RunningCount: aws.Int64(1),
+		Deployments: []*ecs.Deployment{
+			{Status: aws.String("PRIMARY"), TaskDefinition: aws.String(newTaskDefArn), CreatedAt: aws.Time(deploymentCreatedAt)},
+			{Status: aws.String("ACTIVE"), TaskDefinition: existingService.TaskDefinition},
+		},
+	}
+	// a task of the new task definition that stopped before this deployment is not counted
+	stoppedTasks := []*ecs.Task{{
+		TaskArn:           aws.String("arn:aws:ecs:us-west-2:accountId:task/earlier"),
+		TaskDefinitionArn: aws.String(newTaskDefArn),
+		CreatedAt:         aws.Time(deploymentCreatedAt.Add(-time.Minute)),
+	}}
+	for i := 0; i < 3; i++ {
+		stoppedTasks = append(stoppedTasks, &ecs.Task{
+			TaskArn:           aws.String(fmt.Sprintf("arn:aws:ecs:us-west-2:accountId:task/task%d", i)),
+			TaskDefinitionArn: aws.String(newTaskDefArn),
+			CreatedAt:         aws.Time(deploymentCreatedAt.Add(time.Minute)),
+			StoppedReason:     aws.String("Essential container in task exited"),
+			Containers: []*ecs.Container{{
+				Name:     aws.String("web"),
+				ExitCode: aws.Int64(1),
+			}},
+		})
+	}
+	rolledBackService := &ecs.Service{
+		ServiceName:  aws.String(serviceName),
+		DesiredCount: aws.Int64(2),
+		RunningCount: aws.Int64(2),
+		Deployments:  []*ecs.Deployment{{}},
+	}
+
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	calls := []*gomock.Call{
+		mockEcs.EXPECT().DescribeService(gomock.Any()).Return(getDescribeServiceTestResponse(existingService), nil),
+		mockEcs.EXPECT().RegisterTaskDefinitionIfNeeded(gomock.Any(), gomock.Any()).Return(&registerTaskDefResponse, nil),
+		mockEcs.EXPECT().UpdateService(gomock.Any()).Do(func(input interface{}) {
+			assert.Equal(t, "test-task-def:2", aws.StringValue(input.(*ecs.UpdateServiceInput).TaskDefinition))
+		}).Return(nil),
+		mockEcs.EXPECT().DescribeService(gomock.Any()).Return(getDescribeServiceTestResponse(deployingService), nil),
+		mockEcs.EXPECT().GetTasksPages(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
+			input := x.(*ecs.ListTasksInput)
+			assert.Equal(t, serviceName, aws.StringValue(input.ServiceName))
+			assert.Equal(t, ecs.DesiredStatusStopped, aws.StringValue(input.DesiredStatus))
+			funct := y.(ecsclient.ProcessTasksAction)
+			funct(stoppedTasks)
+		}).Return(nil),
+	}
+	if !noRollback {
+		calls = append(calls,
+			mockEcs.EXPECT().UpdateService(gomock.Any()).Do(func(input interface{}) {
+				req := input.(*ecs.UpdateServiceInput)
+				assert.Equal(t, "test-task-def:1", aws.StringValue(req.TaskDefinition))
+				assert.Equal(t, int64(2), aws.Int64Value(req.DesiredCount))
+				assert.Equal(t, existingService.DeploymentConfiguration, req.DeploymentConfiguration, "Expected the previous deployment configuration to be restored")
+			}).Return(nil),
+			mockEcs.EXPECT().DescribeService(gomock.Any()).Return(getDescribeServiceTestResponse(rolledBackService), nil),
+		)
+	}
+	gomock.InOrder(calls...)
+
+	ecsContext := &context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+		ECSParams:     &utils.ECSParams{},
+		ProjectName:   serviceName,
+	}
+	service := NewService(ecsContext)
+	assert.NoError(t, service.LoadContext(), "Unexpected error while loading context in rollback test")
+
+	service.SetTaskDefinition(&taskDefinition)
+	return service.Up()
+}
+
 func getDefaultUpdateInput() UpdateServiceParams {
 	return UpdateServiceParams{
 		deploymentConfig: &ecs.DeploymentConfiguration{},
@@ -1297,6 +1794,7 @@ func updateServiceTest(t *testing.T,
 				networkConfig:          req.NetworkConfiguration,
 				healthCheckGracePeriod: req.HealthCheckGracePeriodSeconds,
 				forceDeployment:        aws.BoolValue(req.ForceNewDeployment),
+				platformVersion:        aws.StringValue(req.PlatformVersion),
 			}
 			assert.Equal(t, expectedInput, observedInput)
 
@@ -18,6 +18,7 @@ import (
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/diff"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/types"
 	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
@@ -36,7 +37,7 @@ type Task struct {
 	taskDef     *ecs.TaskDefinition
 	cache       cache.Cache
 	ecsContext  *context.ECSContext
-	timeSleeper *utils.TimeSleeper
+	timeSleeper utils.Sleeper
 	tags        []*ecs.Tag
 }
 
@@ -66,7 +67,7 @@ func (t *Task) Context() *context.ECSContext {
 }
 
 // Sleeper returs an instance of TimeSleeper used to wait until Tasks has either started running or stopped
-func (t *Task) Sleeper() *utils.TimeSleeper {
+func (t *Task) Sleeper() utils.Sleeper {
 	return t.timeSleeper
 }
 
@@ -170,8 +171,9 @@ func (t *Task) Scale(desiredCount int) error {
 }
 
 // Run starts all containers defined in the task definition once regardless of if they were started before
-// It also overrides the commands for the specified containers
-// TODO Account for other ContainerOverrides
+// It also overrides the commands for the specified containers, along with the containers, size and role
+// overridden with flags. With --attach, it prints the logs of the containers until the task stops and
+// fails with the exit code of its essential container.
 func (t *Task) Run(commandOverrides map[string][]string) error {
 	taskDef, err := entity.GetOrCreateTaskDefinition(t)
 	if err != nil {
@@ -180,7 +182,16 @@ func (t *Task) Run(commandOverrides map[string][]string) error {
 	taskDefinitionId := aws.StringValue(taskDef.TaskDefinitionArn)
 	count := 1
 
-	runTaskInput, err := t.buildRunTaskInput(taskDefinitionId, count, commandOverrides)
+	overrides, err := newRunOverrides(t.Context().CLIContext, commandOverrides, taskDef)
+	if err != nil {
+		return err
+	}
+	taskOverride, err := convertToECSTaskOverride(overrides, taskDef, t.Context().CommandConfig.LaunchType)
+	if err != nil {
+		return err
+	}
+
+	runTaskInput, err := t.buildRunTaskInput(taskDefinitionId, count, taskOverride)
 	if err != nil {
 		return err
 	}
@@ -196,6 +207,9 @@ func (t *Task) Run(commandOverrides map[string][]string) error {
 		}).Info("Couldn't run containers")
 	}
 
+	if t.Context().CLIContext.Bool(flags.AttachFlag) {
+		return t.attach(ecsTasks.Tasks, taskDef)
+	}
 	return t.waitForRunTasks(ecsTasks.Tasks)
 }
 
@@ -215,6 +229,26 @@ func (t *Task) Down() error {
 	return composeutils.ErrUnsupported
 }
 
+// Abort applies only to deployments of ECS Services using task sets
+func (t *Task) Abort() error {
+	return composeutils.ErrUnsupported
+}
+
+// Diff applies only to ECS Services
+func (t *Task) Diff() (*diff.Service, error) {
+	return nil, composeutils.ErrUnsupported
+}
+
+// Revisions applies only to ECS Services
+func (t *Task) Revisions() (project.InfoSet, error) {
+	return nil, composeutils.ErrUnsupported
+}
+
+// Rollback applies only to ECS Services
+func (t *Task) Rollback(revision int64) error {
+	return composeutils.ErrUnsupported
+}
+
 // EntityType returns the type of the entity
 func (t *Task) EntityType() types.Type {
 	return types.Task
@@ -289,29 +323,8 @@ func (t *Task) runTasks(taskDefinition string, totalCount int) ([]*ecs.Task, err
 	return result, nil
 }
 
-func convertToECSTaskOverride(overrides map[string][]string) (*ecs.TaskOverride, error) {
-	if overrides == nil {
-		return nil, nil
-	}
-
-	commandOverrides := []*ecs.ContainerOverride{}
-	for cont, command := range overrides {
-		contOverride := &ecs.ContainerOverride{
-			Name:    aws.String(cont),
-			Command: aws.StringSlice(command),
-		}
-		commandOverrides = append(commandOverrides, contOverride)
-	}
-
-	ecsOverrides := &ecs.TaskOverride{
-		ContainerOverrides: commandOverrides,
-	}
-
-	return ecsOverrides, nil
-}
-
 // buildRunTaskInput will account for what is currently specified in ECS Params
-func (t *Task) buildRunTaskInput(taskDefinition string, count int, overrides map[string][]string) (*ecs.RunTaskInput, error) {
+func (t *Task) buildRunTaskInput(taskDefinition string, count int, taskOverride *ecs.TaskOverride) (*ecs.RunTaskInput, error) {
 	cluster := t.Context().CommandConfig.Cluster
 	launchType := t.Context().CommandConfig.LaunchType
 	group := entity.GetTaskGroup(t)
@@ -338,7 +351,7 @@ func (t *Task) buildRunTaskInput(taskDefinition string, count int, overrides map
 		return nil, err
 	}
 
-	taskOverride, err := convertToECSTaskOverride(overrides)
+	platformVersion, err := entity.GetPlatformVersion(t)
 	if err != nil {
 		return nil, err
 	}
@@ -370,6 +383,10 @@ func (t *Task) buildRunTaskInput(taskDefinition string, count int, overrides map
 		runTaskInput.LaunchType = aws.String(launchType)
 	}
 
+	if platformVersion != "" {
+		runTaskInput.PlatformVersion = aws.String(platformVersion)
+	}
+
 	tags, err := t.GetTags()
 	if err != nil {
 		return nil, err
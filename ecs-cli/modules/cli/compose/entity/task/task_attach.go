@@ -0,0 +1,124 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/logs"
+	cwlogsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/waiters"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// make the logs output and their clients easily mockable in tests
+var logsOutput io.Writer = os.Stdout
+var newLogClientFactory = cwlogsclient.NewLogClientFactory
+
+// attach waits for the task to be running, prints the logs of its containers until it stops and
+// returns an error with the exit code of its essential container if that did not exit with 0
+func (t *Task) attach(ecsTasks []*ecs.Task, taskDef *ecs.TaskDefinition) error {
+	if len(ecsTasks) != 1 {
+		return fmt.Errorf("Expected to run 1 task to attach to, ran %d", len(ecsTasks))
+	}
+	taskArn := aws.StringValue(ecsTasks[0].TaskArn)
+	taskID := entity.GetIdFromArn(ecsTasks[0].TaskArn)
+
+	taskLogs, err := logs.NewTaskLogs(taskDef, taskID, newLogClientFactory(t.Context().CommandConfig), logsOutput)
+	if err != nil {
+		return err
+	}
+	if err = t.waitForRunTasks(ecsTasks); err != nil {
+		return err
+	}
+
+	var stoppedTask *ecs.Task
+	err = waiters.TaskWaitUntilComplete(t.Context().CommandConfig.Context(), func(retryCount int) (bool, error) {
+		if err := taskLogs.Print(); err != nil {
+			return false, err
+		}
+		tasks, err := t.Context().ECSClient.DescribeTasks([]*string{aws.String(taskArn)})
+		if err != nil {
+			return false, err
+		}
+		if len(tasks) == 0 {
+			return false, fmt.Errorf("Could not find task %s", taskID)
+		}
+		if aws.StringValue(tasks[0].LastStatus) == ecs.DesiredStatusStopped {
+			stoppedTask = tasks[0]
+			return true, nil
+		}
+		return false, nil
+	}, t)
+	if err != nil {
+		if utils.IsInterrupted(err) {
+			log.WithFields(log.Fields{
+				"task": taskID,
+			}).Warn("Stopped following the task, which keeps running")
+		}
+		return err
+	}
+
+	// print what was logged between the last check and the task stopping
+	if err = taskLogs.Print(); err != nil {
+		return err
+	}
+	return taskExitStatus(stoppedTask, taskDef)
+}
+
+// taskExitStatus logs why each container of the stopped task stopped. It returns an error with the
+// exit code of the first essential container of the task definition if that did not exit with 0.
+func taskExitStatus(ecsTask *ecs.Task, taskDef *ecs.TaskDefinition) error {
+	log.WithFields(log.Fields{
+		"task":   entity.GetIdFromArn(ecsTask.TaskArn),
+		"reason": aws.StringValue(ecsTask.StoppedReason),
+	}).Info("Task stopped")
+
+	containers := make(map[string]*ecs.Container)
+	for _, container := range ecsTask.Containers {
+		fields := log.Fields{
+			"container": aws.StringValue(container.Name),
+			"reason":    aws.StringValue(container.Reason),
+		}
+		if container.ExitCode != nil {
+			fields["exitCode"] = aws.Int64Value(container.ExitCode)
+		}
+		log.WithFields(fields).Info("Container stopped")
+		containers[aws.StringValue(container.Name)] = container
+	}
+
+	for _, containerDef := range taskDef.ContainerDefinitions {
+		// containers are essential unless the task definition says otherwise
+		if containerDef.Essential != nil && !aws.BoolValue(containerDef.Essential) {
+			continue
+		}
+		name := aws.StringValue(containerDef.Name)
+		container, ok := containers[name]
+		if !ok || container.ExitCode == nil {
+			return cli.NewExitError(fmt.Sprintf("Essential container %s did not exit: %s", name, aws.StringValue(ecsTask.StoppedReason)), 1)
+		}
+		if exitCode := aws.Int64Value(container.ExitCode); exitCode != 0 {
+			return cli.NewExitError(fmt.Sprintf("Essential container %s exited with code %d", name, exitCode), int(exitCode))
+		}
+		return nil
+	}
+	return nil
+}
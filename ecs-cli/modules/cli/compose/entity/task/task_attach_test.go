@@ -0,0 +1,183 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package task
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	cwlogsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const (
+	attachTaskArn = "arn:aws:ecs:us-west-2:123412341234:task/attachtask"
+	attachTaskID  = "attachtask"
+)
+
+func attachContainerDef(name string, essential *bool) *ecs.ContainerDefinition {
+	return &ecs.ContainerDefinition{
+		Name:      aws.String(name),
+		Essential: essential,
+		LogConfiguration: &ecs.LogConfiguration{
+			LogDriver: aws.String("awslogs"),
+			Options: map[string]*string{
+				"awslogs-group":         aws.String("migrations"),
+				"awslogs-region":        aws.String("us-west-2"),
+				"awslogs-stream-prefix": aws.String("ecs"),
+			},
+		},
+	}
+}
+
+func TestTaskRunWithAttach(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+	mockLogsFactory := mock_cloudwatchlogs.NewMockLogClientFactory(ctrl)
+	mockLogs := mock_cloudwatchlogs.NewMockClient(ctrl)
+
+	defaultLogsOutput, defaultNewLogClientFactory := logsOutput, newLogClientFactory
+	defer func() { logsOutput, newLogClientFactory = defaultLogsOutput, defaultNewLogClientFactory }()
+	out := &bytes.Buffer{}
+	logsOutput = out
+	newLogClientFactory = func(*config.CommandConfig) cwlogsclient.LogClientFactory {
+		return mockLogsFactory
+	}
+
+	taskDefinition := ecs.TaskDefinition{
+		Family: aws.String("migrate"),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			attachContainerDef("migrate", nil),
+			attachContainerDef("proxy", aws.Bool(false)),
+		},
+	}
+	respTaskDef := taskDefinition
+	respTaskDef.TaskDefinitionArn = aws.String("arn:aws:ecs:us-west-2:123412341234:task-definition/migrate:1")
+
+	runningTask := &ecs.Task{
+		TaskArn:       aws.String(attachTaskArn),
+		LastStatus:    aws.String(ecs.DesiredStatusRunning),
+		DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+	}
+	stoppedTask := &ecs.Task{
+		TaskArn:       aws.String(attachTaskArn),
+		LastStatus:    aws.String(ecs.DesiredStatusStopped),
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		StoppedReason: aws.String("Essential container in task exited"),
+		Containers: []*ecs.Container{
+			{Name: aws.String("migrate"), ExitCode: aws.Int64(3)},
+			{Name: aws.String("proxy"), ExitCode: aws.Int64(0)},
+		},
+	}
+
+	gomock.InOrder(
+		mockEcs.EXPECT().RegisterTaskDefinitionIfNeeded(gomock.Any(), gomock.Any()).Return(&respTaskDef, nil),
+		mockEcs.EXPECT().ListAccountSettings(gomock.Any()).Return(&ecs.ListAccountSettingsOutput{
+			Settings: []*ecs.Setting{{Value: aws.String("disabled"), Name: aws.String(ecs.SettingNameTaskLongArnFormat)}},
+		}, nil),
+		mockEcs.EXPECT().RunTask(gomock.Any()).Return(&ecs.RunTaskOutput{Tasks: []*ecs.Task{runningTask}}, nil),
+		mockLogsFactory.EXPECT().Get("us-west-2").Return(mockLogs),
+		mockEcs.EXPECT().DescribeTasks(gomock.Any()).Return([]*ecs.Task{runningTask}, nil),
+		mockLogs.EXPECT().FilterAllLogEvents(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
+			action := y.(func([]*cloudwatchlogs.FilteredLogEvent))
+			action([]*cloudwatchlogs.FilteredLogEvent{{
+				LogStreamName: aws.String("ecs/migrate/" + attachTaskID),
+				Message:       aws.String("migration 42 failed"),
+				Timestamp:     aws.Int64(1),
+			}})
+		}).Return(nil),
+		mockEcs.EXPECT().DescribeTasks(gomock.Any()).Do(func(x interface{}) {
+			assert.Equal(t, []string{attachTaskArn}, aws.StringValueSlice(x.([]*string)))
+		}).Return([]*ecs.Task{stoppedTask}, nil),
+		mockLogs.EXPECT().FilterAllLogEvents(gomock.Any(), gomock.Any()).Return(nil),
+	)
+
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Bool(flags.AttachFlag, true, "")
+	task := NewTask(&context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{},
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+	})
+	task.SetTaskDefinition(&taskDefinition)
+
+	err := task.Run(nil)
+	if assert.Error(t, err, "Expected error when the essential container fails") {
+		exitErr, ok := err.(cli.ExitCoder)
+		if assert.True(t, ok, "Expected an error with an exit code") {
+			assert.Equal(t, 3, exitErr.ExitCode())
+		}
+	}
+	assert.Equal(t, "migrate | migration 42 failed\n", out.String())
+}
+
+func TestTaskExitStatus(t *testing.T) {
+	taskDef := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			attachContainerDef("sidecar", aws.Bool(false)),
+			attachContainerDef("job", aws.Bool(true)),
+		},
+	}
+
+	testCases := map[string]struct {
+		containers []*ecs.Container
+		exitCode   int
+	}{
+		"success": {
+			containers: []*ecs.Container{
+				{Name: aws.String("sidecar"), ExitCode: aws.Int64(137)},
+				{Name: aws.String("job"), ExitCode: aws.Int64(0)},
+			},
+		},
+		"failure": {
+			containers: []*ecs.Container{
+				{Name: aws.String("sidecar"), ExitCode: aws.Int64(0)},
+				{Name: aws.String("job"), ExitCode: aws.Int64(2)},
+			},
+			exitCode: 2,
+		},
+		"did not exit": {
+			containers: []*ecs.Container{
+				{Name: aws.String("job"), Reason: aws.String("CannotPullContainerError")},
+			},
+			exitCode: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := taskExitStatus(&ecs.Task{TaskArn: aws.String(attachTaskArn), Containers: testCase.containers}, taskDef)
+			if testCase.exitCode == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			exitErr, ok := err.(cli.ExitCoder)
+			if assert.True(t, ok, "Expected an error with an exit code") {
+				assert.Equal(t, testCase.exitCode, exitErr.ExitCode())
+			}
+		})
+	}
+}
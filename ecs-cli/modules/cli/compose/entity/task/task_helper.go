@@ -16,6 +16,7 @@ package task
 import (
 	log "github.com/sirupsen/logrus"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/waiters"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -25,7 +26,8 @@ import (
 func waitForTasks(task *Task, taskArns map[string]bool) error {
 	timeoutMessage := "Timeout waiting for ECS running task count to match desired task count."
 
-	return waiters.TaskWaitUntilTimeout(func(retryCount int) (bool, error) {
+	var lastTasks []*ecs.Task
+	err := waiters.TaskWaitUntilTimeout(task.Context().CommandConfig.Context(), func(retryCount int) (bool, error) {
 		if len(taskArns) == 0 {
 			return true, nil
 		}
@@ -37,6 +39,7 @@ func waitForTasks(task *Task, taskArns map[string]bool) error {
 		if err != nil {
 			return false, err
 		}
+		lastTasks = ecsTasks
 
 		// log tasks status
 		checkECSTasksStatus(ecsTasks, taskArns, retryCount)
@@ -47,6 +50,26 @@ func waitForTasks(task *Task, taskArns map[string]bool) error {
 
 		return false, nil
 	}, task, timeoutMessage)
+
+	if utils.IsInterrupted(err) {
+		logTasksLeftBehind(lastTasks, taskArns)
+	}
+	return err
+}
+
+// logTasksLeftBehind logs the tasks that had not reached their desired status yet once the CLI
+// stopped waiting for them because the command was interrupted or its --timeout expired
+func logTasksLeftBehind(ecsTasks []*ecs.Task, taskArns map[string]bool) {
+	for _, ecsTask := range ecsTasks {
+		if !taskArns[aws.StringValue(ecsTask.TaskArn)] {
+			continue
+		}
+		log.WithFields(log.Fields{
+			"task":          entity.GetIdFromArn(ecsTask.TaskArn),
+			"lastStatus":    aws.StringValue(ecsTask.LastStatus),
+			"desiredStatus": aws.StringValue(ecsTask.DesiredStatus),
+		}).Warn("Stopped waiting for the task")
+	}
 }
 
 // checkECSTasksStatus iterates through the ecsTasks and checks if the desired status is same as last status
@@ -0,0 +1,202 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	composeutils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/urfave/cli"
+)
+
+// runOverrides are the changes compose run makes to the task definition for the task it starts
+type runOverrides struct {
+	commands    map[string][]string
+	environment map[string][]*ecs.KeyValuePair
+	cpu         map[string]int64
+	memory      map[string]int64
+	taskRoleArn string
+	taskCPU     string
+	taskMemory  string
+}
+
+// newRunOverrides reads the overrides of compose run from its flags. Container overrides can leave
+// out the container if the task definition has a single container.
+func newRunOverrides(cliContext *cli.Context, commands map[string][]string, taskDef *ecs.TaskDefinition) (*runOverrides, error) {
+	overrides := &runOverrides{
+		commands:    commands,
+		environment: make(map[string][]*ecs.KeyValuePair),
+		cpu:         make(map[string]int64),
+		memory:      make(map[string]int64),
+		taskRoleArn: cliContext.String(flags.OverrideTaskRoleArnFlag),
+		taskCPU:     cliContext.String(flags.TaskCPUFlag),
+		taskMemory:  cliContext.String(flags.TaskMemoryFlag),
+	}
+	defaultContainer := ""
+	if len(taskDef.ContainerDefinitions) == 1 {
+		defaultContainer = aws.StringValue(taskDef.ContainerDefinitions[0].Name)
+	}
+
+	for _, env := range cliContext.StringSlice(flags.EnvFlag) {
+		variable := strings.SplitN(env, "=", 2)
+		if len(variable) != 2 {
+			return nil, fmt.Errorf("Invalid --%s '%s'; expected CONTAINER:KEY=VALUE", flags.EnvFlag, env)
+		}
+		container, key := defaultContainer, variable[0]
+		// only the key can name the container, as values may contain colons
+		if i := strings.Index(key, ":"); i >= 0 {
+			container, key = key[:i], key[i+1:]
+		}
+		if container == "" || key == "" {
+			return nil, fmt.Errorf("Invalid --%s '%s'; expected CONTAINER:KEY=VALUE", flags.EnvFlag, env)
+		}
+		overrides.environment[container] = append(overrides.environment[container], &ecs.KeyValuePair{
+			Name:  aws.String(key),
+			Value: aws.String(variable[1]),
+		})
+	}
+
+	if err := parseContainerLimits(cliContext.StringSlice(flags.ContainerCPUFlag), flags.ContainerCPUFlag, defaultContainer, overrides.cpu); err != nil {
+		return nil, err
+	}
+	if err := parseContainerLimits(cliContext.StringSlice(flags.ContainerMemoryFlag), flags.ContainerMemoryFlag, defaultContainer, overrides.memory); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// parseContainerLimits parses CONTAINER=VALUE limits of the flag into limits
+func parseContainerLimits(values []string, flagName, defaultContainer string, limits map[string]int64) error {
+	for _, value := range values {
+		container, limit := defaultContainer, value
+		if i := strings.LastIndex(value, "="); i >= 0 {
+			container, limit = value[:i], value[i+1:]
+		}
+		parsed, err := strconv.ParseInt(limit, 10, 64)
+		if container == "" || err != nil || parsed <= 0 {
+			return fmt.Errorf("Invalid --%s '%s'; expected CONTAINER=VALUE with a positive integer value", flagName, value)
+		}
+		limits[container] = parsed
+	}
+	return nil
+}
+
+// containers returns the names of the overridden containers, sorted
+func (o *runOverrides) containers() []string {
+	names := make(map[string]bool)
+	for name := range o.commands {
+		names[name] = true
+	}
+	for name := range o.environment {
+		names[name] = true
+	}
+	for name := range o.cpu {
+		names[name] = true
+	}
+	for name := range o.memory {
+		names[name] = true
+	}
+
+	containers := []string{}
+	for name := range names {
+		containers = append(containers, name)
+	}
+	sort.Strings(containers)
+	return containers
+}
+
+// taskSize returns the CPU and memory to run the task with if either is overridden, taking the other
+// from the task definition. With the FARGATE launch type they must be a supported task size.
+func (o *runOverrides) taskSize(taskDef *ecs.TaskDefinition, launchType string) (string, string, error) {
+	if o.taskCPU == "" && o.taskMemory == "" {
+		return "", "", nil
+	}
+	cpu, memory := o.taskCPU, o.taskMemory
+	if cpu == "" {
+		cpu = aws.StringValue(taskDef.Cpu)
+	}
+	if memory == "" {
+		memory = aws.StringValue(taskDef.Memory)
+	}
+
+	if launchType == config.LaunchTypeFargate {
+		if err := composeutils.ValidateFargateTaskSize(cpu, memory); err != nil {
+			return "", "", fmt.Errorf("Invalid --%s and --%s overrides: %s", flags.TaskCPUFlag, flags.TaskMemoryFlag, err.Error())
+		}
+	}
+	return cpu, memory, nil
+}
+
+// convertToECSTaskOverride returns the container, task size and task role overrides of the task, or nil
+// if there are none. All the overridden containers must be defined in the task definition.
+func convertToECSTaskOverride(overrides *runOverrides, taskDef *ecs.TaskDefinition, launchType string) (*ecs.TaskOverride, error) {
+	if overrides == nil {
+		return nil, nil
+	}
+	cpu, memory, err := overrides.taskSize(taskDef, launchType)
+	if err != nil {
+		return nil, err
+	}
+
+	definedContainers := make(map[string]bool)
+	for _, containerDef := range taskDef.ContainerDefinitions {
+		definedContainers[aws.StringValue(containerDef.Name)] = true
+	}
+
+	containerOverrides := []*ecs.ContainerOverride{}
+	for _, name := range overrides.containers() {
+		if !definedContainers[name] {
+			return nil, fmt.Errorf("Cannot override container %s, which is not defined in task definition %s", name, aws.StringValue(taskDef.Family))
+		}
+		containerOverride := &ecs.ContainerOverride{
+			Name:        aws.String(name),
+			Environment: overrides.environment[name],
+		}
+		if command, ok := overrides.commands[name]; ok {
+			containerOverride.Command = aws.StringSlice(command)
+		}
+		if cpu, ok := overrides.cpu[name]; ok {
+			containerOverride.Cpu = aws.Int64(cpu)
+		}
+		if memory, ok := overrides.memory[name]; ok {
+			containerOverride.Memory = aws.Int64(memory)
+		}
+		containerOverrides = append(containerOverrides, containerOverride)
+	}
+
+	if len(containerOverrides) == 0 && cpu == "" && memory == "" && overrides.taskRoleArn == "" {
+		return nil, nil
+	}
+	taskOverride := &ecs.TaskOverride{}
+	if len(containerOverrides) > 0 {
+		taskOverride.ContainerOverrides = containerOverrides
+	}
+	if cpu != "" {
+		taskOverride.Cpu = aws.String(cpu)
+	}
+	if memory != "" {
+		taskOverride.Memory = aws.String(memory)
+	}
+	if overrides.taskRoleArn != "" {
+		taskOverride.TaskRoleArn = aws.String(overrides.taskRoleArn)
+	}
+	return taskOverride, nil
+}
@@ -0,0 +1,270 @@
+// Copyright 2015-2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package task
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func overridesContext(env, cpu, memory []string, values map[string]string) *cli.Context {
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	envs, cpus, memories := cli.StringSlice(env), cli.StringSlice(cpu), cli.StringSlice(memory)
+	flagSet.Var(&envs, flags.EnvFlag, "")
+	flagSet.Var(&cpus, flags.ContainerCPUFlag, "")
+	flagSet.Var(&memories, flags.ContainerMemoryFlag, "")
+	for _, name := range []string{flags.OverrideTaskRoleArnFlag, flags.TaskCPUFlag, flags.TaskMemoryFlag} {
+		flagSet.String(name, values[name], "")
+	}
+	return cli.NewContext(nil, flagSet, nil)
+}
+
+func overridesTaskDef(containers ...string) *ecs.TaskDefinition {
+	taskDef := &ecs.TaskDefinition{
+		Family: aws.String("migrate"),
+		Cpu:    aws.String("512"),
+		Memory: aws.String("1024"),
+	}
+	for _, container := range containers {
+		taskDef.ContainerDefinitions = append(taskDef.ContainerDefinitions, &ecs.ContainerDefinition{Name: aws.String(container)})
+	}
+	return taskDef
+}
+
+func TestConvertToECSTaskOverrideWithAllOverrides(t *testing.T) {
+	taskDef := overridesTaskDef("migrate", "proxy")
+	cliContext := overridesContext(
+		[]string{"migrate:DATABASE_URL=postgres://db:5432/app", "migrate:DRY_RUN=", "proxy:MODE=passthrough"},
+		[]string{"migrate=256"},
+		[]string{"migrate=768", "proxy=128"},
+		map[string]string{flags.OverrideTaskRoleArnFlag: "arn:aws:iam::123412341234:role/migrations"},
+	)
+	commands := map[string][]string{"migrate": {"rake", "db:migrate"}}
+
+	overrides, err := newRunOverrides(cliContext, commands, taskDef)
+	assert.NoError(t, err, "Unexpected error reading overrides")
+	actual, err := convertToECSTaskOverride(overrides, taskDef, config.LaunchTypeEC2)
+	assert.NoError(t, err, "Unexpected error converting overrides")
+
+	expected := &ecs.TaskOverride{
+		TaskRoleArn: aws.String("arn:aws:iam::123412341234:role/migrations"),
+		ContainerOverrides: []*ecs.ContainerOverride{
+			{
+				Name:    aws.String("migrate"),
+				Command: aws.StringSlice([]string{"rake", "db:migrate"}),
+				Environment: []*ecs.KeyValuePair{
+					{Name: aws.String("DATABASE_URL"), Value: aws.String("postgres://db:5432/app")},
+					{Name: aws.String("DRY_RUN"), Value: aws.String("")},
+				},
+				Cpu:    aws.Int64(256),
+				Memory: aws.Int64(768),
+			},
+			{
+				Name:        aws.String("proxy"),
+				Environment: []*ecs.KeyValuePair{{Name: aws.String("MODE"), Value: aws.String("passthrough")}},
+				Memory:      aws.Int64(128),
+			},
+		},
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestConvertToECSTaskOverrideWithSingleContainer(t *testing.T) {
+	taskDef := overridesTaskDef("migrate")
+	cliContext := overridesContext([]string{"DATABASE_URL=postgres://db:5432/app"}, []string{"256"}, nil, nil)
+
+	overrides, err := newRunOverrides(cliContext, nil, taskDef)
+	assert.NoError(t, err, "Unexpected error reading overrides")
+	actual, err := convertToECSTaskOverride(overrides, taskDef, config.LaunchTypeEC2)
+	assert.NoError(t, err, "Unexpected error converting overrides")
+
+	expected := &ecs.TaskOverride{
+		ContainerOverrides: []*ecs.ContainerOverride{
+			{
+				Name:        aws.String("migrate"),
+				Environment: []*ecs.KeyValuePair{{Name: aws.String("DATABASE_URL"), Value: aws.String("postgres://db:5432/app")}},
+				Cpu:         aws.Int64(256),
+			},
+		},
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestConvertToECSTaskOverrideWithoutOverrides(t *testing.T) {
+	taskDef := overridesTaskDef("migrate")
+
+	overrides, err := newRunOverrides(overridesContext(nil, nil, nil, nil), nil, taskDef)
+	assert.NoError(t, err, "Unexpected error reading overrides")
+	actual, err := convertToECSTaskOverride(overrides, taskDef, config.LaunchTypeEC2)
+	assert.NoError(t, err, "Unexpected error converting overrides")
+	assert.Nil(t, actual, "Expected no overrides")
+}
+
+func TestConvertToECSTaskOverrideWithUndefinedContainer(t *testing.T) {
+	taskDef := overridesTaskDef("migrate", "proxy")
+	cliContext := overridesContext([]string{"worker:QUEUE=default"}, nil, nil, nil)
+
+	overrides, err := newRunOverrides(cliContext, nil, taskDef)
+	assert.NoError(t, err, "Unexpected error reading overrides")
+	_, err = convertToECSTaskOverride(overrides, taskDef, config.LaunchTypeEC2)
+	assert.Error(t, err, "Expected error overriding a container which is not in the task definition")
+}
+
+func TestNewRunOverridesErrors(t *testing.T) {
+	testCases := map[string]*cli.Context{
+		"env without container":    overridesContext([]string{"QUEUE=default"}, nil, nil, nil),
+		"env without value":        overridesContext([]string{"migrate:QUEUE"}, nil, nil, nil),
+		"env without key":          overridesContext([]string{"migrate:=default"}, nil, nil, nil),
+		"cpu without container":    overridesContext(nil, []string{"256"}, nil, nil),
+		"cpu not a number":         overridesContext(nil, []string{"migrate=quarter"}, nil, nil),
+		"memory not positive":      overridesContext(nil, nil, []string{"migrate=0"}, nil),
+		"memory with unit":         overridesContext(nil, nil, []string{"migrate=512MiB"}, nil),
+		"memory without container": overridesContext(nil, nil, []string{"=512"}, nil),
+	}
+
+	for name, cliContext := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := newRunOverrides(cliContext, nil, overridesTaskDef("migrate", "proxy"))
+			assert.Error(t, err, "Expected error reading invalid overrides")
+		})
+	}
+}
+
+func TestRunOverridesTaskSize(t *testing.T) {
+	testCases := map[string]struct {
+		cpu            string
+		memory         string
+		launchType     string
+		expectedCPU    string
+		expectedMemory string
+		expectErr      bool
+	}{
+		"no overrides": {
+			launchType: config.LaunchTypeFargate,
+		},
+		"fargate cpu and memory": {
+			cpu:            "1 vCPU",
+			memory:         "4GB",
+			launchType:     config.LaunchTypeFargate,
+			expectedCPU:    "1 vCPU",
+			expectedMemory: "4GB",
+		},
+		"fargate memory with task definition cpu": {
+			memory:         "2048",
+			launchType:     config.LaunchTypeFargate,
+			expectedCPU:    "512",
+			expectedMemory: "2048",
+		},
+		"fargate cpu too small for task definition memory": {
+			cpu:        "256",
+			memory:     "4096",
+			launchType: config.LaunchTypeFargate,
+			expectErr:  true,
+		},
+		"fargate cpu not supported": {
+			cpu:        "768",
+			launchType: config.LaunchTypeFargate,
+			expectErr:  true,
+		},
+		"ec2 is not limited to fargate sizes": {
+			cpu:            "768",
+			launchType:     config.LaunchTypeEC2,
+			expectedCPU:    "768",
+			expectedMemory: "1024",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			overrides := &runOverrides{taskCPU: testCase.cpu, taskMemory: testCase.memory}
+			cpu, memory, err := overrides.taskSize(overridesTaskDef("migrate"), testCase.launchType)
+			if testCase.expectErr {
+				assert.Error(t, err, "Expected error for an invalid task size")
+				return
+			}
+			assert.NoError(t, err, "Unexpected error for a valid task size")
+			assert.Equal(t, testCase.expectedCPU, cpu)
+			assert.Equal(t, testCase.expectedMemory, memory)
+		})
+	}
+}
+
+func TestTaskRunWithTaskSizeOverride(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+	taskDefinition := overridesTaskDef("migrate")
+	respTaskDef := *taskDefinition
+	respTaskDef.TaskDefinitionArn = aws.String("arn:aws:ecs:us-west-2:123412341234:task-definition/migrate:1")
+
+	gomock.InOrder(
+		mockEcs.EXPECT().RegisterTaskDefinitionIfNeeded(gomock.Any(), gomock.Any()).Return(&respTaskDef, nil),
+		mockEcs.EXPECT().ListAccountSettings(gomock.Any()).Return(&ecs.ListAccountSettingsOutput{
+			Settings: []*ecs.Setting{{Value: aws.String("disabled"), Name: aws.String(ecs.SettingNameTaskLongArnFormat)}},
+		}, nil),
+		mockEcs.EXPECT().RunTask(gomock.Any()).Do(func(x interface{}) {
+			req := x.(*ecs.RunTaskInput)
+			assert.Equal(t, aws.StringValue(respTaskDef.TaskDefinitionArn), aws.StringValue(req.TaskDefinition))
+			if assert.NotNil(t, req.Overrides, "Expected the task overrides") {
+				assert.Equal(t, "2048", aws.StringValue(req.Overrides.Cpu))
+				assert.Equal(t, "8GB", aws.StringValue(req.Overrides.Memory))
+				assert.Equal(t, int64(7168), aws.Int64Value(req.Overrides.ContainerOverrides[0].Memory))
+			}
+		}).Return(&ecs.RunTaskOutput{}, nil),
+	)
+
+	task := NewTask(&context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{LaunchType: config.LaunchTypeFargate},
+		ECSParams:     fargateECSParamsWithPlatformVersion(""),
+		CLIContext: overridesContext(nil, nil, []string{"7168"}, map[string]string{
+			flags.TaskCPUFlag:    "2048",
+			flags.TaskMemoryFlag: "8GB",
+		}),
+	})
+	task.SetTaskDefinition(taskDefinition)
+
+	assert.NoError(t, task.Run(nil), "Unexpected error running task")
+}
+
+func TestTaskRunWithInvalidFargateTaskSizeOverride(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEcs := mock_ecs.NewMockECSClient(ctrl)
+
+	taskDefinition := overridesTaskDef("migrate")
+	respTaskDef := *taskDefinition
+	respTaskDef.TaskDefinitionArn = aws.String("arn:aws:ecs:us-west-2:123412341234:task-definition/migrate:1")
+	mockEcs.EXPECT().RegisterTaskDefinitionIfNeeded(gomock.Any(), gomock.Any()).Return(&respTaskDef, nil)
+
+	task := NewTask(&context.ECSContext{
+		ECSClient:     mockEcs,
+		CommandConfig: &config.CommandConfig{LaunchType: config.LaunchTypeFargate},
+		CLIContext:    overridesContext(nil, nil, nil, map[string]string{flags.TaskMemoryFlag: "16GB"}),
+	})
+	task.SetTaskDefinition(taskDefinition)
+
+	assert.Error(t, task.Run(nil), "Expected error running a task with an unsupported Fargate task size")
+}
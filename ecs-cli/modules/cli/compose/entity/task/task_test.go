@@ -22,6 +22,7 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	utils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/golang/mock/gomock"
@@ -157,8 +158,13 @@ func TestConvertToECSTaskOverride(t *testing.T) {
 	container := "railsapp"
 	command := []string{"bundle exec puma -C config/puma.rb"}
 
-	input := map[string][]string{
-		container: command,
+	input := &runOverrides{
+		commands: map[string][]string{
+			container: command,
+		},
+	}
+	taskDef := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{{Name: aws.String(container)}},
 	}
 
 	expected := &ecs.TaskOverride{
@@ -170,7 +176,7 @@ func TestConvertToECSTaskOverride(t *testing.T) {
 		},
 	}
 
-	actual, err := convertToECSTaskOverride(input)
+	actual, err := convertToECSTaskOverride(input, taskDef, config.LaunchTypeEC2)
 
 	if assert.NoError(t, err) {
 		assert.Equal(t, expected, actual)
@@ -178,9 +184,9 @@ func TestConvertToECSTaskOverride(t *testing.T) {
 }
 
 func TestConvertToECSTaskOverride_WithNil(t *testing.T) {
-	var input map[string][]string
+	var input *runOverrides
 
-	actual, err := convertToECSTaskOverride(input)
+	actual, err := convertToECSTaskOverride(input, &ecs.TaskDefinition{}, config.LaunchTypeEC2)
 
 	if assert.NoError(t, err) {
 		assert.Nil(t, actual)
@@ -244,8 +250,13 @@ func TestBuildRuntaskInput_WithOverride(t *testing.T) {
 	container := "railsapp"
 	launchType := "EC2"
 	command := []string{"bundle exec puma -C config/puma.rb"}
-	override := map[string][]string{
-		container: command,
+	override := &ecs.TaskOverride{
+		ContainerOverrides: []*ecs.ContainerOverride{
+			{
+				Name:    aws.String(container),
+				Command: aws.StringSlice(command),
+			},
+		},
 	}
 
 	flagSet := flag.NewFlagSet("ecs-cli", 0)
@@ -386,3 +397,85 @@ func TestBuildRuntaskInputManagedTagsDisabled(t *testing.T) {
 		assert.Nil(t, req.EnableECSManagedTags, "Expected ECS Managed tags to be unset")
 	}
 }
+
+func fargateECSParamsWithPlatformVersion(platformVersion string) *utils.ECSParams {
+	return &utils.ECSParams{
+		TaskDefinition: utils.EcsTaskDef{
+			NetworkMode: "awsvpc",
+		},
+		RunParams: utils.RunParams{
+			NetworkConfiguration: utils.NetworkConfiguration{
+				AwsVpcConfiguration: utils.AwsVpcConfiguration{
+					Subnets: []string{"subnet-feedface"},
+				},
+			},
+			PlatformVersion: platformVersion,
+		},
+	}
+}
+
+func TestBuildRuntaskInputWithPlatformVersion(t *testing.T) {
+	testCases := map[string]struct {
+		flagValue       string
+		paramsValue     string
+		expectedVersion string
+	}{
+		"from ecs params": {
+			paramsValue:     "1.3.0",
+			expectedVersion: "1.3.0",
+		},
+		"flag overrides ecs params": {
+			flagValue:       "LATEST",
+			paramsValue:     "1.3.0",
+			expectedVersion: "LATEST",
+		},
+		"not set": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			flagSet := flag.NewFlagSet("ecs-cli", 0)
+			flagSet.Bool(flags.DisableECSManagedTagsFlag, true, "")
+			flagSet.String(flags.PlatformVersionFlag, tc.flagValue, "")
+			context := &context.ECSContext{
+				CLIContext: cli.NewContext(nil, flagSet, nil),
+				CommandConfig: &config.CommandConfig{
+					Cluster:    "myCluster",
+					LaunchType: config.LaunchTypeFargate,
+				},
+				ECSParams: fargateECSParamsWithPlatformVersion(tc.paramsValue),
+			}
+			task := &Task{
+				ecsContext: context,
+			}
+
+			req, err := task.buildRunTaskInput("clydeApp", 1, nil)
+
+			if assert.NoError(t, err) {
+				if tc.expectedVersion == "" {
+					assert.Nil(t, req.PlatformVersion, "Expected platform version to be unset")
+				} else {
+					assert.Equal(t, tc.expectedVersion, aws.StringValue(req.PlatformVersion), "Expected platform version to match")
+				}
+			}
+		})
+	}
+}
+
+func TestBuildRuntaskInputWithPlatformVersionAndEC2(t *testing.T) {
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.String(flags.PlatformVersionFlag, "1.3.0", "")
+	context := &context.ECSContext{
+		CLIContext: cli.NewContext(nil, flagSet, nil),
+		CommandConfig: &config.CommandConfig{
+			Cluster:    "myCluster",
+			LaunchType: config.LaunchTypeEC2,
+		},
+	}
+	task := &Task{
+		ecsContext: context,
+	}
+
+	_, err := task.buildRunTaskInput("clydeApp", 1, nil)
+	assert.Error(t, err, "Expected error with a platform version and launch type EC2")
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/project"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	cliUtils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
 
 	"github.com/docker/libcompose/cli/command"
@@ -26,8 +27,12 @@ import (
 // ProjectFactory is an interface that surfaces a function to create ECS Compose Project (intended to make mocking easy in tests)
 type ProjectFactory interface {
 	Create(cliContext *cli.Context, isService bool) (project.Project, error)
+	CreateOffline(cliContext *cli.Context) (project.Project, error)
 }
 
+// commandConfigFunc creates the CommandConfig for a project from the cli context
+type commandConfigFunc func(*cli.Context, config.ReadWriter) (*config.CommandConfig, error)
+
 // projectFactory implements ProjectFactory interface
 type projectFactory struct {
 }
@@ -39,9 +44,20 @@ func NewProjectFactory() ProjectFactory {
 
 // Create is a factory function that creates and configures ECS Compose project using the supplied command line arguments
 func (projectFactory projectFactory) Create(cliContext *cli.Context, isService bool) (project.Project, error) {
+	return projectFactory.create(cliContext, isService, config.NewCommandConfig)
+}
+
+// CreateOffline creates and configures an ECS Compose project for a task
+// without AWS clients, so that commands that do not call AWS can run without
+// a region or credentials configured
+func (projectFactory projectFactory) CreateOffline(cliContext *cli.Context) (project.Project, error) {
+	return projectFactory.create(cliContext, false, config.NewLocalCommandConfig)
+}
+
+func (projectFactory projectFactory) create(cliContext *cli.Context, isService bool, newCommandConfig commandConfigFunc) (project.Project, error) {
 	// creates and populates the ecs context
 	ecsContext := &context.ECSContext{}
-	if err := projectFactory.populateContext(ecsContext, cliContext); err != nil {
+	if err := projectFactory.populateContextWithConfig(ecsContext, cliContext, newCommandConfig); err != nil {
 		return nil, err
 	}
 	ecsContext.IsService = isService
@@ -58,6 +74,10 @@ func (projectFactory projectFactory) Create(cliContext *cli.Context, isService b
 
 // populateContext sets the required CLI arguments to the ECS context
 func (projectFactory projectFactory) populateContext(ecsContext *context.ECSContext, cliContext *cli.Context) error {
+	return projectFactory.populateContextWithConfig(ecsContext, cliContext, config.NewCommandConfig)
+}
+
+func (projectFactory projectFactory) populateContextWithConfig(ecsContext *context.ECSContext, cliContext *cli.Context, newCommandConfig commandConfigFunc) error {
 	/*
 		Populate the following libcompose fields on the ECS context:
 		 - ComposeFiles: reads from `--file` or `-f` flags. Defaults to
@@ -75,12 +95,12 @@ func (projectFactory projectFactory) populateContext(ecsContext *context.ECSCont
 		utils.LogError(err, "Error loading config")
 		return err
 	}
-	config, err := config.NewCommandConfig(cliContext, rdwr)
+	commandConfig, err := newCommandConfig(cliContext, rdwr)
 	if err != nil {
 		utils.LogError(err, "Unable to create an instance of CommandConfig given the cli context")
 		return err
 	}
-	ecsContext.CommandConfig = config
+	ecsContext.CommandConfig = commandConfig
 
 	// populate libcompose context
 	if err = projectFactory.populateLibcomposeContext(ecsContext); err != nil {
@@ -92,7 +112,7 @@ func (projectFactory projectFactory) populateContext(ecsContext *context.ECSCont
 
 // populateLibcomposeContext sets the required Libcompose lookup utilities on the ECS context
 func (projectFactory projectFactory) populateLibcomposeContext(ecsContext *context.ECSContext) error {
-	envLookup, err := utils.GetDefaultEnvironmentLookup()
+	envLookup, err := cliUtils.GetDefaultEnvironmentLookup()
 	if err != nil {
 		return err
 	}
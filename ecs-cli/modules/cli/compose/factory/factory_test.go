@@ -22,6 +22,7 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/project/mock"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli"
@@ -63,6 +64,30 @@ func TestPopulateContext(t *testing.T) {
 	}
 }
 
+func TestPopulateContextWithLocalCommandConfig(t *testing.T) {
+	globalSet := flag.NewFlagSet("ecs-cli", 0)
+	globalContext := cli.NewContext(nil, globalSet, nil)
+	flagSet := flag.NewFlagSet("ecs-cli-convert", 0)
+	cliContext := cli.NewContext(nil, flagSet, globalContext)
+	ecsContext := &context.ECSContext{}
+
+	// No region or credentials are configured
+	tempDirName, err := ioutil.TempDir("", "test")
+	if err != nil {
+		t.Fatal("Error while creating the dummy ecs config directory")
+	}
+	os.Setenv("HOME", tempDirName)
+	defer removeTempEnvironment(tempDirName)
+
+	projectFactory := projectFactory{}
+	err = projectFactory.populateContextWithConfig(ecsContext, cliContext, config.NewLocalCommandConfig)
+
+	assert.NoError(t, err, "Unexpected error populating the context")
+	if assert.NotNil(t, ecsContext.CommandConfig, "Expected CommandConfig to be set") {
+		assert.Nil(t, ecsContext.CommandConfig.Session, "Expected Session to be nil")
+	}
+}
+
 func setUpTempEnvironment(t *testing.T, tempDirName string) {
 	// Create a temprorary directory for the dummy ecs config
 	os.Setenv("HOME", tempDirName)
@@ -60,3 +60,16 @@ func (m *MockProjectFactory) Create(arg0 *cli.Context, arg1 bool) (project.Proje
 func (mr *MockProjectFactoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectFactory)(nil).Create), arg0, arg1)
 }
+
+// CreateOffline mocks base method
+func (m *MockProjectFactory) CreateOffline(arg0 *cli.Context) (project.Project, error) {
+	ret := m.ctrl.Call(m, "CreateOffline", arg0)
+	ret0, _ := ret[0].(project.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOffline indicates an expected call of CreateOffline
+func (mr *MockProjectFactoryMockRecorder) CreateOffline(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOffline", reflect.TypeOf((*MockProjectFactory)(nil).CreateOffline), arg0)
+}
@@ -27,10 +27,12 @@ import (
 
 // supported fields/options from compose 1/2 YAML file
 var supportedComposeV1V2YamlOptions = []string{
+	"build",
 	"cap_add",
 	"cap_drop",
 	"command",
 	"cpu_shares",
+	"depends_on",
 	"devices",
 	"dns",
 	"dns_search",
@@ -53,6 +55,7 @@ var supportedComposeV1V2YamlOptions = []string{
 	"read_only",
 	"security_opt",
 	"shm_size",
+	"stop_grace_period",
 	"tmpfs",
 	"tty",
 	"ulimits",
@@ -64,33 +67,37 @@ var supportedComposeV1V2YamlOptions = []string{
 
 // supported fields/options from compose 3 YAML file
 var supportedFieldsInV3 = map[string]bool{
-	"CapAdd":      true,
-	"CapDrop":     true,
-	"Command":     true,
-	"Devices":     true,
-	"DNS":         true,
-	"DNSSearch":   true,
-	"Entrypoint":  true,
-	"Environment": true,
-	"EnvFile":     true,
-	"ExtraHosts":  true,
-	"Hostname":    true,
-	"HealthCheck": true,
-	"Image":       true,
-	"Labels":      true,
-	"Links":       true,
-	"Logging":     true,
-	"Name":        true,
-	"Ports":       true,
-	"Privileged":  true,
-	"ReadOnly":    true,
-	"SecurityOpt": true,
-	"Tmpfs":       true,
-	"Tty":         true,
-	"Ulimits":     true,
-	"User":        true,
-	"Volumes":     true,
-	"WorkingDir":  true,
+	"Build":           true,
+	"CapAdd":          true,
+	"CapDrop":         true,
+	"Command":         true,
+	"DependsOn":       true,
+	"Deploy":          true,
+	"Devices":         true,
+	"DNS":             true,
+	"DNSSearch":       true,
+	"Entrypoint":      true,
+	"Environment":     true,
+	"EnvFile":         true,
+	"ExtraHosts":      true,
+	"Hostname":        true,
+	"HealthCheck":     true,
+	"Image":           true,
+	"Labels":          true,
+	"Links":           true,
+	"Logging":         true,
+	"Name":            true,
+	"Ports":           true,
+	"Privileged":      true,
+	"ReadOnly":        true,
+	"SecurityOpt":     true,
+	"StopGracePeriod": true,
+	"Tmpfs":           true,
+	"Tty":             true,
+	"Ulimits":         true,
+	"User":            true,
+	"Volumes":         true,
+	"WorkingDir":      true,
 }
 
 var supportedComposeV1V2YamlOptionsMap = getSupportedComposeV1V2YamlOptionsMap()
@@ -161,11 +168,20 @@ func LogUnsupportedProjectFields(project *project.Project) {
 	}
 }
 
+// unsupportedServiceOptionMessages explains why certain options cannot be supported
+var unsupportedServiceOptionMessages = map[string]string{
+	"stop_signal": "Skipping unsupported YAML option for service... ECS does not support setting a stop signal; use STOPSIGNAL in the image's Dockerfile instead.",
+}
+
 func logWarningForUnsupportedServiceOption(tagName, serviceName string) {
+	message, ok := unsupportedServiceOptionMessages[tagName]
+	if !ok {
+		message = "Skipping unsupported YAML option for service..."
+	}
 	log.WithFields(log.Fields{
 		"option name":  tagName,
 		"service name": serviceName,
-	}).Warn("Skipping unsupported YAML option for service...")
+	}).Warn(message)
 }
 
 func validNetworksForService(config *config.ServiceConfig) bool {
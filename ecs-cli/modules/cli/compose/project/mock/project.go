@@ -23,6 +23,7 @@ import (
 	adapter "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
 	context "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	entity "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	diff "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/diff"
 	project "github.com/docker/libcompose/project"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -50,6 +51,18 @@ func (m *MockProject) EXPECT() *MockProjectMockRecorder {
 	return m.recorder
 }
 
+// Abort mocks base method
+func (m *MockProject) Abort() error {
+	ret := m.ctrl.Call(m, "Abort")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Abort indicates an expected call of Abort
+func (mr *MockProjectMockRecorder) Abort() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Abort", reflect.TypeOf((*MockProject)(nil).Abort))
+}
+
 // ContainerConfigs mocks base method
 func (m *MockProject) ContainerConfigs() []adapter.ContainerConfig {
 	ret := m.ctrl.Call(m, "ContainerConfigs")
@@ -86,6 +99,19 @@ func (mr *MockProjectMockRecorder) Create() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProject)(nil).Create))
 }
 
+// Diff mocks base method
+func (m *MockProject) Diff() ([]*diff.Service, error) {
+	ret := m.ctrl.Call(m, "Diff")
+	ret0, _ := ret[0].([]*diff.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Diff indicates an expected call of Diff
+func (mr *MockProjectMockRecorder) Diff() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Diff", reflect.TypeOf((*MockProject)(nil).Diff))
+}
+
 // Down mocks base method
 func (m *MockProject) Down() error {
 	ret := m.ctrl.Call(m, "Down")
@@ -147,6 +173,31 @@ func (mr *MockProjectMockRecorder) Parse() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockProject)(nil).Parse))
 }
 
+// Revisions mocks base method
+func (m *MockProject) Revisions() (project.InfoSet, error) {
+	ret := m.ctrl.Call(m, "Revisions")
+	ret0, _ := ret[0].(project.InfoSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revisions indicates an expected call of Revisions
+func (mr *MockProjectMockRecorder) Revisions() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revisions", reflect.TypeOf((*MockProject)(nil).Revisions))
+}
+
+// Rollback mocks base method
+func (m *MockProject) Rollback(arg0 int64) error {
+	ret := m.ctrl.Call(m, "Rollback", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback
+func (mr *MockProjectMockRecorder) Rollback(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockProject)(nil).Rollback), arg0)
+}
+
 // Run mocks base method
 func (m *MockProject) Run(arg0 map[string][]string) error {
 	ret := m.ctrl.Call(m, "Run", arg0)
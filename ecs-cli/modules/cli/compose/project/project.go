@@ -19,6 +19,7 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/diff"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/service"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/task"
 	"github.com/sirupsen/logrus"
@@ -26,6 +27,7 @@ import (
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/regcredio"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/docker/libcompose/project"
 )
 
@@ -49,6 +51,10 @@ type Project interface {
 	Scale(count int) error
 	Stop() error
 	Down() error
+	Abort() error
+	Diff() ([]*diff.Service, error)
+	Revisions() (project.InfoSet, error)
+	Rollback(revision int64) error
 }
 
 // ecsProject struct is an implementation of Project.
@@ -61,6 +67,13 @@ type ecsProject struct {
 	// TODO: track a map of entities [taskDefinition -> Entity]
 	// 1 task definition for every disjoint set of containers in the compose file
 	entity entity.ProjectEntity
+
+	// entities holds one service entity per compose service with --split-services
+	entities []entity.ProjectEntity
+
+	// pinnedImages maps images to their digest with --pin-image-digests, so that
+	// an image shared by several task definitions is resolved once
+	pinnedImages map[string]string
 }
 
 // NewProject creates a new instance of the ECS Compose Project
@@ -121,6 +134,10 @@ func (p *ecsProject) Parse() error {
 		return err
 	}
 
+	if err := p.filterServices(); err != nil {
+		return err
+	}
+
 	// Populates ecs-params onto project ecsContext
 	if err := p.parseECSParams(); err != nil {
 		return err
@@ -130,6 +147,13 @@ func (p *ecsProject) Parse() error {
 		return err
 	}
 
+	if err := p.buildAndPushImages(); err != nil {
+		return err
+	}
+
+	if p.splitServices() {
+		return p.transformSplitTaskDefinitions()
+	}
 	return p.transformTaskDefinition()
 }
 
@@ -168,10 +192,14 @@ func (p *ecsProject) parseCompose() error {
 // parseECSParams sets data from the ecs-params.yml file on the ecsProject.context
 func (p *ecsProject) parseECSParams() error {
 	logrus.Debug("Parsing the ecs-params yaml...")
-	ecsParamsFileName := p.ecsContext.CLIContext.GlobalString(flags.ECSParamsFileNameFlag)
-	ecsParams, err := utils.ReadECSParams(ecsParamsFileName)
+	ecsParamsFileNames := p.ecsContext.CLIContext.GlobalStringSlice(flags.ECSParamsFileNameFlag)
+	lenient := p.ecsContext.CLIContext.GlobalBool(flags.ECSParamsLenientFlag)
+	ecsParams, err := utils.ReadECSParamsFiles(ecsParamsFileNames, lenient)
 
 	if err != nil {
+		if _, ok := err.(*utils.ECSParamsValidationError); ok {
+			logrus.Warnf("Use --%s to deploy with an ecs-params file that does not pass validation", flags.ECSParamsLenientFlag)
+		}
 		return err
 	}
 
@@ -196,8 +224,17 @@ func (p *ecsProject) parseECSRegistryCreds() error {
 // transformTaskDefinition converts the compose yml and ecs-params yml into an
 // ECS task definition and loads it onto the project entity
 func (p *ecsProject) transformTaskDefinition() error {
-	ecsContext := p.ecsContext
+	taskDefinition, err := p.convertTaskDefinition(p.ecsContext, p.ContainerConfigs(), p.VolumeConfigs())
+	if err != nil {
+		return err
+	}
+	p.entity.SetTaskDefinition(taskDefinition)
+	return nil
+}
 
+// convertTaskDefinition converts the given containers and volumes into an ECS
+// task definition named after the project of ecsContext
+func (p *ecsProject) convertTaskDefinition(ecsContext *context.ECSContext, containerConfigs []adapter.ContainerConfig, volumes *adapter.Volumes) (*ecs.TaskDefinition, error) {
 	// convert to task definition
 	logrus.Debug("Transforming yaml to task definition...")
 
@@ -209,37 +246,41 @@ func (p *ecsProject) transformTaskDefinition() error {
 		TaskDefName:            taskDefinitionName,
 		TaskRoleArn:            taskRoleArn,
 		RequiredCompatibilites: requiredCompatibilities,
-		Volumes:                p.VolumeConfigs(),
-		ContainerConfigs:       p.ContainerConfigs(), // TODO Change to pointer on project?
+		Volumes:                volumes,
+		ContainerConfigs:       containerConfigs, // TODO Change to pointer on project?
 		ECSParams:              ecsContext.ECSParams,
 		ECSRegistryCreds:       p.ecsRegistryCreds,
 	}
 
 	taskDefinition, err := utils.ConvertToTaskDefinition(convertParams)
-
 	if err != nil {
-		return err
+		return nil, err
 	}
-	p.entity.SetTaskDefinition(taskDefinition)
-	return nil
+	if err := injectDefaultAWSLogs(ecsContext, taskDefinition); err != nil {
+		return nil, err
+	}
+	if err := p.pinImageDigests(taskDefinition); err != nil {
+		return nil, err
+	}
+	return taskDefinition, nil
 }
 
 //* ----------------- commands ----------------- */
 
 func (p *ecsProject) Create() error {
-	return p.entity.Create()
+	return p.forEachEntity(entity.ProjectEntity.Create)
 }
 
 func (p *ecsProject) Start() error {
-	return p.entity.Start()
+	return p.forEachEntity(entity.ProjectEntity.Start)
 }
 
 func (p *ecsProject) Up() error {
-	return p.entity.Up()
+	return p.forEachEntity(entity.ProjectEntity.Up)
 }
 
 func (p *ecsProject) Info(desiredStatus string) (project.InfoSet, error) {
-	return p.entity.Info(true, desiredStatus)
+	return p.entitiesInfo(desiredStatus)
 }
 
 func (p *ecsProject) Run(commandOverrides map[string][]string) error {
@@ -247,13 +288,33 @@ func (p *ecsProject) Run(commandOverrides map[string][]string) error {
 }
 
 func (p *ecsProject) Scale(count int) error {
-	return p.entity.Scale(count)
+	return p.forEachEntity(func(projectEntity entity.ProjectEntity) error {
+		return projectEntity.Scale(count)
+	})
 }
 
 func (p *ecsProject) Stop() error {
-	return p.entity.Stop()
+	return p.forEachEntity(entity.ProjectEntity.Stop)
 }
 
 func (p *ecsProject) Down() error {
-	return p.entity.Down()
+	return p.forEachEntity(entity.ProjectEntity.Down)
+}
+
+func (p *ecsProject) Abort() error {
+	return p.forEachEntity(entity.ProjectEntity.Abort)
+}
+
+func (p *ecsProject) Diff() ([]*diff.Service, error) {
+	return p.entitiesDiff()
+}
+
+func (p *ecsProject) Revisions() (project.InfoSet, error) {
+	return p.entitiesRevisions()
+}
+
+func (p *ecsProject) Rollback(revision int64) error {
+	return p.forEachEntity(func(projectEntity entity.ProjectEntity) error {
+		return projectEntity.Rollback(revision)
+	})
 }
@@ -0,0 +1,93 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/image"
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	stsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts"
+	dockerclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// make the docker and AWS clients used to build and push images easily mockable in tests
+var newDockerClient = dockerclient.NewClient
+var newECRClient = ecrclient.NewClient
+var newSTSClient = stsclient.NewClient
+
+// buildAndPushImages builds the image of every compose service with a build
+// section, pushes it to ECR and rewrites the image of the service to the
+// pushed URI, when --build-and-push is set
+func (p *ecsProject) buildAndPushImages() error {
+	var builds []*adapter.ContainerConfig
+	for i := range p.containerConfigs {
+		if p.containerConfigs[i].Build != nil {
+			builds = append(builds, &p.containerConfigs[i])
+		}
+	}
+	if len(builds) == 0 {
+		return nil
+	}
+
+	if !p.ecsContext.CLIContext.Bool(flags.BuildAndPushFlag) {
+		for _, config := range builds {
+			if config.Image == "" {
+				logrus.WithField("service", config.Name).Warnf("Service has a build section but no image; use --%s to build and push it to ECR", flags.BuildAndPushFlag)
+			}
+		}
+		return nil
+	}
+
+	dockerClient, err := newDockerClient()
+	if err != nil {
+		return err
+	}
+	commandConfig := p.ecsContext.CommandConfig
+	ecrClient := newECRClient(commandConfig)
+	stsClient := newSTSClient(commandConfig)
+
+	for _, config := range builds {
+		input := image.BuildImageInput{
+			Image:      buildImageName(p.ecsContext.ProjectName, config),
+			ContextDir: config.Build.Context,
+			Dockerfile: config.Build.Dockerfile,
+			BuildArgs:  config.Build.Args,
+		}
+		pushed, err := image.BuildAndPushImage(input, dockerClient, ecrClient, stsClient)
+		if err != nil {
+			return errors.Wrapf(err, "unable to build and push the image of service %s", config.Name)
+		}
+		logrus.WithFields(logrus.Fields{
+			"service": config.Name,
+			"image":   pushed,
+		}).Info("Using pushed image")
+		config.Image = pushed
+	}
+	return nil
+}
+
+// buildImageName returns the name the image of a compose service is pushed
+// as: its image if it has one, otherwise <project-name>-<service-name>, which
+// is lower cased as ECR repository names must be
+func buildImageName(projectName string, config *adapter.ContainerConfig) string {
+	if config.Image != "" {
+		return config.Image
+	}
+	return strings.ToLower(projectName + "-" + config.Name)
+}
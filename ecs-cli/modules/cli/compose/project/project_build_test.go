@@ -0,0 +1,145 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr/mock"
+	stsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts/mock"
+	dockerclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/fsouza/go-dockerclient"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const (
+	testRegistryID = "123456789012"
+	testRegistry   = testRegistryID + ".dkr.ecr.us-west-2.amazonaws.com"
+)
+
+func setupBuildTestProject(buildAndPush bool, configs []adapter.ContainerConfig) *ecsProject {
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Bool(flags.BuildAndPushFlag, buildAndPush, "")
+
+	ecsContext := &context.ECSContext{
+		CLIContext:    cli.NewContext(nil, flagSet, nil),
+		CommandConfig: &config.CommandConfig{},
+	}
+	ecsContext.ProjectName = "Shop"
+
+	return &ecsProject{
+		ecsContext:       ecsContext,
+		containerConfigs: configs,
+	}
+}
+
+func mockBuildClients(t *testing.T) (*mock_docker.MockClient, *mock_ecr.MockClient, *mock_sts.MockClient, func()) {
+	ctrl := gomock.NewController(t)
+	mockDocker := mock_docker.NewMockClient(ctrl)
+	mockECR := mock_ecr.NewMockClient(ctrl)
+	mockSTS := mock_sts.NewMockClient(ctrl)
+
+	newDockerClient = func() (dockerclient.Client, error) { return mockDocker, nil }
+	newECRClient = func(*config.CommandConfig) ecrclient.Client { return mockECR }
+	newSTSClient = func(*config.CommandConfig) stsclient.Client { return mockSTS }
+
+	return mockDocker, mockECR, mockSTS, func() {
+		ctrl.Finish()
+		newDockerClient = dockerclient.NewClient
+		newECRClient = ecrclient.NewClient
+		newSTSClient = stsclient.NewClient
+	}
+}
+
+func TestBuildAndPushImages(t *testing.T) {
+	mockDocker, mockECR, mockSTS, cleanup := mockBuildClients(t)
+	defer cleanup()
+
+	configs := []adapter.ContainerConfig{
+		{Name: "web", Build: &adapter.BuildConfig{Context: "/src/web", Args: map[string]string{}}},
+		{Name: "worker", Image: "worker:1.0", Build: &adapter.BuildConfig{Context: "/src/worker", Dockerfile: "Dockerfile.worker"}},
+		{Name: "db", Image: "mysql"},
+	}
+	project := setupBuildTestProject(true, configs)
+
+	auth := &ecrclient.Auth{Registry: testRegistry}
+	gomock.InOrder(
+		mockSTS.EXPECT().GetAWSAccountID().Return(testRegistryID, nil),
+		mockECR.EXPECT().GetAuthorizationTokenByID(testRegistryID).Return(auth, nil),
+		mockDocker.EXPECT().BuildImage(testRegistry+"/shop-web:latest", "/src/web", "", map[string]string{}).Return(nil),
+		mockECR.EXPECT().RepositoryExists("shop-web").Return(false),
+		mockECR.EXPECT().CreateRepository("shop-web").Return("shop-web", nil),
+		mockDocker.EXPECT().PushImage(testRegistry+"/shop-web", "latest", testRegistry, docker.AuthConfiguration{}).Return(nil),
+
+		mockSTS.EXPECT().GetAWSAccountID().Return(testRegistryID, nil),
+		mockECR.EXPECT().GetAuthorizationTokenByID(testRegistryID).Return(auth, nil),
+		mockDocker.EXPECT().BuildImage(testRegistry+"/worker:1.0", "/src/worker", "Dockerfile.worker", nil).Return(nil),
+		mockECR.EXPECT().RepositoryExists("worker").Return(true),
+		mockDocker.EXPECT().PushImage(testRegistry+"/worker", "1.0", testRegistry, docker.AuthConfiguration{}).Return(nil),
+	)
+
+	err := project.buildAndPushImages()
+	assert.NoError(t, err, "Unexpected error building and pushing images")
+
+	assert.Equal(t, testRegistry+"/shop-web:latest", project.containerConfigs[0].Image, "Expected web image to be rewritten")
+	assert.Equal(t, testRegistry+"/worker:1.0", project.containerConfigs[1].Image, "Expected worker image to be rewritten")
+	assert.Equal(t, "mysql", project.containerConfigs[2].Image, "Expected db image to be unchanged")
+}
+
+func TestBuildAndPushImages_ErrorNamesService(t *testing.T) {
+	mockDocker, mockECR, mockSTS, cleanup := mockBuildClients(t)
+	defer cleanup()
+
+	configs := []adapter.ContainerConfig{
+		{Name: "web", Build: &adapter.BuildConfig{Context: "/src/web"}},
+	}
+	project := setupBuildTestProject(true, configs)
+
+	gomock.InOrder(
+		mockSTS.EXPECT().GetAWSAccountID().Return(testRegistryID, nil),
+		mockECR.EXPECT().GetAuthorizationTokenByID(testRegistryID).Return(&ecrclient.Auth{Registry: testRegistry}, nil),
+		mockDocker.EXPECT().BuildImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("build failed")),
+	)
+
+	err := project.buildAndPushImages()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "service web")
+	}
+	assert.Empty(t, project.containerConfigs[0].Image, "Expected image to be unchanged")
+}
+
+func TestBuildAndPushImages_WithoutFlag(t *testing.T) {
+	_, _, _, cleanup := mockBuildClients(t)
+	defer cleanup()
+
+	configs := []adapter.ContainerConfig{
+		{Name: "web", Image: "web", Build: &adapter.BuildConfig{Context: "/src/web"}},
+	}
+	project := setupBuildTestProject(false, configs)
+
+	err := project.buildAndPushImages()
+	assert.NoError(t, err, "Unexpected error without --build-and-push")
+	assert.Equal(t, "web", project.containerConfigs[0].Image, "Expected image to be unchanged")
+}
@@ -0,0 +1,85 @@
+package project
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+)
+
+const (
+	dependsOnKey = "depends_on"
+	conditionKey = "condition"
+)
+
+// dependencyConditions holds the condition of each depends_on entry written
+// in the long syntax, keyed by service name and then by dependency name
+type dependencyConditions map[string]map[string]string
+
+// dependencies returns the ContainerDependencies of a service
+func (d dependencyConditions) dependencies(serviceName string, dependsOn []string) []adapter.ContainerDependency {
+	return adapter.ConvertToContainerDependencies(dependsOn, d[serviceName])
+}
+
+// normalizeDependsOn rewrites depends_on entries written in the long syntax
+// (a map of service name to condition) into the short syntax (a list of
+// service names), which is the only form the compose parsers understand. The
+// condition of each rewritten entry is recorded in conditions. Returns true if
+// the config was modified.
+func normalizeDependsOn(composeConfig map[string]interface{}, conditions dependencyConditions) (bool, error) {
+	modified := false
+	for serviceName, serviceConfig := range getServiceConfigs(composeConfig) {
+		dependsOn, ok := serviceConfig[dependsOnKey].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		names := []string{}
+		for name, value := range dependsOn {
+			condition, err := getDependencyCondition(serviceName, name, value)
+			if err != nil {
+				return false, err
+			}
+			if conditions[serviceName] == nil {
+				conditions[serviceName] = make(map[string]string)
+			}
+			conditions[serviceName][name] = condition
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		shortSyntax := []interface{}{}
+		for _, name := range names {
+			shortSyntax = append(shortSyntax, name)
+		}
+		serviceConfig[dependsOnKey] = shortSyntax
+		modified = true
+	}
+
+	return modified, nil
+}
+
+func getDependencyCondition(serviceName, dependencyName string, value interface{}) (string, error) {
+	if value == nil {
+		return adapter.DependencyConditionStarted, nil
+	}
+	options, ok := value.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("%s: depends_on.%s must be a mapping", serviceName, dependencyName)
+	}
+	condition, ok := options[conditionKey]
+	if !ok {
+		return adapter.DependencyConditionStarted, nil
+	}
+	conditionString, ok := condition.(string)
+	if !ok || !adapter.IsValidDependencyCondition(conditionString) {
+		return "", fmt.Errorf("%s: depends_on.%s.condition must be one of %s, %s, %s or %s, found '%v'",
+			serviceName, dependencyName,
+			adapter.DependencyConditionStarted,
+			adapter.DependencyConditionHealthy,
+			adapter.DependencyConditionCompletedSuccessfully,
+			adapter.DependencyConditionCompleted,
+			condition)
+	}
+	return conditionString, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	composecontainer "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/container"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/image"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// pinImageDigests replaces the image of every container of the task
+// definition by the digest its tag points to when --pin-image-digests is set.
+// The original image is kept in a docker label so that ps can show it. Since
+// the image is part of the task definition, a new digest registers a new
+// revision instead of reusing the cached one.
+func (p *ecsProject) pinImageDigests(taskDefinition *ecs.TaskDefinition) error {
+	if !p.ecsContext.CLIContext.Bool(flags.PinImageDigestsFlag) {
+		return nil
+	}
+
+	dockerClient, err := newDockerClient()
+	if err != nil {
+		return err
+	}
+	ecrClient := newECRClient(p.ecsContext.CommandConfig)
+	if p.pinnedImages == nil {
+		p.pinnedImages = make(map[string]string)
+	}
+
+	for _, containerDef := range taskDefinition.ContainerDefinitions {
+		original := aws.StringValue(containerDef.Image)
+		pinned, ok := p.pinnedImages[original]
+		if !ok {
+			pinned, err = image.ResolveImageDigest(original, dockerClient, ecrClient)
+			if err != nil {
+				return errors.Wrapf(err, "unable to pin the image of container %s to a digest", aws.StringValue(containerDef.Name))
+			}
+			p.pinnedImages[original] = pinned
+		}
+		if pinned == original {
+			continue
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"container": aws.StringValue(containerDef.Name),
+			"image":     pinned,
+		}).Infof("Pinning image %s", original)
+		containerDef.Image = aws.String(pinned)
+		if containerDef.DockerLabels == nil {
+			containerDef.DockerLabels = make(map[string]*string)
+		}
+		containerDef.DockerLabels[composecontainer.OriginalImageLabel] = aws.String(original)
+	}
+	return nil
+}
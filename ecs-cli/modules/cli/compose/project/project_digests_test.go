@@ -0,0 +1,125 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	composecontainer "github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/container"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+const testDigest = "sha256:0123456789abcdef"
+
+func setupDigestTestProject(pinImageDigests bool) *ecsProject {
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Bool(flags.PinImageDigestsFlag, pinImageDigests, "")
+
+	return &ecsProject{
+		ecsContext: &context.ECSContext{
+			CLIContext:    cli.NewContext(nil, flagSet, nil),
+			CommandConfig: &config.CommandConfig{},
+		},
+	}
+}
+
+func TestPinImageDigests(t *testing.T) {
+	mockDocker, mockECR, _, cleanup := mockBuildClients(t)
+	defer cleanup()
+
+	ecrImage := testRegistry + "/web"
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:         aws.String("web"),
+				Image:        aws.String(ecrImage + ":v2"),
+				DockerLabels: map[string]*string{"team": aws.String("shop")},
+			},
+			{
+				Name:  aws.String("proxy"),
+				Image: aws.String("nginx"),
+			},
+			{
+				Name:  aws.String("sidecar"),
+				Image: aws.String("nginx"),
+			},
+			{
+				Name:  aws.String("pinned"),
+				Image: aws.String("redis@" + testDigest),
+			},
+		},
+	}
+
+	mockECR.EXPECT().GetImageDigest(testRegistryID, "web", "v2").Return(testDigest, nil)
+	mockDocker.EXPECT().ImageDigest("nginx", "latest", gomock.Any()).Return(testDigest, nil).Times(1)
+
+	project := setupDigestTestProject(true)
+	err := project.pinImageDigests(taskDefinition)
+	assert.NoError(t, err, "Unexpected error pinning image digests")
+
+	web := taskDefinition.ContainerDefinitions[0]
+	assert.Equal(t, ecrImage+"@"+testDigest, aws.StringValue(web.Image))
+	assert.Equal(t, ecrImage+":v2", aws.StringValue(web.DockerLabels[composecontainer.OriginalImageLabel]))
+	assert.Equal(t, "shop", aws.StringValue(web.DockerLabels["team"]), "Expected existing labels to be kept")
+
+	for _, containerDef := range taskDefinition.ContainerDefinitions[1:3] {
+		assert.Equal(t, "nginx@"+testDigest, aws.StringValue(containerDef.Image))
+		assert.Equal(t, "nginx", aws.StringValue(containerDef.DockerLabels[composecontainer.OriginalImageLabel]))
+	}
+
+	pinned := taskDefinition.ContainerDefinitions[3]
+	assert.Equal(t, "redis@"+testDigest, aws.StringValue(pinned.Image))
+	assert.Empty(t, pinned.DockerLabels, "Expected no label on an image already referenced by digest")
+}
+
+func TestPinImageDigests_WithoutFlag(t *testing.T) {
+	_, _, _, cleanup := mockBuildClients(t)
+	defer cleanup()
+
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{Name: aws.String("proxy"), Image: aws.String("nginx")},
+		},
+	}
+
+	project := setupDigestTestProject(false)
+	err := project.pinImageDigests(taskDefinition)
+	assert.NoError(t, err, "Unexpected error pinning image digests")
+	assert.Equal(t, "nginx", aws.StringValue(taskDefinition.ContainerDefinitions[0].Image))
+}
+
+func TestPinImageDigests_ErrorResolvingDigest(t *testing.T) {
+	mockDocker, _, _, cleanup := mockBuildClients(t)
+	defer cleanup()
+
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{Name: aws.String("proxy"), Image: aws.String("nginx:1.15")},
+		},
+	}
+	mockDocker.EXPECT().ImageDigest("nginx", "1.15", gomock.Any()).Return("", errors.New("something failed"))
+
+	project := setupDigestTestProject(true)
+	err := project.pinImageDigests(taskDefinition)
+	assert.Error(t, err, "Expected error pinning image digests")
+}
@@ -0,0 +1,53 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"fmt"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/sirupsen/logrus"
+)
+
+// injectDefaultAWSLogs sets the awslogs log configuration of default_awslogs
+// in the ecs-params on every container of the task definition that has no
+// logging configuration, using the cluster and region of the command
+func injectDefaultAWSLogs(ecsContext *context.ECSContext, taskDefinition *ecs.TaskDefinition) error {
+	ecsParams := ecsContext.ECSParams
+	if ecsParams == nil || ecsParams.TaskDefinition.DefaultAWSLogs == nil {
+		return nil
+	}
+	defaults := ecsParams.TaskDefinition.DefaultAWSLogs
+
+	region := ecsContext.CommandConfig.Region
+	if region == "" {
+		return fmt.Errorf("default_awslogs requires a region for awslogs-region. Set a region using ecs-cli configure command with the --%s flag or %s environment variable or --%s flag", flags.RegionFlag, flags.AwsRegionEnvVar, flags.ProfileFlag)
+	}
+
+	for _, containerDef := range taskDefinition.ContainerDefinitions {
+		if containerDef.LogConfiguration != nil {
+			continue
+		}
+		containerName := aws.StringValue(containerDef.Name)
+		containerDef.LogConfiguration = defaults.LogConfiguration(ecsContext.CommandConfig.Cluster, ecsContext.ProjectName, containerName, region)
+		logrus.WithFields(logrus.Fields{
+			"container": containerName,
+			"logGroup":  aws.StringValue(containerDef.LogConfiguration.Options["awslogs-group"]),
+		}).Debug("Using default awslogs configuration")
+	}
+	return nil
+}
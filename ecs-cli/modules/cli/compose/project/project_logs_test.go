@@ -0,0 +1,87 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInjectDefaultAWSLogs(t *testing.T) {
+	ecsContext := &context.ECSContext{
+		CommandConfig: &config.CommandConfig{Cluster: "prod", Region: "eu-west-1"},
+		ECSParams: &utils.ECSParams{
+			TaskDefinition: utils.EcsTaskDef{
+				DefaultAWSLogs: &utils.DefaultAWSLogs{LogGroup: "/ecs/{cluster}/{project}/{container}"},
+			},
+		},
+	}
+	ecsContext.ProjectName = "shop"
+
+	customLogConfig := &ecs.LogConfiguration{LogDriver: aws.String(ecs.LogDriverSyslog)}
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{Name: aws.String("web")},
+			{Name: aws.String("syslog"), LogConfiguration: customLogConfig},
+		},
+	}
+
+	err := injectDefaultAWSLogs(ecsContext, taskDefinition)
+	assert.NoError(t, err, "Unexpected error injecting default awslogs")
+
+	web := taskDefinition.ContainerDefinitions[0].LogConfiguration
+	if assert.NotNil(t, web, "Expected a log configuration for web") {
+		assert.Equal(t, ecs.LogDriverAwslogs, aws.StringValue(web.LogDriver))
+		assert.Equal(t, "/ecs/prod/shop/web", aws.StringValue(web.Options["awslogs-group"]))
+		assert.Equal(t, "eu-west-1", aws.StringValue(web.Options["awslogs-region"]))
+		assert.Equal(t, "ecs", aws.StringValue(web.Options["awslogs-stream-prefix"]))
+	}
+	assert.Equal(t, customLogConfig, taskDefinition.ContainerDefinitions[1].LogConfiguration, "Expected the compose log configuration to be kept")
+}
+
+func TestInjectDefaultAWSLogs_NotConfigured(t *testing.T) {
+	ecsContext := &context.ECSContext{
+		CommandConfig: &config.CommandConfig{},
+		ECSParams:     &utils.ECSParams{},
+	}
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{{Name: aws.String("web")}},
+	}
+
+	err := injectDefaultAWSLogs(ecsContext, taskDefinition)
+	assert.NoError(t, err, "Unexpected error injecting default awslogs")
+	assert.Nil(t, taskDefinition.ContainerDefinitions[0].LogConfiguration)
+}
+
+func TestInjectDefaultAWSLogs_NoRegion(t *testing.T) {
+	ecsContext := &context.ECSContext{
+		CommandConfig: &config.CommandConfig{Cluster: "prod"},
+		ECSParams: &utils.ECSParams{
+			TaskDefinition: utils.EcsTaskDef{DefaultAWSLogs: &utils.DefaultAWSLogs{}},
+		},
+	}
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{{Name: aws.String("web")}},
+	}
+
+	err := injectDefaultAWSLogs(ecsContext, taskDefinition)
+	assert.Error(t, err, "Expected an error without a region instead of an empty awslogs-region")
+	assert.Nil(t, taskDefinition.ContainerDefinitions[0].LogConfiguration)
+}
@@ -0,0 +1,105 @@
+package project
+
+import (
+	"io/ioutil"
+
+	"github.com/docker/cli/cli/compose/loader"
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const servicesKey = "services"
+
+// rawServiceOptions holds the service options that are read from the compose
+// files before they are parsed, because the compose parsers either do not
+// accept them or do not expose them
+type rawServiceOptions struct {
+	dependencyConditions dependencyConditions
+	sysctls              serviceSysctls
+}
+
+func newRawServiceOptions() *rawServiceOptions {
+	return &rawServiceOptions{
+		dependencyConditions: make(dependencyConditions),
+		sysctls:              make(serviceSysctls),
+	}
+}
+
+// getServiceConfigs returns the services section of a parsed compose file, keyed by service name
+func getServiceConfigs(composeConfig map[string]interface{}) map[string]map[string]interface{} {
+	serviceConfigs := make(map[string]map[string]interface{})
+	services, ok := composeConfig[servicesKey].(map[string]interface{})
+	if !ok {
+		return serviceConfigs
+	}
+	for name, service := range services {
+		if serviceConfig, ok := service.(map[string]interface{}); ok {
+			serviceConfigs[name] = serviceConfig
+		}
+	}
+	return serviceConfigs
+}
+
+// normalizeComposeConfig records the raw service options of a parsed compose
+// file, and rewrites the file so that the compose parsers accept it. Returns
+// true if the config was modified.
+func normalizeComposeConfig(composeConfig map[string]interface{}, options *rawServiceOptions) (bool, error) {
+	dependsOnModified, err := normalizeDependsOn(composeConfig, options.dependencyConditions)
+	if err != nil {
+		return false, err
+	}
+	sysctlsModified, err := extractSysctls(composeConfig, options.sysctls)
+	if err != nil {
+		return false, err
+	}
+	return dependsOnModified || sysctlsModified, nil
+}
+
+// normalizeV1V2ComposeFiles reads the compose files of the project and, if any
+// of them need to be rewritten, sets the normalized file content on the
+// libcompose context so that libcompose parses it instead of the files.
+func (p *ecsProject) normalizeV1V2ComposeFiles() (*rawServiceOptions, error) {
+	options := newRawServiceOptions()
+	context := &p.ecsContext.Context
+
+	composeBytes := context.ComposeBytes
+	if composeBytes == nil {
+		// Reading from STDIN is left to libcompose
+		if len(context.ComposeFiles) == 1 && context.ComposeFiles[0] == "-" {
+			return options, nil
+		}
+		for _, composeFile := range context.ComposeFiles {
+			bytes, err := ioutil.ReadFile(composeFile)
+			if err != nil {
+				return nil, err
+			}
+			composeBytes = append(composeBytes, bytes)
+		}
+	}
+
+	modified := false
+	normalizedBytes := [][]byte{}
+	for _, bytes := range composeBytes {
+		composeConfig, err := loader.ParseYAML(bytes)
+		if err != nil {
+			// Leave reporting of malformed files to libcompose
+			return options, nil
+		}
+		fileModified, err := normalizeComposeConfig(composeConfig, options)
+		if err != nil {
+			return nil, err
+		}
+		if fileModified {
+			modified = true
+			if bytes, err = yaml.Marshal(composeConfig); err != nil {
+				return nil, errors.Wrap(err, "unable to normalize compose file")
+			}
+		}
+		normalizedBytes = append(normalizedBytes, bytes)
+	}
+
+	if modified {
+		context.ComposeBytes = normalizedBytes
+	}
+	return options, nil
+}
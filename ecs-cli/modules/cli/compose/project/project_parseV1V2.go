@@ -14,6 +14,11 @@ import (
 func (p *ecsProject) parseV1V2() (*[]adapter.ContainerConfig, error) {
 	logrus.Debug("Parsing v1/2 project...")
 
+	rawOptions, err := p.normalizeV1V2ComposeFiles()
+	if err != nil {
+		return nil, err
+	}
+
 	libcomposeProject := project.NewProject(&p.ecsContext.Context, nil, nil)
 	// libcompose.Project#Parse populates project information based on its
 	// context. It sets up the name, the composefile and the composebytes
@@ -46,6 +51,8 @@ func (p *ecsProject) parseV1V2() (*[]adapter.ContainerConfig, error) {
 		if err != nil {
 			return nil, err
 		}
+		containerConfig.DependsOn = rawOptions.dependencyConditions.dependencies(serviceName, serviceConfig.DependsOn)
+		containerConfig.Sysctls = rawOptions.sysctls[serviceName]
 		containerConfigs = append(containerConfigs, *containerConfig)
 	}
 
@@ -92,6 +99,11 @@ func convertV1V2ToContainerConfig(context *project.Context, serviceName string,
 
 	shmSize := adapter.ConvertToMemoryInMB(int64(service.ShmSize))
 
+	stopTimeout, err := adapter.ConvertToStopTimeout(service.StopGracePeriod)
+	if err != nil {
+		return nil, err
+	}
+
 	tmpfs, err := adapter.ConvertToTmpfs(service.Tmpfs)
 	if err != nil {
 		return nil, err
@@ -109,6 +121,7 @@ func convertV1V2ToContainerConfig(context *project.Context, serviceName string,
 
 	outputConfig := &adapter.ContainerConfig{
 		Name:                  serviceName,
+		Build:                 adapter.ConvertToBuildConfig(service.Build.Context, service.Build.Dockerfile, service.Build.Args),
 		CapAdd:                service.CapAdd,
 		CapDrop:               service.CapDrop,
 		Command:               service.Command,
@@ -133,6 +146,7 @@ func convertV1V2ToContainerConfig(context *project.Context, serviceName string,
 		Privileged:            service.Privileged,
 		ReadOnly:              service.ReadOnly,
 		ShmSize:               shmSize,
+		StopTimeout:           stopTimeout,
 		Tmpfs:                 tmpfs,
 		Ulimits:               ulimits,
 		VolumesFrom:           volumesFrom,
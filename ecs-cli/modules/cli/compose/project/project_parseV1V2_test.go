@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
@@ -543,6 +544,142 @@ services:
 	}
 }
 
+func TestParseV1V2_WithDependsOn(t *testing.T) {
+	// Setup docker-compose file
+	composeFileString := `version: '2'
+services:
+  web:
+    image: webapp
+    depends_on:
+      migrations:
+        condition: service_completed_successfully
+      db:
+        condition: service_healthy
+  migrations:
+    image: migrations
+    depends_on:
+      - db
+  db:
+    image: postgres`
+
+	tmpfile, err := ioutil.TempFile("", "test")
+	assert.NoError(t, err, "Unexpected error in creating test file")
+
+	defer os.Remove(tmpfile.Name())
+
+	_, err = tmpfile.Write([]byte(composeFileString))
+	assert.NoError(t, err, "Unexpected error in writing to test file")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Unexpected error closing file")
+
+	// Set up project
+	project := setupTestProject(t)
+	project.ecsContext.ComposeFiles = append(project.ecsContext.ComposeFiles, tmpfile.Name())
+
+	actualConfigs, err := project.parseV1V2()
+	assert.NoError(t, err, "Unexpected error parsing file")
+	assert.Equal(t, 3, len(*actualConfigs))
+
+	web, err := getContainerConfigByName("web", actualConfigs)
+	if assert.NoError(t, err) {
+		assert.ElementsMatch(t, []adapter.ContainerDependency{
+			{Name: "db", Condition: adapter.DependencyConditionHealthy},
+			{Name: "migrations", Condition: adapter.DependencyConditionCompletedSuccessfully},
+		}, web.DependsOn, "Expected DependsOn to match")
+		assert.Equal(t, "webapp", web.Image, "Expected Image to match")
+	}
+
+	migrations, err := getContainerConfigByName("migrations", actualConfigs)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []adapter.ContainerDependency{
+			{Name: "db", Condition: adapter.DependencyConditionStarted},
+		}, migrations.DependsOn, "Expected DependsOn to match")
+	}
+
+	db, err := getContainerConfigByName("db", actualConfigs)
+	if assert.NoError(t, err) {
+		assert.Empty(t, db.DependsOn, "Expected DependsOn to be empty")
+	}
+}
+
+func TestParseV1V2_WithDependsOnInvalidCondition(t *testing.T) {
+	// Setup docker-compose file
+	composeFileString := `version: '2'
+services:
+  web:
+    image: webapp
+    depends_on:
+      db:
+        condition: service_ready
+  db:
+    image: postgres`
+
+	tmpfile, err := ioutil.TempFile("", "test")
+	assert.NoError(t, err, "Unexpected error in creating test file")
+
+	defer os.Remove(tmpfile.Name())
+
+	_, err = tmpfile.Write([]byte(composeFileString))
+	assert.NoError(t, err, "Unexpected error in writing to test file")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Unexpected error closing file")
+
+	// Set up project
+	project := setupTestProject(t)
+	project.ecsContext.ComposeFiles = append(project.ecsContext.ComposeFiles, tmpfile.Name())
+
+	_, err = project.parseV1V2()
+	assert.Error(t, err, "Expected error parsing unsupported depends_on condition")
+}
+
+func TestParseV1V2_WithStopGracePeriodAndSysctls(t *testing.T) {
+	// Setup docker-compose file
+	composeFileString := `version: '2'
+services:
+  web:
+    image: webapp
+    stop_grace_period: 45s
+    sysctls:
+      - net.core.somaxconn=${SOMAXCONN}
+  worker:
+    image: worker`
+
+	os.Setenv("SOMAXCONN", "2048")
+	defer os.Unsetenv("SOMAXCONN")
+
+	tmpfile, err := ioutil.TempFile("", "test")
+	assert.NoError(t, err, "Unexpected error in creating test file")
+
+	defer os.Remove(tmpfile.Name())
+
+	_, err = tmpfile.Write([]byte(composeFileString))
+	assert.NoError(t, err, "Unexpected error in writing to test file")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Unexpected error closing file")
+
+	// Set up project
+	project := setupTestProject(t)
+	project.ecsContext.ComposeFiles = append(project.ecsContext.ComposeFiles, tmpfile.Name())
+
+	actualConfigs, err := project.parseV1V2()
+	assert.NoError(t, err, "Unexpected error parsing file")
+
+	web, err := getContainerConfigByName("web", actualConfigs)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(45), aws.Int64Value(web.StopTimeout), "Expected StopTimeout to match")
+		assert.Equal(t, map[string]string{"net.core.somaxconn": "2048"}, web.Sysctls, "Expected Sysctls to match")
+	}
+
+	worker, err := getContainerConfigByName("worker", actualConfigs)
+	if assert.NoError(t, err) {
+		assert.Nil(t, worker.StopTimeout, "Expected StopTimeout to be nil")
+		assert.Nil(t, worker.Sysctls, "Expected Sysctls to be nil")
+	}
+}
+
 func getContainerConfigByName(name string, configs *[]adapter.ContainerConfig) (*adapter.ContainerConfig, error) {
 	for _, config := range *configs {
 		if config.Name == name {
@@ -551,3 +688,46 @@ func getContainerConfigByName(name string, configs *[]adapter.ContainerConfig) (
 	}
 	return nil, fmt.Errorf("Container with name %v could not be found", name)
 }
+
+func TestParseV1V2_Version2_WithBuild(t *testing.T) {
+	composeFileString := `version: '2'
+services:
+  web:
+    build:
+      context: ./web
+      dockerfile: Dockerfile.prod
+      args:
+        VERSION: "1.2"
+  db:
+    image: mysql`
+
+	tmpfile, err := ioutil.TempFile("", "test")
+	assert.NoError(t, err, "Unexpected error in creating test file")
+
+	defer os.Remove(tmpfile.Name())
+
+	_, err = tmpfile.Write([]byte(composeFileString))
+	assert.NoError(t, err, "Unexpected error in writing to test file")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Unexpected error closing file")
+
+	// Set up project
+	project := setupTestProject(t)
+	project.ecsContext.ComposeFiles = append(project.ecsContext.ComposeFiles, tmpfile.Name())
+
+	actualConfigs, err := project.parseV1V2()
+	assert.NoError(t, err, "Unexpected error parsing file")
+
+	web, err := getContainerConfigByName("web", actualConfigs)
+	assert.NoError(t, err, "Unexpected error retrieving container config")
+	if assert.NotNil(t, web.Build, "Expected web to have a build section") {
+		assert.Equal(t, filepath.Join(filepath.Dir(tmpfile.Name()), "web"), web.Build.Context, "Expected build context relative to the compose file")
+		assert.Equal(t, "Dockerfile.prod", web.Build.Dockerfile)
+		assert.Equal(t, map[string]string{"VERSION": "1.2"}, web.Build.Args)
+	}
+
+	db, err := getContainerConfigByName("db", actualConfigs)
+	assert.NoError(t, err, "Unexpected error retrieving container config")
+	assert.Nil(t, db.Build, "Expected db to have no build section")
+}
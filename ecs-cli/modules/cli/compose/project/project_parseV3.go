@@ -1,10 +1,13 @@
 package project
 
 import (
+	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
@@ -19,10 +22,17 @@ import (
 	"github.com/docker/libcompose/yaml"
 )
 
+const (
+	miB = 1024 * 1024
+
+	// cpuUnitsPerCPU is the number of ECS CPU units that make up one vCPU
+	cpuUnitsPerCPU = 1024
+)
+
 func (p *ecsProject) parseV3() (*[]adapter.ContainerConfig, error) {
 	log.Debug("Parsing v3 project...")
 
-	v3Config, err := getV3Config(p.ecsContext.ComposeFiles)
+	v3Config, rawOptions, err := getV3Config(p.ecsContext.ComposeFiles)
 	if err != nil {
 		return nil, err
 	}
@@ -33,6 +43,11 @@ func (p *ecsProject) parseV3() (*[]adapter.ContainerConfig, error) {
 	}
 	p.volumes = servVols
 
+	wrkDir, err := getWorkingDir(p.ecsContext.ComposeFiles[0])
+	if err != nil {
+		return nil, err
+	}
+
 	// convert ServiceConfigs to ContainerConfigs
 	conConfigs := []adapter.ContainerConfig{}
 	for _, service := range v3Config.Services {
@@ -40,6 +55,12 @@ func (p *ecsProject) parseV3() (*[]adapter.ContainerConfig, error) {
 		if err != nil {
 			return nil, err
 		}
+		// build contexts are relative to the directory of the first compose file
+		if cCon.Build != nil && !filepath.IsAbs(cCon.Build.Context) {
+			cCon.Build.Context = filepath.Join(wrkDir, cCon.Build.Context)
+		}
+		cCon.DependsOn = rawOptions.dependencyConditions.dependencies(service.Name, service.DependsOn)
+		cCon.Sysctls = rawOptions.sysctls[service.Name]
 		conConfigs = append(conConfigs, *cCon)
 	}
 
@@ -47,17 +68,21 @@ func (p *ecsProject) parseV3() (*[]adapter.ContainerConfig, error) {
 }
 
 // parses compose files into a docker/cli Config, which contains v3 ServiceConfigs
-func getV3Config(composeFiles []string) (*types.Config, error) {
+func getV3Config(composeFiles []string) (*types.Config, *rawServiceOptions, error) {
 	configFiles := []types.ConfigFile{}
+	rawOptions := newRawServiceOptions()
 	for _, file := range composeFiles {
 
 		loadedFile, err := ioutil.ReadFile(file)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		parsedFile, err := loader.ParseYAML(loadedFile)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		if _, err = normalizeComposeConfig(parsedFile, rawOptions); err != nil {
+			return nil, nil, err
 		}
 		configFile := types.ConfigFile{
 			Filename: file,
@@ -68,7 +93,7 @@ func getV3Config(composeFiles []string) (*types.Config, error) {
 
 	wrkDir, err := getWorkingDir(composeFiles[0])
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	localEnv := getEnvironment()
@@ -82,10 +107,10 @@ func getV3Config(composeFiles []string) (*types.Config, error) {
 	// load config from config details
 	config, err := loader.Load(configDetails)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return config, nil
+	return config, rawOptions, nil
 }
 
 func convertToContainerConfig(serviceConfig types.ServiceConfig, serviceVols *adapter.Volumes) (*adapter.ContainerConfig, error) {
@@ -93,6 +118,7 @@ func convertToContainerConfig(serviceConfig types.ServiceConfig, serviceVols *ad
 	logWarningForDeployFields(serviceConfig.Deploy, serviceConfig.Name)
 
 	c := &adapter.ContainerConfig{
+		Build:                 adapter.ConvertToBuildConfig(serviceConfig.Build.Context, serviceConfig.Build.Dockerfile, serviceConfig.Build.Args),
 		CapAdd:                serviceConfig.CapAdd,
 		CapDrop:               serviceConfig.CapDrop,
 		Command:               serviceConfig.Command,
@@ -115,6 +141,14 @@ func convertToContainerConfig(serviceConfig types.ServiceConfig, serviceVols *ad
 	}
 	c.Devices = devices
 
+	if err = convertDeployResources(serviceConfig.Deploy.Resources, serviceConfig.Name, c); err != nil {
+		return nil, err
+	}
+
+	if serviceConfig.StopGracePeriod != nil {
+		c.StopTimeout = adapter.ConvertToTimeInSeconds(serviceConfig.StopGracePeriod)
+	}
+
 	if serviceConfig.HealthCheck != nil && !serviceConfig.HealthCheck.Disable {
 		c.HealthCheck = adapter.ConvertToHealthCheck(serviceConfig.HealthCheck)
 	}
@@ -249,17 +283,91 @@ func convertToECSUlimits(ulimits map[string]*types.UlimitsConfig) []*ecs.Ulimit
 	return ecsUlimits
 }
 
-func logWarningForDeployFields(d types.DeployConfig, serviceName string) {
-	if d.Resources.Limits != nil || d.Resources.Reservations != nil {
+// convertDeployResources sets the container level CPU and memory values from
+// deploy.resources. Only reservations.cpus, reservations.memory and
+// limits.memory have an ECS equivalent; values from the ecs-params file take
+// precedence and are applied later in reconcileContainerDef.
+func convertDeployResources(resources types.Resources, serviceName string, c *adapter.ContainerConfig) error {
+	if limits := resources.Limits; limits != nil {
+		if limits.NanoCPUs != "" {
+			logWarningForResourceField("deploy.resources.limits.cpus", serviceName, "ECS does not support a hard CPU limit on a container; use deploy.resources.reservations.cpus instead.")
+		}
+		if len(limits.GenericResources) > 0 {
+			logWarningForResourceField("deploy.resources.limits.generic_resources", serviceName, "Generic resources cannot be expressed in an ECS container definition.")
+		}
+		c.Memory = convertDeployMemory(int64(limits.MemoryBytes), "deploy.resources.limits.memory", serviceName)
+	}
+
+	if reservations := resources.Reservations; reservations != nil {
+		if len(reservations.GenericResources) > 0 {
+			logWarningForResourceField("deploy.resources.reservations.generic_resources", serviceName, "Generic resources cannot be expressed in an ECS container definition.")
+		}
+		c.MemoryReservation = convertDeployMemory(int64(reservations.MemoryBytes), "deploy.resources.reservations.memory", serviceName)
+
+		cpu, err := convertDeployCPUs(reservations.NanoCPUs, serviceName)
+		if err != nil {
+			return err
+		}
+		c.CPU = cpu
+	}
+
+	return nil
+}
+
+// convertDeployMemory converts a memory value in bytes to the MiB expected by ECS
+func convertDeployMemory(bytes int64, option, serviceName string) int64 {
+	memory := adapter.ConvertToMemoryInMB(bytes)
+	if bytes > 0 && memory == 0 {
+		logWarningForResourceField(option, serviceName, "Value is less than 1 MiB, which is the smallest amount of memory that can be specified in ECS. Ignoring...")
+	} else if bytes%miB != 0 {
 		log.WithFields(log.Fields{
-			"option name":  "deploy",
+			"option name":  option,
+			"service name": serviceName,
+		}).Infof("ECS memory values are specified in MiB; rounding down to %d MiB", memory)
+	}
+	return memory
+}
+
+// convertDeployCPUs converts a decimal number of CPUs (e.g. "0.5") into ECS CPU
+// units, where 1024 units are equivalent to one vCPU
+func convertDeployCPUs(nanoCPUs, serviceName string) (int64, error) {
+	if nanoCPUs == "" {
+		return 0, nil
+	}
+	cpus, err := strconv.ParseFloat(nanoCPUs, 64)
+	if err != nil || cpus < 0 {
+		return 0, fmt.Errorf("%s: deploy.resources.reservations.cpus must be a positive decimal number of CPUs, found '%s'", serviceName, nanoCPUs)
+	}
+
+	units := cpus * cpuUnitsPerCPU
+	rounded := int64(math.Round(units))
+	if units != float64(rounded) {
+		log.WithFields(log.Fields{
+			"option name":  "deploy.resources.reservations.cpus",
 			"service name": serviceName,
-		}).Warn("Skipping unsupported YAML option for service... service-level resources should be configured in the ecs-param.yml file.")
-	} else if !reflect.DeepEqual(d, types.DeployConfig{}) {
+		}).Infof("ECS CPU values are specified in whole CPU units (1024 units per vCPU); rounding %v units to %d", units, rounded)
+	}
+	if cpus > 0 && rounded == 0 {
+		logWarningForResourceField("deploy.resources.reservations.cpus", serviceName, "Value is less than 1 CPU unit, which is the smallest CPU reservation that can be specified in ECS. Ignoring...")
+	}
+
+	return rounded, nil
+}
+
+func logWarningForResourceField(option, serviceName, message string) {
+	log.WithFields(log.Fields{
+		"option name":  option,
+		"service name": serviceName,
+	}).Warn(message)
+}
+
+func logWarningForDeployFields(d types.DeployConfig, serviceName string) {
+	d.Resources = types.Resources{}
+	if !reflect.DeepEqual(d, types.DeployConfig{}) {
 		log.WithFields(log.Fields{
 			"option name":  "deploy",
 			"service name": serviceName,
-		}).Warn("Skipping unsupported YAML option for service...")
+		}).Warn("Skipping unsupported YAML option for service... only deploy.resources is supported.")
 	}
 }
 
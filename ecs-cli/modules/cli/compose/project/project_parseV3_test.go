@@ -3,6 +3,7 @@ package project
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -725,11 +726,269 @@ services:
 	verifyContainerConfig(t, wordpressCon, *wp)
 }
 
+func TestParseV3WithDependsOn(t *testing.T) {
+	// set up file
+	composeFileString := `version: '3'
+services:
+  web:
+    image: webapp
+    depends_on:
+      migrations:
+        condition: service_completed_successfully
+      db:
+  migrations:
+    image: migrations
+    depends_on:
+      - db
+  db:
+    image: postgres`
+
+	tmpfile, err := ioutil.TempFile("", "test")
+	assert.NoError(t, err, "Unexpected error in creating test file")
+
+	defer os.Remove(tmpfile.Name())
+
+	_, err = tmpfile.Write([]byte(composeFileString))
+	assert.NoError(t, err, "Unexpected error writing file")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Unexpected error closing file")
+
+	// add files to projects
+	project := setupTestProject(t)
+	project.ecsContext.ComposeFiles = append(project.ecsContext.ComposeFiles, tmpfile.Name())
+
+	actualConfigs, err := project.parseV3()
+	assert.NoError(t, err, "Unexpected error parsing file")
+	assert.Equal(t, 3, len(*actualConfigs))
+
+	web, err := getContainerConfigByName("web", actualConfigs)
+	assert.NoError(t, err, "Unexpected error retrieving web config")
+	assert.Equal(t, []adapter.ContainerDependency{
+		{Name: "db", Condition: adapter.DependencyConditionStarted},
+		{Name: "migrations", Condition: adapter.DependencyConditionCompletedSuccessfully},
+	}, web.DependsOn, "Expected DependsOn to match")
+
+	migrations, err := getContainerConfigByName("migrations", actualConfigs)
+	assert.NoError(t, err, "Unexpected error retrieving migrations config")
+	assert.Equal(t, []adapter.ContainerDependency{
+		{Name: "db", Condition: adapter.DependencyConditionStarted},
+	}, migrations.DependsOn, "Expected DependsOn to match")
+}
+
+func TestNormalizeDependsOn_ErrorWithInvalidLongSyntax(t *testing.T) {
+	testCases := []map[string]interface{}{
+		{"db": "service_healthy"},
+		{"db": map[string]interface{}{"condition": "service_ready"}},
+		{"db": map[string]interface{}{"condition": 1}},
+	}
+
+	for _, dependsOn := range testCases {
+		composeConfig := map[string]interface{}{
+			"services": map[string]interface{}{
+				"web": map[string]interface{}{
+					"depends_on": dependsOn,
+				},
+			},
+		}
+		_, err := normalizeDependsOn(composeConfig, make(dependencyConditions))
+		assert.Error(t, err, "Expected error for depends_on %v", dependsOn)
+	}
+}
+
+func TestParseV3WithStopGracePeriodAndSysctls(t *testing.T) {
+	// set up expected ContainerConfig values
+	webCon := adapter.ContainerConfig{}
+	webCon.Name = "web"
+	webCon.Image = "nginx"
+	webCon.StopTimeout = aws.Int64(90)
+	webCon.Sysctls = map[string]string{
+		"net.core.somaxconn":          "1024",
+		"net.ipv4.tcp_keepalive_time": "200",
+	}
+
+	workerCon := adapter.ContainerConfig{}
+	workerCon.Name = "worker"
+	workerCon.Image = "worker"
+	workerCon.Sysctls = map[string]string{
+		"net.ipv4.ip_forward": "1",
+	}
+
+	// set up file
+	composeFileString := `version: '3'
+services:
+  web:
+    image: nginx
+    stop_grace_period: 1m30s
+    sysctls:
+      net.core.somaxconn: 1024
+      net.ipv4.tcp_keepalive_time: 200
+  worker:
+    image: worker
+    sysctls:
+      - net.ipv4.ip_forward=1`
+
+	tmpfile, err := ioutil.TempFile("", "test")
+	assert.NoError(t, err, "Unexpected error in creating test file")
+
+	defer os.Remove(tmpfile.Name())
+
+	_, err = tmpfile.Write([]byte(composeFileString))
+	assert.NoError(t, err, "Unexpected error writing file")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Unexpected error closing file")
+
+	// add files to projects
+	project := setupTestProject(t)
+	project.ecsContext.ComposeFiles = append(project.ecsContext.ComposeFiles, tmpfile.Name())
+
+	actualConfigs, err := project.parseV3()
+	assert.NoError(t, err, "Unexpected error parsing file")
+	assert.Equal(t, 2, len(*actualConfigs))
+
+	web, err := getContainerConfigByName(webCon.Name, actualConfigs)
+	assert.NoError(t, err, "Unexpected error retrieving web config")
+	verifyContainerConfig(t, webCon, *web)
+
+	worker, err := getContainerConfigByName(workerCon.Name, actualConfigs)
+	assert.NoError(t, err, "Unexpected error retrieving worker config")
+	verifyContainerConfig(t, workerCon, *worker)
+}
+
+func TestParseSysctls_WithEnvFile(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "test")
+	assert.NoError(t, err, "Unexpected error creating the temporary directory")
+	defer os.RemoveAll(tempDirName)
+	wd, err := os.Getwd()
+	assert.NoError(t, err, "Unexpected error getting the working directory")
+	defer os.Chdir(wd)
+	assert.NoError(t, os.Chdir(tempDirName), "Unexpected error changing the working directory")
+
+	err = ioutil.WriteFile(filepath.Join(tempDirName, ".env"), []byte("SOMAXCONN=1024"), 0644)
+	assert.NoError(t, err, "Unexpected error writing the .env file")
+
+	sysctls, err := parseSysctls("web", []interface{}{"net.core.somaxconn=${SOMAXCONN}"})
+	if assert.NoError(t, err, "Unexpected error parsing sysctls") {
+		assert.Equal(t, map[string]string{"net.core.somaxconn": "1024"}, sysctls)
+	}
+}
+
+func TestParseSysctls_ErrorWithInvalidValue(t *testing.T) {
+	testCases := []interface{}{
+		"net.core.somaxconn=1024",
+		[]interface{}{"net.core.somaxconn"},
+		map[string]interface{}{"net.core.somaxconn": nil},
+	}
+
+	for _, sysctls := range testCases {
+		_, err := parseSysctls("web", sysctls)
+		assert.Error(t, err, "Expected error for sysctls %v", sysctls)
+	}
+}
+
+func TestParseV3WithDeployResources(t *testing.T) {
+	// set up expected ContainerConfig values
+	wordpressCon := adapter.ContainerConfig{}
+	wordpressCon.Name = "wordpress"
+	wordpressCon.Image = "wordpress"
+	wordpressCon.CPU = int64(512)
+	wordpressCon.Memory = int64(1024)
+	wordpressCon.MemoryReservation = int64(256)
+
+	mysqlCon := adapter.ContainerConfig{}
+	mysqlCon.Name = "mysql"
+	mysqlCon.Image = "mysql"
+	mysqlCon.Memory = int64(500)
+
+	// set up file
+	composeFileString := `version: '3'
+services:
+  wordpress:
+    image: wordpress
+    deploy:
+      resources:
+        limits:
+          cpus: '1'
+          memory: 1g
+        reservations:
+          cpus: '0.5'
+          memory: 256m
+  mysql:
+    image: mysql
+    deploy:
+      replicas: 2
+      resources:
+        limits:
+          memory: 500m`
+
+	tmpfile, err := ioutil.TempFile("", "test")
+	assert.NoError(t, err, "Unexpected error in creating test file")
+
+	defer os.Remove(tmpfile.Name())
+
+	_, err = tmpfile.Write([]byte(composeFileString))
+	assert.NoError(t, err, "Unexpected error writing file")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Unexpected error closing file")
+
+	// add files to projects
+	project := setupTestProject(t)
+	project.ecsContext.ComposeFiles = append(project.ecsContext.ComposeFiles, tmpfile.Name())
+
+	// assert # and content of container configs matches expected
+	actualConfigs, err := project.parseV3()
+	assert.NoError(t, err, "Unexpected error parsing file")
+
+	assert.Equal(t, 2, len(*actualConfigs))
+
+	wp, err := getContainerConfigByName(wordpressCon.Name, actualConfigs)
+	assert.NoError(t, err, "Unexpected error retrieving wordpress config")
+	verifyContainerConfig(t, wordpressCon, *wp)
+
+	mysql, err := getContainerConfigByName(mysqlCon.Name, actualConfigs)
+	assert.NoError(t, err, "Unexpected error retrieving mysql config")
+	verifyContainerConfig(t, mysqlCon, *mysql)
+}
+
+func TestConvertDeployCPUs(t *testing.T) {
+	testCases := map[string]int64{
+		"":       0,
+		"1":      1024,
+		"0.25":   256,
+		"2.5":    2560,
+		"0.3":    307,
+		"0.0001": 0,
+	}
+
+	for input, expected := range testCases {
+		actual, err := convertDeployCPUs(input, "web")
+		assert.NoError(t, err, "Unexpected error converting %s", input)
+		assert.Equal(t, expected, actual, "Unexpected CPU units for %s", input)
+	}
+}
+
+func TestConvertDeployCPUs_ErrorWithInvalidValue(t *testing.T) {
+	for _, input := range []string{"fifty", "50%", "-1"} {
+		_, err := convertDeployCPUs(input, "web")
+		assert.Error(t, err, "Expected error converting %s", input)
+	}
+}
+
+func TestConvertDeployMemory(t *testing.T) {
+	assert.Equal(t, int64(0), convertDeployMemory(0, "deploy.resources.limits.memory", "web"))
+	assert.Equal(t, int64(0), convertDeployMemory(1024, "deploy.resources.limits.memory", "web"))
+	assert.Equal(t, int64(512), convertDeployMemory(512*miB, "deploy.resources.limits.memory", "web"))
+	assert.Equal(t, int64(476), convertDeployMemory(500000000, "deploy.resources.limits.memory", "web"))
+}
+
 // TODO: add check for fields not used by V3, use to also check V1V2 ContainerConfigs?
 func verifyContainerConfig(t *testing.T, expected, actual adapter.ContainerConfig) {
 	assert.ElementsMatch(t, expected.CapAdd, actual.CapAdd, "Expected CapAdd to match")
 	assert.ElementsMatch(t, expected.CapDrop, actual.CapDrop, "Expected CapDrop to match")
 	assert.ElementsMatch(t, expected.Command, actual.Command, "Expected Command to match")
+	assert.Equal(t, expected.CPU, actual.CPU, "Expected CPU to match")
 	assert.ElementsMatch(t, expected.Devices, actual.Devices, "Expected Devices to match")
 	assert.ElementsMatch(t, expected.DNSSearchDomains, actual.DNSSearchDomains, "Expected DNSSearchDomains to match")
 	assert.ElementsMatch(t, expected.DNSServers, actual.DNSServers, "Expected DNSServers to match")
@@ -743,10 +1002,14 @@ func verifyContainerConfig(t *testing.T, expected, actual adapter.ContainerConfi
 	assert.Equal(t, expected.Image, actual.Image, "Expected Image to match")
 	assert.ElementsMatch(t, expected.Links, actual.Links, "Expected Links to match")
 	assert.Equal(t, expected.LogConfiguration, actual.LogConfiguration, "Expected LogConfiguration to match")
+	assert.Equal(t, expected.Memory, actual.Memory, "Expected Memory to match")
+	assert.Equal(t, expected.MemoryReservation, actual.MemoryReservation, "Expected MemoryReservation to match")
 	assert.ElementsMatch(t, expected.MountPoints, actual.MountPoints, "Expected MountPoints to match")
 	assert.ElementsMatch(t, expected.PortMappings, actual.PortMappings, "Expected PortMappings to match")
 	assert.Equal(t, expected.Privileged, actual.Privileged, "Expected Privileged to match")
 	assert.Equal(t, expected.ReadOnly, actual.ReadOnly, "Expected ReadOnly to match")
+	assert.Equal(t, expected.StopTimeout, actual.StopTimeout, "Expected StopTimeout to match")
+	assert.Equal(t, expected.Sysctls, actual.Sysctls, "Expected Sysctls to match")
 	assert.ElementsMatch(t, expected.Tmpfs, actual.Tmpfs, "Expected Tmpfs to match")
 	assert.Equal(t, expected.PseudoTerminal, actual.PseudoTerminal, "Expected PseuoTerminal to match")
 	assert.ElementsMatch(t, expected.Ulimits, actual.Ulimits, "Expected Ulimits to match")
@@ -762,3 +1025,47 @@ func verifyContainerConfig(t *testing.T, expected, actual adapter.ContainerConfi
 		assert.Nil(t, actual.HealthCheck, "Expected healthcheck to be nil in output ContainerConfig")
 	}
 }
+
+func TestParseV3WithBuild(t *testing.T) {
+	composeFileString := `version: '3'
+services:
+  web:
+    image: web:1.0
+    build:
+      context: ./web
+      args:
+        VERSION: "1.2"
+  worker:
+    build: /src/worker`
+
+	tmpfile, err := ioutil.TempFile("", "test")
+	assert.NoError(t, err, "Unexpected error in creating test file")
+
+	defer os.Remove(tmpfile.Name())
+
+	_, err = tmpfile.Write([]byte(composeFileString))
+	assert.NoError(t, err, "Unexpected error writing file")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Unexpected error closing file")
+
+	project := setupTestProject(t)
+	project.ecsContext.ComposeFiles = append(project.ecsContext.ComposeFiles, tmpfile.Name())
+
+	actualConfigs, err := project.parseV3()
+	assert.NoError(t, err, "Unexpected error parsing file")
+
+	web, err := getContainerConfigByName("web", actualConfigs)
+	assert.NoError(t, err, "Unexpected error retrieving web config")
+	assert.Equal(t, "web:1.0", web.Image)
+	if assert.NotNil(t, web.Build, "Expected web to have a build section") {
+		assert.Equal(t, filepath.Join(filepath.Dir(tmpfile.Name()), "web"), web.Build.Context, "Expected build context relative to the compose file")
+		assert.Equal(t, map[string]string{"VERSION": "1.2"}, web.Build.Args)
+	}
+
+	worker, err := getContainerConfigByName("worker", actualConfigs)
+	assert.NoError(t, err, "Unexpected error retrieving worker config")
+	if assert.NotNil(t, worker.Build, "Expected worker to have a build section") {
+		assert.Equal(t, "/src/worker", worker.Build.Context)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	cliUtils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/sirupsen/logrus"
+)
+
+// filterServices limits the container configs of the project to the services
+// selected with --services and not excluded with --exclude-services, and
+// drops the volumes that only the removed services mounted
+func (p *ecsProject) filterServices() error {
+	cliContext := p.ecsContext.CLIContext
+	included := splitServiceNames(cliContext.GlobalStringSlice(flags.ServicesFlag))
+	excluded := splitServiceNames(cliContext.GlobalStringSlice(flags.ExcludeServicesFlag))
+	if len(included) == 0 && len(excluded) == 0 {
+		return nil
+	}
+
+	configs, err := filterContainerConfigs(p.containerConfigs, included, excluded)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(configs))
+	for i, config := range configs {
+		names[i] = config.Name
+	}
+	logrus.WithField("services", strings.Join(names, ",")).Info("Using a subset of the compose services")
+
+	p.containerConfigs = configs
+	p.volumes = filterVolumes(p.volumes, configs)
+	return nil
+}
+
+// filterContainerConfigs returns the configs of the included services, or of
+// every service if included is empty, minus the excluded services. It returns
+// an error if a name does not match a service, if no service is left, or if a
+// remaining service refers to a removed one through links, volumes_from or
+// depends_on.
+func filterContainerConfigs(configs []adapter.ContainerConfig, included, excluded []string) ([]adapter.ContainerConfig, error) {
+	defined := make(map[string]bool)
+	definedNames := []string{}
+	for _, config := range configs {
+		defined[config.Name] = true
+		definedNames = append(definedNames, config.Name)
+	}
+	sort.Strings(definedNames)
+
+	selected := make(map[string]bool)
+	for _, name := range included {
+		if !defined[name] {
+			return nil, fmt.Errorf("--%s: service %s is not defined in the compose file; services are %s", flags.ServicesFlag, name, strings.Join(definedNames, ", "))
+		}
+		selected[name] = true
+	}
+	for _, name := range excluded {
+		if !defined[name] {
+			return nil, fmt.Errorf("--%s: service %s is not defined in the compose file; services are %s", flags.ExcludeServicesFlag, name, strings.Join(definedNames, ", "))
+		}
+	}
+
+	removed := make(map[string]bool)
+	output := []adapter.ContainerConfig{}
+	for _, config := range configs {
+		if (len(selected) > 0 && !selected[config.Name]) || cliUtils.InSlice(config.Name, excluded) {
+			removed[config.Name] = true
+			continue
+		}
+		output = append(output, config)
+	}
+	if len(output) == 0 {
+		return nil, fmt.Errorf("no services left after applying --%s and --%s", flags.ServicesFlag, flags.ExcludeServicesFlag)
+	}
+
+	for _, config := range output {
+		for _, link := range config.Links {
+			if name := strings.SplitN(link, ":", 2)[0]; removed[name] {
+				return nil, excludedReferenceError(config.Name, "links", name)
+			}
+		}
+		for _, volumeFrom := range config.VolumesFrom {
+			if name := aws.StringValue(volumeFrom.SourceContainer); removed[name] {
+				return nil, excludedReferenceError(config.Name, "volumes_from", name)
+			}
+		}
+		for _, dependency := range config.DependsOn {
+			if removed[dependency.Name] {
+				return nil, excludedReferenceError(config.Name, "depends_on", dependency.Name)
+			}
+		}
+	}
+	return output, nil
+}
+
+func excludedReferenceError(serviceName, field, excludedName string) error {
+	return fmt.Errorf("service %s refers to service %s in %s, which is not selected; add %s to --%s or remove it from --%s", serviceName, excludedName, field, excludedName, flags.ServicesFlag, flags.ExcludeServicesFlag)
+}
+
+// filterVolumes returns the volumes that are mounted by the given containers
+func filterVolumes(volumes *adapter.Volumes, configs []adapter.ContainerConfig) *adapter.Volumes {
+	mounted := make(map[string]bool)
+	for _, config := range configs {
+		for _, mountPoint := range config.MountPoints {
+			mounted[aws.StringValue(mountPoint.SourceVolume)] = true
+		}
+	}
+
+	output := adapter.NewVolumes()
+	for hostPath, name := range volumes.VolumeWithHost {
+		if mounted[name] {
+			output.VolumeWithHost[hostPath] = name
+		}
+	}
+	for _, name := range volumes.VolumeEmptyHost {
+		if mounted[name] {
+			output.VolumeEmptyHost = append(output.VolumeEmptyHost, name)
+		}
+	}
+	return output
+}
+
+// splitServiceNames flattens service names given either as repeated flags
+// or as comma separated lists
+func splitServiceNames(values []string) []string {
+	names := []string{}
+	for _, value := range values {
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
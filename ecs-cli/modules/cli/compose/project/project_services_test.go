@@ -0,0 +1,102 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func testServiceConfigs() []adapter.ContainerConfig {
+	return []adapter.ContainerConfig{
+		{
+			Name:        "app",
+			Links:       []string{"db:database"},
+			MountPoints: []*ecs.MountPoint{{SourceVolume: aws.String("uploads")}},
+		},
+		{
+			Name:        "worker",
+			VolumesFrom: []*ecs.VolumeFrom{{SourceContainer: aws.String("app")}},
+			DependsOn:   []adapter.ContainerDependency{{Name: "db"}},
+		},
+		{Name: "db", MountPoints: []*ecs.MountPoint{{SourceVolume: aws.String("volume-1")}}},
+		{Name: "mock"},
+	}
+}
+
+func configNames(configs []adapter.ContainerConfig) []string {
+	names := []string{}
+	for _, config := range configs {
+		names = append(names, config.Name)
+	}
+	return names
+}
+
+func TestFilterContainerConfigs(t *testing.T) {
+	configs, err := filterContainerConfigs(testServiceConfigs(), nil, []string{"mock"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"app", "worker", "db"}, configNames(configs))
+	}
+
+	configs, err = filterContainerConfigs(testServiceConfigs(), []string{"db", "app"}, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"app", "db"}, configNames(configs), "Expected compose file order to be kept")
+	}
+
+	configs, err = filterContainerConfigs(testServiceConfigs(), []string{"app", "db", "mock"}, []string{"mock"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"app", "db"}, configNames(configs), "Expected exclusions to win over inclusions")
+	}
+}
+
+func TestFilterContainerConfigs_Errors(t *testing.T) {
+	testCases := []struct {
+		included []string
+		excluded []string
+		expected string
+	}{
+		{[]string{"web"}, nil, "--services: service web is not defined in the compose file; services are app, db, mock, worker"},
+		{nil, []string{"web"}, "--exclude-services: service web is not defined in the compose file; services are app, db, mock, worker"},
+		{[]string{"mock"}, []string{"mock"}, "no services left after applying --services and --exclude-services"},
+		{[]string{"app"}, nil, "service app refers to service db in links, which is not selected; add db to --services or remove it from --exclude-services"},
+		{nil, []string{"app"}, "service worker refers to service app in volumes_from, which is not selected; add app to --services or remove it from --exclude-services"},
+		{[]string{"worker", "app"}, nil, "service app refers to service db in links, which is not selected; add db to --services or remove it from --exclude-services"},
+		{[]string{"worker"}, nil, "service worker refers to service app in volumes_from, which is not selected; add app to --services or remove it from --exclude-services"},
+	}
+
+	for _, testCase := range testCases {
+		_, err := filterContainerConfigs(testServiceConfigs(), testCase.included, testCase.excluded)
+		assert.EqualError(t, err, testCase.expected, "Unexpected error for --services %v --exclude-services %v", testCase.included, testCase.excluded)
+	}
+}
+
+func TestFilterVolumes(t *testing.T) {
+	volumes := &adapter.Volumes{
+		VolumeWithHost:  map[string]string{"./uploads": "uploads", "./mock": "mock-data"},
+		VolumeEmptyHost: []string{"volume-1", "unused"},
+	}
+	configs := testServiceConfigs()[:1]
+
+	filtered := filterVolumes(volumes, configs)
+	assert.Equal(t, map[string]string{"./uploads": "uploads"}, filtered.VolumeWithHost)
+	assert.Empty(t, filtered.VolumeEmptyHost)
+}
+
+func TestSplitServiceNames(t *testing.T) {
+	assert.Equal(t, []string{"app", "worker", "db"}, splitServiceNames([]string{"app, worker", "db", ""}))
+}
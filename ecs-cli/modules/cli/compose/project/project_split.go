@@ -0,0 +1,185 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/diff"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/service"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/docker/libcompose/project"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// splitServices reports whether each compose service is deployed as its own
+// task definition and ECS Service
+func (p *ecsProject) splitServices() bool {
+	return p.ecsContext.IsService && p.ecsContext.CLIContext.Bool(flags.SplitServicesFlag)
+}
+
+// transformSplitTaskDefinitions creates one service entity per compose
+// service, each with a task definition holding only that service's container
+func (p *ecsProject) transformSplitTaskDefinitions() error {
+	if err := validateSplitContainerConfigs(p.containerConfigs); err != nil {
+		return err
+	}
+	if err := p.validateServiceOverrides(); err != nil {
+		return err
+	}
+
+	p.entities = []entity.ProjectEntity{}
+	for _, config := range p.containerConfigs {
+		ecsContext := p.composeServiceContext(config.Name)
+		logrus.WithField("service", config.Name).Debugf("Deploying compose service as %s", ecsContext.ProjectName)
+		serviceEntity := service.NewService(ecsContext)
+		if err := serviceEntity.LoadContext(); err != nil {
+			return err
+		}
+
+		configs := []adapter.ContainerConfig{config}
+		taskDefinition, err := p.convertTaskDefinition(ecsContext, configs, filterVolumes(p.volumes, configs))
+		if err != nil {
+			return errors.Wrapf(err, "service %s", config.Name)
+		}
+		serviceEntity.SetTaskDefinition(taskDefinition)
+		p.entities = append(p.entities, serviceEntity)
+	}
+	return nil
+}
+
+// composeServiceContext returns a copy of the project context scoped to a
+// single compose service. The project name, which names the task definition
+// family and the ECS Service, becomes <project-name>-<service-name>, and the
+// ecs-params carry the service_overrides of that service.
+func (p *ecsProject) composeServiceContext(serviceName string) *context.ECSContext {
+	ecsContext := *p.ecsContext
+	ecsContext.ProjectName = p.ecsContext.ProjectName + "-" + serviceName
+	ecsContext.ComposeServiceName = serviceName
+	ecsContext.ECSParams = p.ecsContext.ECSParams.ForComposeService(serviceName)
+	return &ecsContext
+}
+
+// validateServiceOverrides checks that service_overrides only names services
+// that are deployed by this project
+func (p *ecsProject) validateServiceOverrides() error {
+	ecsParams := p.ecsContext.ECSParams
+	if ecsParams == nil {
+		return nil
+	}
+	defined := []string{}
+	for _, config := range p.containerConfigs {
+		defined = append(defined, config.Name)
+	}
+	sort.Strings(defined)
+
+	names := []string{}
+	for name := range ecsParams.ServiceOverrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if i := sort.SearchStrings(defined, name); i == len(defined) || defined[i] != name {
+			return fmt.Errorf("service_overrides: service %s is not defined in the compose file; services are %s", name, strings.Join(defined, ", "))
+		}
+	}
+	return nil
+}
+
+// validateSplitContainerConfigs returns an error if a compose service refers
+// to another one through links, volumes_from or depends_on, since those only
+// work between containers of the same task
+func validateSplitContainerConfigs(configs []adapter.ContainerConfig) error {
+	for _, config := range configs {
+		if len(config.Links) > 0 {
+			return splitReferenceError(config.Name, "links", strings.SplitN(config.Links[0], ":", 2)[0])
+		}
+		if len(config.VolumesFrom) > 0 {
+			return splitReferenceError(config.Name, "volumes_from", aws.StringValue(config.VolumesFrom[0].SourceContainer))
+		}
+		if len(config.DependsOn) > 0 {
+			return splitReferenceError(config.Name, "depends_on", config.DependsOn[0].Name)
+		}
+	}
+	return nil
+}
+
+func splitReferenceError(serviceName, field, otherName string) error {
+	return fmt.Errorf("service %s refers to service %s in %s, which is not supported with --%s because each service runs in its own task", serviceName, otherName, field, flags.SplitServicesFlag)
+}
+
+// projectEntities returns the entities the commands act on: one per compose
+// service with --split-services, otherwise the single project entity
+func (p *ecsProject) projectEntities() []entity.ProjectEntity {
+	if len(p.entities) > 0 {
+		return p.entities
+	}
+	return []entity.ProjectEntity{p.entity}
+}
+
+// forEachEntity calls command on every project entity, stopping at the first error
+func (p *ecsProject) forEachEntity(command func(entity.ProjectEntity) error) error {
+	for _, projectEntity := range p.projectEntities() {
+		if err := command(projectEntity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// entitiesInfo concatenates the containers of every project entity
+func (p *ecsProject) entitiesInfo(desiredStatus string) (project.InfoSet, error) {
+	allInfo := project.InfoSet{}
+	for _, projectEntity := range p.projectEntities() {
+		info, err := projectEntity.Info(true, desiredStatus)
+		if err != nil {
+			return nil, err
+		}
+		allInfo = append(allInfo, info...)
+	}
+	return allInfo, nil
+}
+
+// entitiesRevisions concatenates the task definition revisions of every project entity
+func (p *ecsProject) entitiesRevisions() (project.InfoSet, error) {
+	allRevisions := project.InfoSet{}
+	for _, projectEntity := range p.projectEntities() {
+		revisions, err := projectEntity.Revisions()
+		if err != nil {
+			return nil, err
+		}
+		allRevisions = append(allRevisions, revisions...)
+	}
+	return allRevisions, nil
+}
+
+// entitiesDiff collects the differences of every project entity
+func (p *ecsProject) entitiesDiff() ([]*diff.Service, error) {
+	diffs := []*diff.Service{}
+	for _, projectEntity := range p.projectEntities() {
+		diff, err := projectEntity.Diff()
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package project
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func setupSplitTestProject(configs []adapter.ContainerConfig, ecsParams *utils.ECSParams) *ecsProject {
+	flagSet := flag.NewFlagSet("ecs-cli", 0)
+	flagSet.Bool(flags.SplitServicesFlag, true, "")
+	cliContext := cli.NewContext(nil, flagSet, nil)
+
+	ecsContext := &context.ECSContext{
+		CLIContext:    cliContext,
+		CommandConfig: &config.CommandConfig{},
+		ECSParams:     ecsParams,
+		IsService:     true,
+	}
+	ecsContext.ProjectName = testProjectName
+
+	volumes := adapter.NewVolumes()
+	volumes.VolumeEmptyHost = []string{"uploads", "cache"}
+
+	return &ecsProject{
+		ecsContext:       ecsContext,
+		containerConfigs: configs,
+		volumes:          volumes,
+	}
+}
+
+func TestTransformSplitTaskDefinitions(t *testing.T) {
+	configs := []adapter.ContainerConfig{
+		{Name: "web", Image: "nginx", Memory: 512, MountPoints: []*ecs.MountPoint{{SourceVolume: aws.String("uploads")}}},
+		{Name: "worker", Image: "worker", Memory: 256},
+	}
+	ecsParams := &utils.ECSParams{
+		TaskDefinition: utils.EcsTaskDef{TaskSize: utils.TaskSize{Cpu: "256", Memory: "512"}},
+		ServiceOverrides: map[string]utils.ServiceOverride{
+			"web": {TaskSize: &utils.TaskSize{Cpu: "1024", Memory: "2048"}},
+		},
+	}
+	project := setupSplitTestProject(configs, ecsParams)
+	assert.True(t, project.splitServices())
+
+	err := project.transformSplitTaskDefinitions()
+	if !assert.NoError(t, err) || !assert.Len(t, project.entities, 2) {
+		return
+	}
+	assert.Len(t, project.projectEntities(), 2)
+
+	web := project.entities[0]
+	assert.Equal(t, testProjectName+"-web", web.Context().ProjectName)
+	assert.Equal(t, "web", web.Context().ComposeServiceName)
+	assert.Equal(t, testProjectName+"-web", aws.StringValue(web.TaskDefinition().Family))
+	assert.Equal(t, "1024", aws.StringValue(web.TaskDefinition().Cpu), "Expected the task size of the service override")
+	if assert.Len(t, web.TaskDefinition().ContainerDefinitions, 1) {
+		assert.Equal(t, "web", aws.StringValue(web.TaskDefinition().ContainerDefinitions[0].Name))
+	}
+	if assert.Len(t, web.TaskDefinition().Volumes, 1, "Expected only the volumes mounted by web") {
+		assert.Equal(t, "uploads", aws.StringValue(web.TaskDefinition().Volumes[0].Name))
+	}
+
+	worker := project.entities[1]
+	assert.Equal(t, testProjectName+"-worker", aws.StringValue(worker.TaskDefinition().Family))
+	assert.Equal(t, "256", aws.StringValue(worker.TaskDefinition().Cpu), "Expected the project task size")
+	assert.Empty(t, worker.TaskDefinition().Volumes)
+
+	assert.Equal(t, testProjectName, project.ecsContext.ProjectName, "Expected the project context to be unchanged")
+}
+
+func TestTransformSplitTaskDefinitions_ErrorWithReferenceToOtherService(t *testing.T) {
+	configs := []adapter.ContainerConfig{
+		{Name: "web", Image: "nginx", Memory: 512, Links: []string{"db:database"}},
+		{Name: "db", Image: "mysql", Memory: 512},
+	}
+	project := setupSplitTestProject(configs, nil)
+
+	err := project.transformSplitTaskDefinitions()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "service web refers to service db in links")
+	}
+}
+
+func TestTransformSplitTaskDefinitions_ErrorWithUnknownServiceOverride(t *testing.T) {
+	configs := []adapter.ContainerConfig{
+		{Name: "web", Image: "nginx", Memory: 512},
+	}
+	ecsParams := &utils.ECSParams{
+		ServiceOverrides: map[string]utils.ServiceOverride{
+			"wbe": {DesiredCount: aws.Int64(2)},
+		},
+	}
+	project := setupSplitTestProject(configs, ecsParams)
+
+	err := project.transformSplitTaskDefinitions()
+	if assert.Error(t, err) {
+		assert.Equal(t, "service_overrides: service wbe is not defined in the compose file; services are web", err.Error())
+	}
+}
@@ -0,0 +1,86 @@
+package project
+
+import (
+	"fmt"
+	"strings"
+
+	cliUtils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/docker/cli/cli/compose/template"
+)
+
+const sysctlsKey = "sysctls"
+
+// serviceSysctls holds the kernel parameters of each service, keyed by service
+// name and then by parameter name
+type serviceSysctls map[string]map[string]string
+
+// extractSysctls records the sysctls of each service in a parsed compose file
+// and removes them from the file, since libcompose rejects the option and the
+// v3 parser drops it. Values from later files override earlier ones.
+func extractSysctls(composeConfig map[string]interface{}, sysctls serviceSysctls) (bool, error) {
+	modified := false
+	for serviceName, serviceConfig := range getServiceConfigs(composeConfig) {
+		value, ok := serviceConfig[sysctlsKey]
+		if !ok {
+			continue
+		}
+
+		parameters, err := parseSysctls(serviceName, value)
+		if err != nil {
+			return false, err
+		}
+		if sysctls[serviceName] == nil {
+			sysctls[serviceName] = make(map[string]string)
+		}
+		for name, parameter := range parameters {
+			sysctls[serviceName][name] = parameter
+		}
+
+		delete(serviceConfig, sysctlsKey)
+		modified = true
+	}
+	return modified, nil
+}
+
+// parseSysctls accepts either a mapping of parameter to value or a list of
+// "parameter=value" strings, and interpolates variables from the .env file and
+// the shell environment in the values
+func parseSysctls(serviceName string, value interface{}) (map[string]string, error) {
+	parameters := make(map[string]string)
+	switch sysctls := value.(type) {
+	case map[string]interface{}:
+		for name, v := range sysctls {
+			if v == nil {
+				return nil, fmt.Errorf("%s: sysctls.%s must have a value", serviceName, name)
+			}
+			parameters[name] = fmt.Sprint(v)
+		}
+	case []interface{}:
+		for _, entry := range sysctls {
+			parts := strings.SplitN(fmt.Sprint(entry), "=", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				return nil, fmt.Errorf("%s: sysctls entry '%v' must be of the form name=value", serviceName, entry)
+			}
+			parameters[parts[0]] = parts[1]
+		}
+	case nil:
+	default:
+		return nil, fmt.Errorf("%s: sysctls must be a mapping or a list of name=value strings", serviceName)
+	}
+
+	if len(parameters) == 0 {
+		return parameters, nil
+	}
+	mapping, err := cliUtils.GetDefaultEnvironmentMapping()
+	if err != nil {
+		return nil, err
+	}
+	for name, parameter := range parameters {
+		interpolated, err := template.Substitute(parameter, mapping)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid interpolation format for sysctls.%s: %v", serviceName, name, err)
+		}
+		parameters[name] = interpolated
+	}
+	return parameters, nil
+}
@@ -19,10 +19,16 @@ import (
 	"os"
 	"testing"
 
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/context"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	cliUtils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/compose"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/regcredio"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli"
 )
@@ -255,6 +261,36 @@ run_params:
 	assert.NoError(t, err, "Could not close tempfile")
 }
 
+func TestTransformTaskDefinition_InvalidFargateTaskSize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// neither the entity nor ECS is expected to be called
+	ecsContext := &context.ECSContext{
+		ECSClient:     mock_ecs.NewMockECSClient(ctrl),
+		CLIContext:    cli.NewContext(nil, flag.NewFlagSet("ecs-cli", 0), nil),
+		CommandConfig: &config.CommandConfig{LaunchType: config.LaunchTypeFargate},
+		ECSParams: &utils.ECSParams{
+			TaskDefinition: utils.EcsTaskDef{
+				NetworkMode: "awsvpc",
+				TaskSize:    utils.TaskSize{Cpu: "256", Memory: "4GB"},
+			},
+		},
+	}
+	ecsContext.ProjectName = testProjectName
+	project := &ecsProject{
+		ecsContext:       ecsContext,
+		containerConfigs: []adapter.ContainerConfig{{Name: "web", Image: "nginx"}},
+		volumes:          adapter.NewVolumes(),
+		entity:           mock_entity.NewMockProjectEntity(ctrl),
+	}
+
+	err := project.transformTaskDefinition()
+	if assert.Error(t, err, "Expected an invalid Fargate task size to fail before the task definition is registered") {
+		assert.Contains(t, err.Error(), "not a valid Fargate combination")
+	}
+}
+
 func TestThrowErrorForUnsupportedComposeVersion(t *testing.T) {
 	unsupportedVersion := "4"
 	composeFileString := `version: '` + unsupportedVersion + `'
@@ -301,7 +337,7 @@ func setupTestProjectWithEcsParams(t *testing.T, ecsParamsFileName string) *ecsP
 
 // TODO: refactor into all-purpose 'setupTestProject' func
 func setupTestProjectWithECSRegistryCreds(t *testing.T, ecsParamsFileName, credFileName string) *ecsProject {
-	envLookup, err := utils.GetDefaultEnvironmentLookup()
+	envLookup, err := cliUtils.GetDefaultEnvironmentLookup()
 	assert.NoError(t, err, "Unexpected error setting up environment lookup")
 
 	resourceLookup, err := utils.GetDefaultResourceLookup()
@@ -309,7 +345,11 @@ func setupTestProjectWithECSRegistryCreds(t *testing.T, ecsParamsFileName, credF
 
 	flagSet := flag.NewFlagSet("ecs-cli", 0)
 	flagSet.String(flags.ProjectNameFlag, testProjectName, "")
-	flagSet.String(flags.ECSParamsFileNameFlag, ecsParamsFileName, "")
+	ecsParamsFiles := &cli.StringSlice{}
+	if ecsParamsFileName != "" {
+		ecsParamsFiles.Set(ecsParamsFileName)
+	}
+	flagSet.Var(ecsParamsFiles, flags.ECSParamsFileNameFlag, "")
 	flagSet.String(flags.RegistryCredsFileNameFlag, credFileName, "")
 
 	parentContext := cli.NewContext(nil, flagSet, nil)
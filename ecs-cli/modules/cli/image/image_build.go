@@ -0,0 +1,84 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	stsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/sts"
+	dockerclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker"
+	docker "github.com/fsouza/go-dockerclient"
+)
+
+// defaultImageTag is used when the image to build has no tag
+const defaultImageTag = "latest"
+
+// BuildImageInput describes an image to build and push to ECR
+type BuildImageInput struct {
+	// Image is the name to push the image as, in the format
+	// [REGISTRY_URI/]ECR_REPOSITORY[:TAG]. Without a registry URI the image
+	// is pushed to the registry of the current account.
+	Image      string
+	ContextDir string
+	Dockerfile string
+	BuildArgs  map[string]string
+}
+
+// BuildAndPushImage builds an image, creates its ECR repository if it does
+// not exist and pushes it, using the same ECR authentication as ecs-cli push.
+// It returns the URI of the pushed image.
+func BuildAndPushImage(input BuildImageInput, dockerClient dockerclient.Client, ecrClient ecrclient.Client, stsClient stsclient.Client) (string, error) {
+	registryURI, repository, tag, err := splitImageName(input.Image, "[:]", PushImageFormat)
+	if err != nil {
+		return "", err
+	}
+	if tag == "" {
+		tag = defaultImageTag
+	}
+
+	var registryID string
+	if registryURI == "" {
+		registryID, err = getRegistryID("", stsClient)
+	} else {
+		registryID, err = getRegistryIDFromURI(registryURI)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	ecrAuth, err := getECRAuth(registryURI, registryID, stsClient, ecrClient)
+	if err != nil {
+		return "", err
+	}
+	repositoryURI := ecrAuth.Registry + "/" + repository
+
+	if err := dockerClient.BuildImage(repositoryURI+":"+tag, input.ContextDir, input.Dockerfile, input.BuildArgs); err != nil {
+		return "", err
+	}
+
+	if !ecrClient.RepositoryExists(repository) {
+		if _, err := ecrClient.CreateRepository(repository); err != nil {
+			return "", err
+		}
+	}
+
+	dockerAuth := docker.AuthConfiguration{
+		Username:      ecrAuth.Username,
+		Password:      ecrAuth.Password,
+		ServerAddress: ecrAuth.ProxyEndpoint,
+	}
+	if err := dockerClient.PushImage(repositoryURI, tag, ecrAuth.Registry, dockerAuth); err != nil {
+		return "", err
+	}
+	return repositoryURI + ":" + tag, nil
+}
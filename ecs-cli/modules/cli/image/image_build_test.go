@@ -0,0 +1,96 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	"github.com/fsouza/go-dockerclient"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildAndPushImage(t *testing.T) {
+	mockECR, mockDocker, mockSTS, _ := setupTestController(t)
+	buildArgs := map[string]string{"VERSION": "1"}
+
+	gomock.InOrder(
+		mockSTS.EXPECT().GetAWSAccountID().Return(registryID, nil),
+		mockECR.EXPECT().GetAuthorizationTokenByID(registryID).Return(&ecr.Auth{
+			Registry: registry,
+		}, nil),
+		mockDocker.EXPECT().BuildImage(repositoryURI+":latest", "/src/web", "Dockerfile.prod", buildArgs).Return(nil),
+		mockECR.EXPECT().RepositoryExists(repository).Return(false),
+		mockECR.EXPECT().CreateRepository(repository).Return(repository, nil),
+		mockDocker.EXPECT().PushImage(repositoryURI, "latest", registry, docker.AuthConfiguration{}).Return(nil),
+	)
+
+	input := BuildImageInput{
+		Image:      repository,
+		ContextDir: "/src/web",
+		Dockerfile: "Dockerfile.prod",
+		BuildArgs:  buildArgs,
+	}
+	pushed, err := BuildAndPushImage(input, mockDocker, mockECR, mockSTS)
+	if assert.NoError(t, err, "Error building and pushing image") {
+		assert.Equal(t, repositoryURI+":latest", pushed)
+	}
+}
+
+func TestBuildAndPushImage_WithRegistryURIAndExistingRepository(t *testing.T) {
+	mockECR, mockDocker, mockSTS, _ := setupTestController(t)
+	registryURI := registryID + ".dkr.ecr.us-west-2.amazonaws.com"
+
+	gomock.InOrder(
+		mockECR.EXPECT().GetAuthorizationToken(registryURI).Return(&ecr.Auth{
+			Registry: registryURI,
+		}, nil),
+		mockDocker.EXPECT().BuildImage(registryURI+"/"+image, "/src/web", "", nil).Return(nil),
+		mockECR.EXPECT().RepositoryExists(repository).Return(true),
+		mockDocker.EXPECT().PushImage(registryURI+"/"+repository, tag, registryURI, docker.AuthConfiguration{}).Return(nil),
+	)
+
+	input := BuildImageInput{
+		Image:      registryURI + "/" + image,
+		ContextDir: "/src/web",
+	}
+	pushed, err := BuildAndPushImage(input, mockDocker, mockECR, mockSTS)
+	if assert.NoError(t, err, "Error building and pushing image") {
+		assert.Equal(t, registryURI+"/"+image, pushed)
+	}
+}
+
+func TestBuildAndPushImage_ErrorBuildingImage(t *testing.T) {
+	mockECR, mockDocker, mockSTS, _ := setupTestController(t)
+
+	gomock.InOrder(
+		mockSTS.EXPECT().GetAWSAccountID().Return(registryID, nil),
+		mockECR.EXPECT().GetAuthorizationTokenByID(registryID).Return(&ecr.Auth{
+			Registry: registry,
+		}, nil),
+		mockDocker.EXPECT().BuildImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("build failed")),
+	)
+
+	_, err := BuildAndPushImage(BuildImageInput{Image: image, ContextDir: "."}, mockDocker, mockECR, mockSTS)
+	assert.Error(t, err, "Expected error when the build fails")
+}
+
+func TestBuildAndPushImage_ErrorWithInvalidImageName(t *testing.T) {
+	mockECR, mockDocker, mockSTS, _ := setupTestController(t)
+
+	_, err := BuildAndPushImage(BuildImageInput{Image: "docker.io/Library/Web", ContextDir: "."}, mockDocker, mockECR, mockSTS)
+	assert.Error(t, err, "Expected error with an image name that is not an ECR repository")
+}
@@ -0,0 +1,93 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"strings"
+
+	ecrclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecr"
+	dockerclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker"
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// dockerHubServerAddress is the key of Docker Hub credentials in the docker config file
+const dockerHubServerAddress = "https://index.docker.io/v1/"
+
+// make the docker config file easily mockable in tests
+var dockerAuthConfigurations = docker.NewAuthConfigurationsFromDockerCfg
+
+// ResolveImageDigest returns the image pinned to the digest its tag currently
+// points to, in the format REPOSITORY@sha256:.... Images in ECR are resolved
+// with DescribeImages, other images are pulled with docker to get the digest
+// from their registry. Images already referenced by digest are returned as is.
+func ResolveImageDigest(image string, dockerClient dockerclient.Client, ecrClient ecrclient.Client) (string, error) {
+	if strings.Contains(image, "@") {
+		return image, nil
+	}
+
+	repository, tag := splitImageTag(image)
+
+	var digest string
+	registryURI, ecrRepository, _, err := splitImageName(image, "[:]", PushImageFormat)
+	if err == nil && registryURI != "" {
+		registryID, err := getRegistryIDFromURI(registryURI)
+		if err != nil {
+			return "", err
+		}
+		digest, err = ecrClient.GetImageDigest(registryID, ecrRepository, tag)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		digest, err = dockerClient.ImageDigest(repository, tag, dockerAuth(repository))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"image":  image,
+		"digest": digest,
+	}).Debug("Resolved image digest")
+	return repository + "@" + digest, nil
+}
+
+// splitImageTag splits an image into its repository and tag, which defaults
+// to latest. The registry of the repository may contain a port.
+func splitImageTag(image string) (repository, tag string) {
+	i := strings.LastIndex(image, ":")
+	if i == -1 || strings.Contains(image[i+1:], "/") {
+		return image, defaultImageTag
+	}
+	return image[:i], image[i+1:]
+}
+
+// dockerAuth returns the credentials of the registry of repository from the
+// docker config file, or no credentials if there are none
+func dockerAuth(repository string) docker.AuthConfiguration {
+	serverAddress := dockerHubServerAddress
+	if parts := strings.SplitN(repository, "/", 2); len(parts) == 2 &&
+		(strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		serverAddress = parts[0]
+	}
+
+	auths, err := dockerAuthConfigurations()
+	if err != nil {
+		log.WithError(errors.Cause(err)).Debug("Pulling image without credentials")
+		return docker.AuthConfiguration{}
+	}
+	return auths.Configs[serverAddress]
+}
@@ -0,0 +1,113 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package image
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fsouza/go-dockerclient"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const digest = "sha256:0123456789abcdef"
+
+func TestResolveImageDigest_ECRImage(t *testing.T) {
+	mockECR, mockDocker, _, _ := setupTestController(t)
+	ecrRepositoryURI := registryID + ".dkr.ecr.us-west-2.amazonaws.com/" + repositoryWithSlash
+
+	mockECR.EXPECT().GetImageDigest(registryID, repositoryWithSlash, tag).Return(digest, nil)
+
+	pinned, err := ResolveImageDigest(ecrRepositoryURI+":"+tag, mockDocker, mockECR)
+	if assert.NoError(t, err, "Unexpected error resolving image digest") {
+		assert.Equal(t, ecrRepositoryURI+"@"+digest, pinned)
+	}
+}
+
+func TestResolveImageDigest_DockerImage(t *testing.T) {
+	mockECR, mockDocker, _, _ := setupTestController(t)
+	defer mockDockerAuthConfigurations(map[string]docker.AuthConfiguration{
+		"registry.example.com:5000": {Username: "user", Password: "pass"},
+	})()
+
+	testCases := map[string]struct {
+		image              string
+		expectedRepository string
+		expectedTag        string
+		expectedAuth       docker.AuthConfiguration
+	}{
+		"docker hub image without tag": {
+			image:              "nginx",
+			expectedRepository: "nginx",
+			expectedTag:        "latest",
+		},
+		"docker hub image with tag": {
+			image:              "library/nginx:1.15",
+			expectedRepository: "library/nginx",
+			expectedTag:        "1.15",
+		},
+		"private registry with port": {
+			image:              "registry.example.com:5000/team/web:v2",
+			expectedRepository: "registry.example.com:5000/team/web",
+			expectedTag:        "v2",
+			expectedAuth:       docker.AuthConfiguration{Username: "user", Password: "pass"},
+		},
+		"private registry with port without tag": {
+			image:              "registry.example.com:5000/team/web",
+			expectedRepository: "registry.example.com:5000/team/web",
+			expectedTag:        "latest",
+			expectedAuth:       docker.AuthConfiguration{Username: "user", Password: "pass"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockDocker.EXPECT().ImageDigest(tc.expectedRepository, tc.expectedTag, tc.expectedAuth).Return(digest, nil)
+
+			pinned, err := ResolveImageDigest(tc.image, mockDocker, mockECR)
+			if assert.NoError(t, err, "Unexpected error resolving image digest") {
+				assert.Equal(t, tc.expectedRepository+"@"+digest, pinned)
+			}
+		})
+	}
+}
+
+func TestResolveImageDigest_ImageWithDigest(t *testing.T) {
+	mockECR, mockDocker, _, _ := setupTestController(t)
+
+	pinned, err := ResolveImageDigest("nginx@"+digest, mockDocker, mockECR)
+	if assert.NoError(t, err, "Unexpected error resolving image digest") {
+		assert.Equal(t, "nginx@"+digest, pinned)
+	}
+}
+
+func TestResolveImageDigest_ErrorCase(t *testing.T) {
+	mockECR, mockDocker, _, _ := setupTestController(t)
+
+	mockDocker.EXPECT().ImageDigest("nginx", "latest", gomock.Any()).Return("", errors.New("something failed"))
+
+	_, err := ResolveImageDigest("nginx", mockDocker, mockECR)
+	assert.Error(t, err, "Expected error resolving image digest")
+}
+
+func mockDockerAuthConfigurations(configs map[string]docker.AuthConfiguration) func() {
+	old := dockerAuthConfigurations
+	dockerAuthConfigurations = func() (*docker.AuthConfigurations, error) {
+		return &docker.AuthConfigurations{Configs: configs}, nil
+	}
+	return func() {
+		dockerAuthConfigurations = old
+	}
+}
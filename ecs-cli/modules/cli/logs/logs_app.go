@@ -21,6 +21,7 @@ import (
 	ecsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
@@ -133,12 +134,19 @@ func logs(context *cli.Context, input *cloudwatchlogs.FilterLogEventsInput, cwLo
 		return err
 	}
 
+	// following the logs stops once the --timeout of the command expires
+	ctx := utils.CommandContext(context)
 	for context.Bool(flags.FollowLogsFlag) {
-		time.Sleep(followLogsWaitTime * time.Second)
+		if err := (&utils.TimeSleeper{}).Sleep(ctx, followLogsWaitTime*time.Second); err != nil {
+			return nil
+		}
 		if lastEvent != nil {
 			input.SetStartTime(aws.Int64Value(lastEvent.Timestamp) + 1)
 		}
 		lastEvent, err = printLogEvents(context, input, cwLogsClient)
+		if utils.IsInterrupted(err) {
+			return nil
+		}
 		if err != nil {
 			return err
 		}
@@ -306,8 +314,9 @@ func logConfigMisMatchError(taskDef *ecs.TaskDefinition, fieldName string) error
 
 /* Create Logs */
 
-// CreateLogGroups creates any needed log groups for the task definition to use CloudWatch Logs
-func CreateLogGroups(taskDef *ecs.TaskDefinition, logClientFactory cwlogsclient.LogClientFactory) error {
+// CreateLogGroups creates any needed log groups for the task definition to use CloudWatch Logs.
+// If retentionInDays is set, the log groups that are created expire their events after that many days.
+func CreateLogGroups(taskDef *ecs.TaskDefinition, retentionInDays int64, logClientFactory cwlogsclient.LogClientFactory) error {
 	logGroupsCreated := false
 	for _, container := range taskDef.ContainerDefinitions {
 		if container.LogConfiguration == nil || container.LogConfiguration.LogDriver == nil || aws.StringValue(container.LogConfiguration.LogDriver) != "awslogs" {
@@ -333,6 +342,13 @@ func CreateLogGroups(taskDef *ecs.TaskDefinition, logClientFactory cwlogsclient.
 		logrus.Infof("Created Log Group %s in %s", aws.StringValue(logConfig.logGroup), region)
 		logGroupsCreated = true
 
+		if retentionInDays > 0 {
+			if err := client.PutRetentionPolicy(logConfig.logGroup, retentionInDays); err != nil {
+				return err
+			}
+			logrus.Infof("Set retention of Log Group %s to %d days", aws.StringValue(logConfig.logGroup), retentionInDays)
+		}
+
 	}
 	if !logGroupsCreated {
 		logrus.Warnf("No log groups to create; no containers use 'awslogs'")
@@ -14,14 +14,17 @@
 package logs
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs/mock"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/ecs/mock"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/config"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -397,6 +400,24 @@ func TestLogsRequestTaskNotFound(t *testing.T) {
 
 /* Create Logs */
 
+func TestLogsFollowStopsWhenCommandTimesOut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogClient := mock_cloudwatchlogs.NewMockClient(ctrl)
+	mockLogClient.EXPECT().FilterAllLogEvents(gomock.Any(), gomock.Any()).Return(nil)
+
+	flagSet := flag.NewFlagSet("ecs-cli-logs", 0)
+	flagSet.Bool(flags.FollowLogsFlag, true, "")
+	app := cli.NewApp()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	<-ctx.Done()
+	utils.SetCommandContext(app, ctx)
+
+	err := logs(cli.NewContext(app, flagSet, nil), &cloudwatchlogs.FilterLogEventsInput{}, mockLogClient)
+	assert.NoError(t, err, "Expected following the logs to stop without error once the command times out")
+}
+
 func TestCreateLogGroups(t *testing.T) {
 	taskDef := dummyTaskDef([]*ecs.ContainerDefinition{
 		dummyContainerDef(logRegion1, logGroup1, logPrefix1, "awslogs", containerName, containerImage),
@@ -412,7 +433,7 @@ func TestCreateLogGroups(t *testing.T) {
 		mockLogClient.EXPECT().CreateLogGroup(gomock.Any()),
 	)
 
-	err := CreateLogGroups(taskDef, mockLogFactory)
+	err := CreateLogGroups(taskDef, 0, mockLogFactory)
 	assert.NoError(t, err, "Unexpected error in call to CreateLogGroups()")
 }
 
@@ -434,7 +455,49 @@ func TestCreateLogGroupsTwoContainers(t *testing.T) {
 		mockLogClient.EXPECT().CreateLogGroup(gomock.Any()),
 	)
 
-	err := CreateLogGroups(taskDef, mockLogFactory)
+	err := CreateLogGroups(taskDef, 0, mockLogFactory)
+	assert.NoError(t, err, "Unexpected error in call to CreateLogGroups()")
+}
+
+func TestCreateLogGroupsWithRetention(t *testing.T) {
+	taskDef := dummyTaskDef([]*ecs.ContainerDefinition{
+		dummyContainerDef(logRegion1, logGroup1, logPrefix1, "awslogs", containerName, containerImage),
+	})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogFactory := mock_cloudwatchlogs.NewMockLogClientFactory(ctrl)
+	mockLogClient := mock_cloudwatchlogs.NewMockClient(ctrl)
+
+	gomock.InOrder(
+		mockLogFactory.EXPECT().Get(logRegion1).Return(mockLogClient),
+		mockLogClient.EXPECT().CreateLogGroup(aws.String(logGroup1)),
+		mockLogClient.EXPECT().PutRetentionPolicy(aws.String(logGroup1), int64(14)),
+	)
+
+	err := CreateLogGroups(taskDef, 14, mockLogFactory)
+	assert.NoError(t, err, "Unexpected error in call to CreateLogGroups()")
+}
+
+func TestCreateLogGroupsWithRetentionLogGroupAlreadyExists(t *testing.T) {
+	taskDef := dummyTaskDef([]*ecs.ContainerDefinition{
+		dummyContainerDef(logRegion1, logGroup1, logPrefix1, "awslogs", containerName, containerImage),
+	})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogFactory := mock_cloudwatchlogs.NewMockLogClientFactory(ctrl)
+	mockLogClient := mock_cloudwatchlogs.NewMockClient(ctrl)
+
+	alreadyExistsErr := awserr.New(cloudwatchlogs.ErrCodeResourceAlreadyExistsException, "Resource Already Exists Exception", nil)
+
+	// the retention of existing log groups is left unchanged
+	gomock.InOrder(
+		mockLogFactory.EXPECT().Get(logRegion1).Return(mockLogClient),
+		mockLogClient.EXPECT().CreateLogGroup(gomock.Any()).Return(alreadyExistsErr),
+	)
+
+	err := CreateLogGroups(taskDef, 14, mockLogFactory)
 	assert.NoError(t, err, "Unexpected error in call to CreateLogGroups()")
 }
 
@@ -454,7 +517,7 @@ func TestCreateLogGroupsOneContainerWrongDriver(t *testing.T) {
 		mockLogClient.EXPECT().CreateLogGroup(gomock.Any()),
 	)
 
-	err := CreateLogGroups(taskDef, mockLogFactory)
+	err := CreateLogGroups(taskDef, 0, mockLogFactory)
 	assert.NoError(t, err, "Unexpected error in call to CreateLogGroups()")
 }
 
@@ -475,7 +538,7 @@ func TestCreateLogGroupsLogGroupAlreadyExists(t *testing.T) {
 		mockLogClient.EXPECT().CreateLogGroup(gomock.Any()).Return(alreadyExistsErr),
 	)
 
-	err := CreateLogGroups(taskDef, mockLogFactory)
+	err := CreateLogGroups(taskDef, 0, mockLogFactory)
 	assert.NoError(t, err, "Unexpected error in call to CreateLogGroups()")
 }
 
@@ -496,7 +559,7 @@ func TestCreateLogGroupsErrorCase(t *testing.T) {
 		mockLogClient.EXPECT().CreateLogGroup(gomock.Any()).Return(someErr),
 	)
 
-	err := CreateLogGroups(taskDef, mockLogFactory)
+	err := CreateLogGroups(taskDef, 0, mockLogFactory)
 	assert.Error(t, err, "Expected error in call to CreateLogGroups()")
 	assert.Equal(t, clientErrorMesssage, err.Error())
 }
@@ -0,0 +1,84 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package logs
+
+import (
+	"fmt"
+	"io"
+
+	cwlogsclient "github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+// TaskLogs prints the CloudWatch log events of all the containers of a task as they are logged
+type TaskLogs struct {
+	client     cwlogsclient.Client
+	input      *cloudwatchlogs.FilterLogEventsInput
+	containers map[string]string // container names by log stream
+	out        io.Writer
+}
+
+// NewTaskLogs returns TaskLogs for the awslogs streams of the containers of the task. All the
+// containers must log to the same log group with a stream prefix.
+func NewTaskLogs(taskDef *ecs.TaskDefinition, taskID string, logClientFactory cwlogsclient.LogClientFactory, out io.Writer) (*TaskLogs, error) {
+	logConfig, err := getLogConfiguration(taskDef, taskID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	containers := make(map[string]string)
+	var streams []string
+	for containerName, prefix := range logConfig.logPrefixes {
+		stream := aws.StringValue(prefix) + "/" + aws.StringValue(containerName) + "/" + taskID
+		containers[stream] = aws.StringValue(containerName)
+		streams = append(streams, stream)
+	}
+
+	input := &cloudwatchlogs.FilterLogEventsInput{}
+	input.SetLogGroupName(aws.StringValue(logConfig.logGroup))
+	input.SetLogStreamNames(aws.StringSlice(streams))
+
+	return &TaskLogs{
+		client:     logClientFactory.Get(aws.StringValue(logConfig.logRegion)),
+		input:      input,
+		containers: containers,
+		out:        out,
+	}, nil
+}
+
+// Print prints the log events logged since it was last called, prefixed with the name of their container
+func (l *TaskLogs) Print() error {
+	var lastEvent *cloudwatchlogs.FilteredLogEvent
+	err := l.client.FilterAllLogEvents(l.input, func(events []*cloudwatchlogs.FilteredLogEvent) {
+		for _, event := range events {
+			lastEvent = event
+			fmt.Fprintf(l.out, "%s | %s\n", l.containers[aws.StringValue(event.LogStreamName)], aws.StringValue(event.Message))
+		}
+	})
+	if err != nil {
+		// the log streams are created once the containers log something
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == cloudwatchlogs.ErrCodeResourceNotFoundException {
+			return nil
+		}
+		return err
+	}
+
+	if lastEvent != nil {
+		l.input.SetStartTime(aws.Int64Value(lastEvent.Timestamp) + 1)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package logs
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/aws/cloudwatchlogs/mock"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskLogsPrint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogsFactory := mock_cloudwatchlogs.NewMockLogClientFactory(ctrl)
+	mockLogs := mock_cloudwatchlogs.NewMockClient(ctrl)
+
+	taskDef := dummyTaskDef([]*ecs.ContainerDefinition{
+		dummyContainerDef(logRegion1, logGroup1, logPrefix1, "awslogs", containerName, containerImage),
+		dummyContainerDef(logRegion1, logGroup1, logPrefix2, "awslogs", containerName2, containerImage2),
+	})
+	stream1 := logPrefix1 + "/" + containerName + "/" + taskID
+	stream2 := logPrefix2 + "/" + containerName2 + "/" + taskID
+
+	gomock.InOrder(
+		mockLogsFactory.EXPECT().Get(logRegion1).Return(mockLogs),
+		mockLogs.EXPECT().FilterAllLogEvents(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
+			input := x.(*cloudwatchlogs.FilterLogEventsInput)
+			assert.Equal(t, logGroup1, aws.StringValue(input.LogGroupName))
+			assert.ElementsMatch(t, []string{stream1, stream2}, aws.StringValueSlice(input.LogStreamNames))
+			assert.Nil(t, input.StartTime)
+			action := y.(func([]*cloudwatchlogs.FilteredLogEvent))
+			action([]*cloudwatchlogs.FilteredLogEvent{
+				{LogStreamName: aws.String(stream1), Message: aws.String("migrating"), Timestamp: aws.Int64(100)},
+				{LogStreamName: aws.String(stream2), Message: aws.String("ready"), Timestamp: aws.Int64(200)},
+			})
+		}).Return(nil),
+		mockLogs.EXPECT().FilterAllLogEvents(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
+			input := x.(*cloudwatchlogs.FilterLogEventsInput)
+			assert.Equal(t, int64(201), aws.Int64Value(input.StartTime), "Expected only the events logged since the last one")
+		}).Return(nil),
+	)
+
+	out := &bytes.Buffer{}
+	taskLogs, err := NewTaskLogs(taskDef, taskID, mockLogsFactory, out)
+	assert.NoError(t, err, "Unexpected error creating task logs")
+	assert.NoError(t, taskLogs.Print(), "Unexpected error printing task logs")
+	assert.NoError(t, taskLogs.Print(), "Unexpected error printing task logs")
+	assert.Equal(t, "wordpress | migrating\nmysql | ready\n", out.String())
+}
+
+func TestTaskLogsPrintBeforeStreamsExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogsFactory := mock_cloudwatchlogs.NewMockLogClientFactory(ctrl)
+	mockLogs := mock_cloudwatchlogs.NewMockClient(ctrl)
+
+	taskDef := dummyTaskDef([]*ecs.ContainerDefinition{dummyContainerDefFromLogOptions(logRegion1, logGroup1, logPrefix1)})
+	mockLogsFactory.EXPECT().Get(logRegion1).Return(mockLogs)
+	mockLogs.EXPECT().FilterAllLogEvents(gomock.Any(), gomock.Any()).Return(
+		awserr.New(cloudwatchlogs.ErrCodeResourceNotFoundException, "The specified log stream does not exist.", nil))
+
+	taskLogs, err := NewTaskLogs(taskDef, taskID, mockLogsFactory, &bytes.Buffer{})
+	assert.NoError(t, err, "Unexpected error creating task logs")
+	assert.NoError(t, taskLogs.Print(), "Expected no error before the log streams exist")
+}
+
+func TestTaskLogsPrintError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogsFactory := mock_cloudwatchlogs.NewMockLogClientFactory(ctrl)
+	mockLogs := mock_cloudwatchlogs.NewMockClient(ctrl)
+
+	taskDef := dummyTaskDef([]*ecs.ContainerDefinition{dummyContainerDefFromLogOptions(logRegion1, logGroup1, logPrefix1)})
+	mockLogsFactory.EXPECT().Get(logRegion1).Return(mockLogs)
+	mockLogs.EXPECT().FilterAllLogEvents(gomock.Any(), gomock.Any()).Return(errors.New(clientErrorMesssage))
+
+	taskLogs, err := NewTaskLogs(taskDef, taskID, mockLogsFactory, &bytes.Buffer{})
+	assert.NoError(t, err, "Unexpected error creating task logs")
+	assert.Error(t, taskLogs.Print(), "Expected error printing task logs")
+}
+
+func TestNewTaskLogsWithoutAWSLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogsFactory := mock_cloudwatchlogs.NewMockLogClientFactory(ctrl)
+
+	taskDef := dummyTaskDef([]*ecs.ContainerDefinition{
+		dummyContainerDef(logRegion1, logGroup1, logPrefix1, "json-file", containerName, containerImage),
+	})
+
+	_, err := NewTaskLogs(taskDef, taskID, mockLogsFactory, &bytes.Buffer{})
+	assert.Error(t, err, "Expected error when the container does not use awslogs")
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -136,7 +137,7 @@ func (c *cloudformationClient) CreateStack(template, stackName string, capabilit
 	if len(tags) > 0 {
 		input.Tags = tags
 	}
-	output, err := c.client.CreateStack(input)
+	output, err := c.client.CreateStackWithContext(c.config.Context(), input)
 
 	if err != nil {
 		return "", err
@@ -148,7 +149,7 @@ func (c *cloudformationClient) CreateStack(template, stackName string, capabilit
 
 // DeleteStack deletes the cloudformation stack.
 func (c *cloudformationClient) DeleteStack(stackName string) error {
-	_, err := c.client.DeleteStack(&cloudformation.DeleteStackInput{
+	_, err := c.client.DeleteStackWithContext(c.config.Context(), &cloudformation.DeleteStackInput{
 		StackName: aws.String(stackName),
 	})
 
@@ -157,14 +158,14 @@ func (c *cloudformationClient) DeleteStack(stackName string) error {
 
 // DescribeStacks describes a CFN stack
 func (c *cloudformationClient) DescribeStacks(stackName string) (*cloudformation.DescribeStacksOutput, error) {
-	return c.client.DescribeStacks(&cloudformation.DescribeStacksInput{
+	return c.client.DescribeStacksWithContext(c.config.Context(), &cloudformation.DescribeStacksInput{
 		StackName: aws.String(stackName),
 	})
 }
 
 // UpdateStack creates the cloudformation stack by invoking the sdk's UpdateStack API.
 func (c *cloudformationClient) UpdateStack(stackName string, params *CfnStackParams) (string, error) {
-	output, err := c.client.UpdateStack(&cloudformation.UpdateStackInput{
+	output, err := c.client.UpdateStackWithContext(c.config.Context(), &cloudformation.UpdateStackInput{
 		Capabilities:        aws.StringSlice([]string{cloudformation.CapabilityCapabilityIam}),
 		StackName:           aws.String(stackName),
 		Parameters:          params.Get(),
@@ -187,7 +188,7 @@ func (c *cloudformationClient) ValidateStackExists(stackName string) error {
 
 // describeStack describes the stack and gets the stack status.
 func (c *cloudformationClient) GetStackParameters(stackName string) ([]*cloudformation.Parameter, error) {
-	output, err := c.client.DescribeStacks(&cloudformation.DescribeStacksInput{
+	output, err := c.client.DescribeStacksWithContext(c.config.Context(), &cloudformation.DescribeStacksInput{
 		StackName: aws.String(stackName),
 	})
 
@@ -232,8 +233,12 @@ func (c *cloudformationClient) WaitUntilUpdateComplete(stackName string) error {
 // stack event's status indicates failure in creating/updating/deleting a resource.
 type failureInStackEvent func(*cloudformation.StackEvent) bool
 
-// waitUntilComplete waits until the function callback indicates completeness or until maxRetries are exhausted.
+// waitUntilComplete waits until the function callback indicates completeness or until maxRetries are exhausted,
+// or until the wait is interrupted.
 func (c *cloudformationClient) waitUntilComplete(stackName string, hasFailed failureInStackEvent, successState string, failureStates map[string]bool, maxRetries int) error {
+	ctx, cancel := utils.WithInterrupt(c.config.Context())
+	defer cancel()
+
 	for retryCount := 0; retryCount < maxRetries; retryCount++ {
 		event, err := c.latestStackEvent(stackName)
 		if err != nil {
@@ -271,7 +276,13 @@ func (c *cloudformationClient) waitUntilComplete(stackName string, hasFailed fai
 		} else {
 			log.WithFields(log.Fields{"stackStatus": status}).Debug("Cloudformation stack status")
 		}
-		c.sleeper.Sleep(delayWait)
+		if err := c.sleeper.Sleep(ctx, delayWait); err != nil {
+			log.WithFields(log.Fields{
+				"stackName":   stackName,
+				"stackStatus": status,
+			}).Warn("Stopped waiting for the Cloudformation stack, the stack operation continues in the background")
+			return errors.Wrapf(err, "Stopped waiting for stack '%s' in state '%s'", stackName, status)
+		}
 	}
 
 	return fmt.Errorf("Timeout waiting for stack operation to complete")
@@ -279,7 +290,7 @@ func (c *cloudformationClient) waitUntilComplete(stackName string, hasFailed fai
 
 // latestStackEvent describes stack events and gets the latest event.
 func (c *cloudformationClient) latestStackEvent(stackName string) (*cloudformation.StackEvent, error) {
-	response, err := c.client.DescribeStackEvents(&cloudformation.DescribeStackEventsInput{StackName: aws.String(stackName)})
+	response, err := c.client.DescribeStackEventsWithContext(c.config.Context(), &cloudformation.DescribeStackEventsInput{StackName: aws.String(stackName)})
 	if err != nil {
 		return nil, err
 	}
@@ -293,7 +304,7 @@ func (c *cloudformationClient) latestStackEvent(stackName string) (*cloudformati
 
 // firstStackEventWithFailure describes stack events and gets the latest event.
 func (c *cloudformationClient) firstStackEventWithFailure(stackName string, nextToken *string, failureStates map[string]bool) (*cloudformation.StackEvent, error) {
-	response, err := c.client.DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+	response, err := c.client.DescribeStackEventsWithContext(c.config.Context(), &cloudformation.DescribeStackEventsInput{
 		StackName: aws.String(stackName),
 		NextToken: nextToken,
 	})
@@ -346,7 +357,7 @@ func (c *cloudformationClient) describeStackResource(stackName string, logicalRe
 		LogicalResourceId: aws.String(logicalResourceId),
 	}
 
-	output, err := c.client.DescribeStackResources(input)
+	output, err := c.client.DescribeStackResourcesWithContext(c.config.Context(), input)
 
 	if err != nil {
 		return nil, err
@@ -14,7 +14,7 @@
 package cloudformation
 
 import (
-	"errors"
+	"context"
 	"testing"
 	"time"
 
@@ -24,12 +24,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
 type noopsleeper struct{}
 
-func (s *noopsleeper) Sleep(d time.Duration) {
+func (s *noopsleeper) Sleep(ctx context.Context, d time.Duration) error {
+	return ctx.Err()
 }
 
 func createStackEvent(status string) *cloudformation.DescribeStackEventsOutput {
@@ -64,8 +66,8 @@ func TestWaitUntilCreateCompletes(t *testing.T) {
 	defer ctrl.Finish()
 
 	eventCreateComplete := createStackEvent(cloudformation.ResourceStatusCreateComplete)
-	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Return(eventCreateComplete, nil)
-	mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusCreateComplete), nil)
+	mockCfn.EXPECT().DescribeStackEventsWithContext(gomock.Any(), gomock.Any()).Return(eventCreateComplete, nil)
+	mockCfn.EXPECT().DescribeStacksWithContext(gomock.Any(), gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusCreateComplete), nil)
 	err := cfnClient.WaitUntilCreateComplete("")
 	if err != nil {
 		t.Error("Error waiting for create completion:", err)
@@ -77,10 +79,10 @@ func TestWaitUntilCreateCompleteFails(t *testing.T) {
 	defer ctrl.Finish()
 
 	eventCreateInProgress := createStackEvent(cloudformation.ResourceStatusCreateInProgress)
-	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Return(eventCreateInProgress, nil)
-	mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusCreateInProgress), nil)
+	mockCfn.EXPECT().DescribeStackEventsWithContext(gomock.Any(), gomock.Any()).Return(eventCreateInProgress, nil)
+	mockCfn.EXPECT().DescribeStacksWithContext(gomock.Any(), gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusCreateInProgress), nil)
 	eventCreateFailed := createStackEvent(cloudformation.ResourceStatusCreateFailed)
-	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Return(eventCreateFailed, nil)
+	mockCfn.EXPECT().DescribeStackEventsWithContext(gomock.Any(), gomock.Any()).Return(eventCreateFailed, nil)
 
 	err := cfnClient.WaitUntilCreateComplete("")
 	if err == nil {
@@ -93,8 +95,8 @@ func TestWaitUntilDeleteCompletes(t *testing.T) {
 	defer ctrl.Finish()
 
 	eventDeleteComplete := createStackEvent(cloudformation.ResourceStatusDeleteComplete)
-	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Return(eventDeleteComplete, nil)
-	mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusDeleteComplete), nil)
+	mockCfn.EXPECT().DescribeStackEventsWithContext(gomock.Any(), gomock.Any()).Return(eventDeleteComplete, nil)
+	mockCfn.EXPECT().DescribeStacksWithContext(gomock.Any(), gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusDeleteComplete), nil)
 	err := cfnClient.WaitUntilDeleteComplete("")
 	if err != nil {
 		t.Error("Error waiting for create completion:", err)
@@ -106,10 +108,10 @@ func TestWaitUntilDeleteCompleteFails(t *testing.T) {
 	defer ctrl.Finish()
 
 	eventDeleteInProgress := createStackEvent(cloudformation.ResourceStatusDeleteInProgress)
-	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Return(eventDeleteInProgress, nil)
-	mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusDeleteInProgress), nil)
+	mockCfn.EXPECT().DescribeStackEventsWithContext(gomock.Any(), gomock.Any()).Return(eventDeleteInProgress, nil)
+	mockCfn.EXPECT().DescribeStacksWithContext(gomock.Any(), gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusDeleteInProgress), nil)
 	eventDeleteFailed := createStackEvent(cloudformation.ResourceStatusDeleteFailed)
-	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Return(eventDeleteFailed, nil)
+	mockCfn.EXPECT().DescribeStackEventsWithContext(gomock.Any(), gomock.Any()).Return(eventDeleteFailed, nil)
 
 	err := cfnClient.WaitUntilDeleteComplete("")
 	if err == nil {
@@ -122,11 +124,11 @@ func TestWaitUntilUpdateCompletes(t *testing.T) {
 	defer ctrl.Finish()
 
 	eventInProgress := createStackEvent(cloudformation.ResourceStatusUpdateInProgress)
-	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Return(eventInProgress, nil)
-	mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusUpdateInProgress), nil)
+	mockCfn.EXPECT().DescribeStackEventsWithContext(gomock.Any(), gomock.Any()).Return(eventInProgress, nil)
+	mockCfn.EXPECT().DescribeStacksWithContext(gomock.Any(), gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusUpdateInProgress), nil)
 	eventUpdateComplete := createStackEvent(cloudformation.ResourceStatusUpdateComplete)
-	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Return(eventUpdateComplete, nil)
-	mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusUpdateComplete), nil)
+	mockCfn.EXPECT().DescribeStackEventsWithContext(gomock.Any(), gomock.Any()).Return(eventUpdateComplete, nil)
+	mockCfn.EXPECT().DescribeStacksWithContext(gomock.Any(), gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusUpdateComplete), nil)
 	err := cfnClient.WaitUntilUpdateComplete("")
 	if err != nil {
 		t.Error("Error waiting for update completion:", err)
@@ -138,10 +140,10 @@ func TestWaitUntilUpdateCompleteFails(t *testing.T) {
 	defer ctrl.Finish()
 
 	eventInProgress := createStackEvent(cloudformation.ResourceStatusUpdateInProgress)
-	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Return(eventInProgress, nil)
-	mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusUpdateInProgress), nil)
+	mockCfn.EXPECT().DescribeStackEventsWithContext(gomock.Any(), gomock.Any()).Return(eventInProgress, nil)
+	mockCfn.EXPECT().DescribeStacksWithContext(gomock.Any(), gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusUpdateInProgress), nil)
 	eventUpdateFailed := createStackEvent(cloudformation.ResourceStatusUpdateFailed)
-	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).Return(eventUpdateFailed, nil)
+	mockCfn.EXPECT().DescribeStackEventsWithContext(gomock.Any(), gomock.Any()).Return(eventUpdateFailed, nil)
 
 	err := cfnClient.WaitUntilUpdateComplete("")
 	if err == nil {
@@ -153,7 +155,7 @@ func TestWaitDescribeEventsError(t *testing.T) {
 	mockCfn, cfnClient, ctrl := setupTestController(t)
 	defer ctrl.Finish()
 
-	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).AnyTimes().Return(nil, errors.New(""))
+	mockCfn.EXPECT().DescribeStackEventsWithContext(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, errors.New(""))
 
 	err := cfnClient.(*cloudformationClient).waitUntilComplete("", failureInCreateEvent, "", createStackFailures, 10)
 	if err == nil {
@@ -176,8 +178,8 @@ func TestWaitExhaustRetries(t *testing.T) {
 	defer ctrl.Finish()
 
 	eventCreateInProgress := createStackEvent(cloudformation.ResourceStatusCreateInProgress)
-	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).AnyTimes().Return(eventCreateInProgress, nil)
-	mockCfn.EXPECT().DescribeStacks(gomock.Any()).AnyTimes().Return(createDescribeStacksOutput(cloudformation.StackStatusCreateInProgress), nil)
+	mockCfn.EXPECT().DescribeStackEventsWithContext(gomock.Any(), gomock.Any()).AnyTimes().Return(eventCreateInProgress, nil)
+	mockCfn.EXPECT().DescribeStacksWithContext(gomock.Any(), gomock.Any()).AnyTimes().Return(createDescribeStacksOutput(cloudformation.StackStatusCreateInProgress), nil)
 
 	err := cfnClient.(*cloudformationClient).waitUntilComplete("", failureInCreateEvent, "", createStackFailures, 10)
 	if err == nil {
@@ -195,6 +197,24 @@ func TestWaitExhaustRetries(t *testing.T) {
 	}
 }
 
+func TestWaitStopsWhenContextIsCancelled(t *testing.T) {
+	mockCfn, cfnClient, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cfnClient.(*cloudformationClient).config.Ctx = ctx
+
+	eventCreateInProgress := createStackEvent(cloudformation.ResourceStatusCreateInProgress)
+	mockCfn.EXPECT().DescribeStackEventsWithContext(ctx, gomock.Any()).Return(eventCreateInProgress, nil)
+	mockCfn.EXPECT().DescribeStacksWithContext(ctx, gomock.Any()).Do(func(_, _ interface{}) {
+		cancel()
+	}).Return(createDescribeStacksOutput(cloudformation.StackStatusCreateInProgress), nil)
+
+	err := cfnClient.(*cloudformationClient).waitUntilComplete("stack", failureInCreateEvent, cloudformation.StackStatusCreateComplete, createStackFailures, 10)
+	assert.Error(t, err, "Expected error waiting for create completion")
+	assert.Equal(t, context.Canceled, errors.Cause(err), "Expected the wait to stop because the context was cancelled")
+}
+
 func TestWaitDescribeStackFailure(t *testing.T) {
 	mockCfn, cfnClient, ctrl := setupTestController(t)
 	defer ctrl.Finish()
@@ -214,8 +234,8 @@ func TestWaitDescribeStackFailure(t *testing.T) {
 	eventsWithFailure.StackEvents = append(eventsWithFailure.StackEvents, &cloudformation.StackEvent{
 		ResourceStatus: aws.String(cloudformation.ResourceStatusCreateInProgress),
 	})
-	mockCfn.EXPECT().DescribeStackEvents(gomock.Any()).AnyTimes().Return(eventsWithFailure, nil)
-	mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusCreateFailed), nil)
+	mockCfn.EXPECT().DescribeStackEventsWithContext(gomock.Any(), gomock.Any()).AnyTimes().Return(eventsWithFailure, nil)
+	mockCfn.EXPECT().DescribeStacksWithContext(gomock.Any(), gomock.Any()).Return(createDescribeStacksOutput(cloudformation.StackStatusCreateFailed), nil)
 
 	err := cfnClient.(*cloudformationClient).waitUntilComplete("", failureInCreateEvent, "", createStackFailures, 10)
 	if err == nil {
@@ -287,13 +307,13 @@ func TestValidateStackExists(t *testing.T) {
 	mockCfn, cfnClient, ctrl := setupTestController(t)
 	defer ctrl.Finish()
 
-	mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errors.New("describe-stacks error"))
+	mockCfn.EXPECT().DescribeStacksWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("describe-stacks error"))
 	err := cfnClient.ValidateStackExists("")
 	if err == nil {
 		t.Error("Expected error validating if stack exists")
 	}
 
-	mockCfn.EXPECT().DescribeStacks(gomock.Any()).Return(createDescribeStacksOutput(""), nil)
+	mockCfn.EXPECT().DescribeStacksWithContext(gomock.Any(), gomock.Any()).Return(createDescribeStacksOutput(""), nil)
 	err = cfnClient.ValidateStackExists("")
 	if err != nil {
 		t.Error("Unexpected error validating if stack exists", err)
@@ -304,10 +324,10 @@ func TestDescribeNetworkResources(t *testing.T) {
 	mockCfn, cfnClient, ctrl := setupTestController(t)
 	defer ctrl.Finish()
 
-	mockCfn.EXPECT().DescribeStackResources(gomock.Any()).Return(describeStackResourceOutput(VPCLogicalResourceId, "vpc-feedface"), nil)
-	mockCfn.EXPECT().DescribeStackResources(gomock.Any()).Return(describeStackResourceOutput(SecurityGroupLogicalResourceId, "sg-c0ffeefe"), nil)
-	mockCfn.EXPECT().DescribeStackResources(gomock.Any()).Return(describeStackResourceOutput(Subnet1LogicalResourceId, "subnet-baff1ed"), nil)
-	mockCfn.EXPECT().DescribeStackResources(gomock.Any()).Return(describeStackResourceOutput(Subnet2LogicalResourceId, "subnet-baff2ed"), nil)
+	mockCfn.EXPECT().DescribeStackResourcesWithContext(gomock.Any(), gomock.Any()).Return(describeStackResourceOutput(VPCLogicalResourceId, "vpc-feedface"), nil)
+	mockCfn.EXPECT().DescribeStackResourcesWithContext(gomock.Any(), gomock.Any()).Return(describeStackResourceOutput(SecurityGroupLogicalResourceId, "sg-c0ffeefe"), nil)
+	mockCfn.EXPECT().DescribeStackResourcesWithContext(gomock.Any(), gomock.Any()).Return(describeStackResourceOutput(Subnet1LogicalResourceId, "subnet-baff1ed"), nil)
+	mockCfn.EXPECT().DescribeStackResourcesWithContext(gomock.Any(), gomock.Any()).Return(describeStackResourceOutput(Subnet2LogicalResourceId, "subnet-baff2ed"), nil)
 
 	err := cfnClient.DescribeNetworkResources("myStack")
 	if err != nil {
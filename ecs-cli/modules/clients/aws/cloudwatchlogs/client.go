@@ -25,11 +25,13 @@ import (
 type Client interface {
 	FilterAllLogEvents(*cloudwatchlogs.FilterLogEventsInput, func([]*cloudwatchlogs.FilteredLogEvent)) error
 	CreateLogGroup(*string) error
+	PutRetentionPolicy(*string, int64) error
 }
 
 // ec2Client implements EC2Client
 type cwLogsClient struct {
 	client cloudwatchlogsiface.CloudWatchLogsAPI
+	config *config.CommandConfig
 }
 
 // NewCloudWatchLogsClient creates an instance of ec2Client object.
@@ -39,11 +41,12 @@ func NewCloudWatchLogsClient(params *config.CommandConfig, logRegion string) Cli
 	client.Handlers.Build.PushBackNamed(clients.CustomUserAgentHandler())
 	return &cwLogsClient{
 		client: client,
+		config: params,
 	}
 }
 
 func (c *cwLogsClient) FilterAllLogEvents(input *cloudwatchlogs.FilterLogEventsInput, action func([]*cloudwatchlogs.FilteredLogEvent)) error {
-	err := c.client.FilterLogEventsPages(input,
+	err := c.client.FilterLogEventsPagesWithContext(c.config.Context(), input,
 		func(page *cloudwatchlogs.FilterLogEventsOutput, lastPage bool) bool {
 			action(page.Events)
 			return !lastPage
@@ -52,12 +55,20 @@ func (c *cwLogsClient) FilterAllLogEvents(input *cloudwatchlogs.FilterLogEventsI
 }
 
 func (c *cwLogsClient) CreateLogGroup(group *string) error {
-	_, err := c.client.CreateLogGroup(&cloudwatchlogs.CreateLogGroupInput{
+	_, err := c.client.CreateLogGroupWithContext(c.config.Context(), &cloudwatchlogs.CreateLogGroupInput{
 		LogGroupName: group,
 	})
 	return err
 }
 
+func (c *cwLogsClient) PutRetentionPolicy(group *string, retentionInDays int64) error {
+	_, err := c.client.PutRetentionPolicyWithContext(c.config.Context(), &cloudwatchlogs.PutRetentionPolicyInput{
+		LogGroupName:    group,
+		RetentionInDays: aws.Int64(retentionInDays),
+	})
+	return err
+}
+
 // LogClientFactory is a factory which creates log clients for a region
 type LogClientFactory interface {
 	Get(string) Client
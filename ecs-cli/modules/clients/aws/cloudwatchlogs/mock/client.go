@@ -70,3 +70,15 @@ func (m *MockClient) FilterAllLogEvents(arg0 *cloudwatchlogs.FilterLogEventsInpu
 func (mr *MockClientMockRecorder) FilterAllLogEvents(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterAllLogEvents", reflect.TypeOf((*MockClient)(nil).FilterAllLogEvents), arg0, arg1)
 }
+
+// PutRetentionPolicy mocks base method
+func (m *MockClient) PutRetentionPolicy(arg0 *string, arg1 int64) error {
+	ret := m.ctrl.Call(m, "PutRetentionPolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutRetentionPolicy indicates an expected call of PutRetentionPolicy
+func (mr *MockClientMockRecorder) PutRetentionPolicy(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRetentionPolicy", reflect.TypeOf((*MockClient)(nil).PutRetentionPolicy), arg0, arg1)
+}
@@ -14,6 +14,7 @@
 package ecr
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients"
@@ -44,6 +45,7 @@ type Client interface {
 	CreateRepository(repositoryName string) (string, error)
 	RepositoryExists(repositoryName string) bool
 	GetImages(repositoryNames []*string, tagStatus string, registryID string, processFn ProcessImageDetails) error
+	GetImageDigest(registryID, repositoryName, tag string) (string, error)
 }
 
 // ecrClient implements Client
@@ -197,6 +199,32 @@ func (c *ecrClient) GetImages(repositoryNames []*string, tagStatus string, regis
 	return err
 }
 
+// GetImageDigest returns the digest of the image with the given tag in a
+// repository. An empty registryID means the registry of the current account.
+func (c *ecrClient) GetImageDigest(registryID, repositoryName, tag string) (string, error) {
+	log.WithFields(log.Fields{
+		"repository": repositoryName,
+		"tag":        tag,
+	}).Debug("Getting image digest from ECR...")
+
+	input := &ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repositoryName),
+		ImageIds:       []*ecr.ImageIdentifier{{ImageTag: aws.String(tag)}},
+	}
+	if registryID != "" {
+		input.SetRegistryId(registryID)
+	}
+
+	resp, err := c.client.DescribeImages(input)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to describe image %s:%s", repositoryName, tag)
+	}
+	if len(resp.ImageDetails) == 0 || aws.StringValue(resp.ImageDetails[0].ImageDigest) == "" {
+		return "", fmt.Errorf("image %s:%s was not found", repositoryName, tag)
+	}
+	return aws.StringValue(resp.ImageDetails[0].ImageDigest), nil
+}
+
 func (c *ecrClient) describeRepositories(repositoryNames []*string, registryID string, outputFn ProcessRepositories) error {
 	var outErr error
 
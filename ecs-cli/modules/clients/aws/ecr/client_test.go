@@ -278,6 +278,44 @@ func TestDescribeImageErrorCase(t *testing.T) {
 	assert.Error(t, err, "Get Images should fail")
 }
 
+func TestGetImageDigest(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().DescribeImages(gomock.Any()).Do(func(input interface{}) {
+		req := input.(*ecr.DescribeImagesInput)
+		assert.Equal(t, registryID, aws.StringValue(req.RegistryId), "Expected registryID to match")
+		assert.Equal(t, repositoryName, aws.StringValue(req.RepositoryName), "Expected repositoryName to match")
+		assert.Equal(t, "v2", aws.StringValue(req.ImageIds[0].ImageTag), "Expected image tag to match")
+	}).Return(&ecr.DescribeImagesOutput{
+		ImageDetails: []*ecr.ImageDetail{{ImageDigest: aws.String(imageDigest)}},
+	}, nil)
+
+	digest, err := client.GetImageDigest(registryID, repositoryName, "v2")
+	assert.NoError(t, err, "GetImageDigest should not fail")
+	assert.Equal(t, imageDigest, digest, "Expected image digest to match")
+}
+
+func TestGetImageDigestImageNotFound(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().DescribeImages(gomock.Any()).Return(&ecr.DescribeImagesOutput{}, nil)
+
+	_, err := client.GetImageDigest("", repositoryName, "v2")
+	assert.Error(t, err, "Expected error when the image does not exist")
+}
+
+func TestGetImageDigestErrorCase(t *testing.T) {
+	mockEcr, _, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockEcr.EXPECT().DescribeImages(gomock.Any()).Return(nil, errors.New("something failed"))
+
+	_, err := client.GetImageDigest("", repositoryName, "v2")
+	assert.Error(t, err, "Expected error while GetImageDigest is called")
+}
+
 func setupTestController(t *testing.T) (*mock_ecriface.MockECRAPI, *mock_login.MockClient, Client, *gomock.Controller) {
 	ctrl := gomock.NewController(t)
 	mockEcr := mock_ecriface.NewMockECRAPI(ctrl)
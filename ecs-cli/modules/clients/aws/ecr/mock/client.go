@@ -86,6 +86,19 @@ func (mr *MockClientMockRecorder) GetAuthorizationTokenByID(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizationTokenByID", reflect.TypeOf((*MockClient)(nil).GetAuthorizationTokenByID), arg0)
 }
 
+// GetImageDigest mocks base method
+func (m *MockClient) GetImageDigest(arg0, arg1, arg2 string) (string, error) {
+	ret := m.ctrl.Call(m, "GetImageDigest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImageDigest indicates an expected call of GetImageDigest
+func (mr *MockClientMockRecorder) GetImageDigest(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageDigest", reflect.TypeOf((*MockClient)(nil).GetImageDigest), arg0, arg1, arg2)
+}
+
 // GetImages mocks base method
 func (m *MockClient) GetImages(arg0 []*string, arg1, arg2 string, arg3 ecr.ProcessImageDetails) error {
 	ret := m.ctrl.Call(m, "GetImages", arg0, arg1, arg2, arg3)
@@ -313,6 +313,50 @@ func (mr *MockECRAPIMockRecorder) DeleteLifecyclePolicyWithContext(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLifecyclePolicyWithContext", reflect.TypeOf((*MockECRAPI)(nil).DeleteLifecyclePolicyWithContext), varargs...)
 }
 
+// DeleteRegistryPolicy mocks base method
+func (m *MockECRAPI) DeleteRegistryPolicy(arg0 *ecr.DeleteRegistryPolicyInput) (*ecr.DeleteRegistryPolicyOutput, error) {
+	ret := m.ctrl.Call(m, "DeleteRegistryPolicy", arg0)
+	ret0, _ := ret[0].(*ecr.DeleteRegistryPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRegistryPolicy indicates an expected call of DeleteRegistryPolicy
+func (mr *MockECRAPIMockRecorder) DeleteRegistryPolicy(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRegistryPolicy", reflect.TypeOf((*MockECRAPI)(nil).DeleteRegistryPolicy), arg0)
+}
+
+// DeleteRegistryPolicyRequest mocks base method
+func (m *MockECRAPI) DeleteRegistryPolicyRequest(arg0 *ecr.DeleteRegistryPolicyInput) (*request.Request, *ecr.DeleteRegistryPolicyOutput) {
+	ret := m.ctrl.Call(m, "DeleteRegistryPolicyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.DeleteRegistryPolicyOutput)
+	return ret0, ret1
+}
+
+// DeleteRegistryPolicyRequest indicates an expected call of DeleteRegistryPolicyRequest
+func (mr *MockECRAPIMockRecorder) DeleteRegistryPolicyRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRegistryPolicyRequest", reflect.TypeOf((*MockECRAPI)(nil).DeleteRegistryPolicyRequest), arg0)
+}
+
+// DeleteRegistryPolicyWithContext mocks base method
+func (m *MockECRAPI) DeleteRegistryPolicyWithContext(arg0 context.Context, arg1 *ecr.DeleteRegistryPolicyInput, arg2 ...request.Option) (*ecr.DeleteRegistryPolicyOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRegistryPolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.DeleteRegistryPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRegistryPolicyWithContext indicates an expected call of DeleteRegistryPolicyWithContext
+func (mr *MockECRAPIMockRecorder) DeleteRegistryPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRegistryPolicyWithContext", reflect.TypeOf((*MockECRAPI)(nil).DeleteRegistryPolicyWithContext), varargs...)
+}
+
 // DeleteRepository mocks base method
 func (m *MockECRAPI) DeleteRepository(arg0 *ecr.DeleteRepositoryInput) (*ecr.DeleteRepositoryOutput, error) {
 	ret := m.ctrl.Call(m, "DeleteRepository", arg0)
@@ -401,6 +445,79 @@ func (mr *MockECRAPIMockRecorder) DeleteRepositoryWithContext(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepositoryWithContext", reflect.TypeOf((*MockECRAPI)(nil).DeleteRepositoryWithContext), varargs...)
 }
 
+// DescribeImageScanFindings mocks base method
+func (m *MockECRAPI) DescribeImageScanFindings(arg0 *ecr.DescribeImageScanFindingsInput) (*ecr.DescribeImageScanFindingsOutput, error) {
+	ret := m.ctrl.Call(m, "DescribeImageScanFindings", arg0)
+	ret0, _ := ret[0].(*ecr.DescribeImageScanFindingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeImageScanFindings indicates an expected call of DescribeImageScanFindings
+func (mr *MockECRAPIMockRecorder) DescribeImageScanFindings(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImageScanFindings", reflect.TypeOf((*MockECRAPI)(nil).DescribeImageScanFindings), arg0)
+}
+
+// DescribeImageScanFindingsPages mocks base method
+func (m *MockECRAPI) DescribeImageScanFindingsPages(arg0 *ecr.DescribeImageScanFindingsInput, arg1 func(*ecr.DescribeImageScanFindingsOutput, bool) bool) error {
+	ret := m.ctrl.Call(m, "DescribeImageScanFindingsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeImageScanFindingsPages indicates an expected call of DescribeImageScanFindingsPages
+func (mr *MockECRAPIMockRecorder) DescribeImageScanFindingsPages(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImageScanFindingsPages", reflect.TypeOf((*MockECRAPI)(nil).DescribeImageScanFindingsPages), arg0, arg1)
+}
+
+// DescribeImageScanFindingsPagesWithContext mocks base method
+func (m *MockECRAPI) DescribeImageScanFindingsPagesWithContext(arg0 context.Context, arg1 *ecr.DescribeImageScanFindingsInput, arg2 func(*ecr.DescribeImageScanFindingsOutput, bool) bool, arg3 ...request.Option) error {
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeImageScanFindingsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DescribeImageScanFindingsPagesWithContext indicates an expected call of DescribeImageScanFindingsPagesWithContext
+func (mr *MockECRAPIMockRecorder) DescribeImageScanFindingsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImageScanFindingsPagesWithContext", reflect.TypeOf((*MockECRAPI)(nil).DescribeImageScanFindingsPagesWithContext), varargs...)
+}
+
+// DescribeImageScanFindingsRequest mocks base method
+func (m *MockECRAPI) DescribeImageScanFindingsRequest(arg0 *ecr.DescribeImageScanFindingsInput) (*request.Request, *ecr.DescribeImageScanFindingsOutput) {
+	ret := m.ctrl.Call(m, "DescribeImageScanFindingsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.DescribeImageScanFindingsOutput)
+	return ret0, ret1
+}
+
+// DescribeImageScanFindingsRequest indicates an expected call of DescribeImageScanFindingsRequest
+func (mr *MockECRAPIMockRecorder) DescribeImageScanFindingsRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImageScanFindingsRequest", reflect.TypeOf((*MockECRAPI)(nil).DescribeImageScanFindingsRequest), arg0)
+}
+
+// DescribeImageScanFindingsWithContext mocks base method
+func (m *MockECRAPI) DescribeImageScanFindingsWithContext(arg0 context.Context, arg1 *ecr.DescribeImageScanFindingsInput, arg2 ...request.Option) (*ecr.DescribeImageScanFindingsOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeImageScanFindingsWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.DescribeImageScanFindingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeImageScanFindingsWithContext indicates an expected call of DescribeImageScanFindingsWithContext
+func (mr *MockECRAPIMockRecorder) DescribeImageScanFindingsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImageScanFindingsWithContext", reflect.TypeOf((*MockECRAPI)(nil).DescribeImageScanFindingsWithContext), varargs...)
+}
+
 // DescribeImages mocks base method
 func (m *MockECRAPI) DescribeImages(arg0 *ecr.DescribeImagesInput) (*ecr.DescribeImagesOutput, error) {
 	ret := m.ctrl.Call(m, "DescribeImages", arg0)
@@ -474,6 +591,50 @@ func (mr *MockECRAPIMockRecorder) DescribeImagesWithContext(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImagesWithContext", reflect.TypeOf((*MockECRAPI)(nil).DescribeImagesWithContext), varargs...)
 }
 
+// DescribeRegistry mocks base method
+func (m *MockECRAPI) DescribeRegistry(arg0 *ecr.DescribeRegistryInput) (*ecr.DescribeRegistryOutput, error) {
+	ret := m.ctrl.Call(m, "DescribeRegistry", arg0)
+	ret0, _ := ret[0].(*ecr.DescribeRegistryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRegistry indicates an expected call of DescribeRegistry
+func (mr *MockECRAPIMockRecorder) DescribeRegistry(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRegistry", reflect.TypeOf((*MockECRAPI)(nil).DescribeRegistry), arg0)
+}
+
+// DescribeRegistryRequest mocks base method
+func (m *MockECRAPI) DescribeRegistryRequest(arg0 *ecr.DescribeRegistryInput) (*request.Request, *ecr.DescribeRegistryOutput) {
+	ret := m.ctrl.Call(m, "DescribeRegistryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.DescribeRegistryOutput)
+	return ret0, ret1
+}
+
+// DescribeRegistryRequest indicates an expected call of DescribeRegistryRequest
+func (mr *MockECRAPIMockRecorder) DescribeRegistryRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRegistryRequest", reflect.TypeOf((*MockECRAPI)(nil).DescribeRegistryRequest), arg0)
+}
+
+// DescribeRegistryWithContext mocks base method
+func (m *MockECRAPI) DescribeRegistryWithContext(arg0 context.Context, arg1 *ecr.DescribeRegistryInput, arg2 ...request.Option) (*ecr.DescribeRegistryOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeRegistryWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.DescribeRegistryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRegistryWithContext indicates an expected call of DescribeRegistryWithContext
+func (mr *MockECRAPIMockRecorder) DescribeRegistryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRegistryWithContext", reflect.TypeOf((*MockECRAPI)(nil).DescribeRegistryWithContext), varargs...)
+}
+
 // DescribeRepositories mocks base method
 func (m *MockECRAPI) DescribeRepositories(arg0 *ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error) {
 	ret := m.ctrl.Call(m, "DescribeRepositories", arg0)
@@ -661,6 +822,35 @@ func (mr *MockECRAPIMockRecorder) GetLifecyclePolicyPreview(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLifecyclePolicyPreview", reflect.TypeOf((*MockECRAPI)(nil).GetLifecyclePolicyPreview), arg0)
 }
 
+// GetLifecyclePolicyPreviewPages mocks base method
+func (m *MockECRAPI) GetLifecyclePolicyPreviewPages(arg0 *ecr.GetLifecyclePolicyPreviewInput, arg1 func(*ecr.GetLifecyclePolicyPreviewOutput, bool) bool) error {
+	ret := m.ctrl.Call(m, "GetLifecyclePolicyPreviewPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetLifecyclePolicyPreviewPages indicates an expected call of GetLifecyclePolicyPreviewPages
+func (mr *MockECRAPIMockRecorder) GetLifecyclePolicyPreviewPages(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLifecyclePolicyPreviewPages", reflect.TypeOf((*MockECRAPI)(nil).GetLifecyclePolicyPreviewPages), arg0, arg1)
+}
+
+// GetLifecyclePolicyPreviewPagesWithContext mocks base method
+func (m *MockECRAPI) GetLifecyclePolicyPreviewPagesWithContext(arg0 context.Context, arg1 *ecr.GetLifecyclePolicyPreviewInput, arg2 func(*ecr.GetLifecyclePolicyPreviewOutput, bool) bool, arg3 ...request.Option) error {
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLifecyclePolicyPreviewPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetLifecyclePolicyPreviewPagesWithContext indicates an expected call of GetLifecyclePolicyPreviewPagesWithContext
+func (mr *MockECRAPIMockRecorder) GetLifecyclePolicyPreviewPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLifecyclePolicyPreviewPagesWithContext", reflect.TypeOf((*MockECRAPI)(nil).GetLifecyclePolicyPreviewPagesWithContext), varargs...)
+}
+
 // GetLifecyclePolicyPreviewRequest mocks base method
 func (m *MockECRAPI) GetLifecyclePolicyPreviewRequest(arg0 *ecr.GetLifecyclePolicyPreviewInput) (*request.Request, *ecr.GetLifecyclePolicyPreviewOutput) {
 	ret := m.ctrl.Call(m, "GetLifecyclePolicyPreviewRequest", arg0)
@@ -723,6 +913,50 @@ func (mr *MockECRAPIMockRecorder) GetLifecyclePolicyWithContext(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLifecyclePolicyWithContext", reflect.TypeOf((*MockECRAPI)(nil).GetLifecyclePolicyWithContext), varargs...)
 }
 
+// GetRegistryPolicy mocks base method
+func (m *MockECRAPI) GetRegistryPolicy(arg0 *ecr.GetRegistryPolicyInput) (*ecr.GetRegistryPolicyOutput, error) {
+	ret := m.ctrl.Call(m, "GetRegistryPolicy", arg0)
+	ret0, _ := ret[0].(*ecr.GetRegistryPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistryPolicy indicates an expected call of GetRegistryPolicy
+func (mr *MockECRAPIMockRecorder) GetRegistryPolicy(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryPolicy", reflect.TypeOf((*MockECRAPI)(nil).GetRegistryPolicy), arg0)
+}
+
+// GetRegistryPolicyRequest mocks base method
+func (m *MockECRAPI) GetRegistryPolicyRequest(arg0 *ecr.GetRegistryPolicyInput) (*request.Request, *ecr.GetRegistryPolicyOutput) {
+	ret := m.ctrl.Call(m, "GetRegistryPolicyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.GetRegistryPolicyOutput)
+	return ret0, ret1
+}
+
+// GetRegistryPolicyRequest indicates an expected call of GetRegistryPolicyRequest
+func (mr *MockECRAPIMockRecorder) GetRegistryPolicyRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryPolicyRequest", reflect.TypeOf((*MockECRAPI)(nil).GetRegistryPolicyRequest), arg0)
+}
+
+// GetRegistryPolicyWithContext mocks base method
+func (m *MockECRAPI) GetRegistryPolicyWithContext(arg0 context.Context, arg1 *ecr.GetRegistryPolicyInput, arg2 ...request.Option) (*ecr.GetRegistryPolicyOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRegistryPolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.GetRegistryPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistryPolicyWithContext indicates an expected call of GetRegistryPolicyWithContext
+func (mr *MockECRAPIMockRecorder) GetRegistryPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryPolicyWithContext", reflect.TypeOf((*MockECRAPI)(nil).GetRegistryPolicyWithContext), varargs...)
+}
+
 // GetRepositoryPolicy mocks base method
 func (m *MockECRAPI) GetRepositoryPolicy(arg0 *ecr.GetRepositoryPolicyInput) (*ecr.GetRepositoryPolicyOutput, error) {
 	ret := m.ctrl.Call(m, "GetRepositoryPolicy", arg0)
@@ -954,6 +1188,94 @@ func (mr *MockECRAPIMockRecorder) PutImageRequest(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutImageRequest", reflect.TypeOf((*MockECRAPI)(nil).PutImageRequest), arg0)
 }
 
+// PutImageScanningConfiguration mocks base method
+func (m *MockECRAPI) PutImageScanningConfiguration(arg0 *ecr.PutImageScanningConfigurationInput) (*ecr.PutImageScanningConfigurationOutput, error) {
+	ret := m.ctrl.Call(m, "PutImageScanningConfiguration", arg0)
+	ret0, _ := ret[0].(*ecr.PutImageScanningConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutImageScanningConfiguration indicates an expected call of PutImageScanningConfiguration
+func (mr *MockECRAPIMockRecorder) PutImageScanningConfiguration(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutImageScanningConfiguration", reflect.TypeOf((*MockECRAPI)(nil).PutImageScanningConfiguration), arg0)
+}
+
+// PutImageScanningConfigurationRequest mocks base method
+func (m *MockECRAPI) PutImageScanningConfigurationRequest(arg0 *ecr.PutImageScanningConfigurationInput) (*request.Request, *ecr.PutImageScanningConfigurationOutput) {
+	ret := m.ctrl.Call(m, "PutImageScanningConfigurationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.PutImageScanningConfigurationOutput)
+	return ret0, ret1
+}
+
+// PutImageScanningConfigurationRequest indicates an expected call of PutImageScanningConfigurationRequest
+func (mr *MockECRAPIMockRecorder) PutImageScanningConfigurationRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutImageScanningConfigurationRequest", reflect.TypeOf((*MockECRAPI)(nil).PutImageScanningConfigurationRequest), arg0)
+}
+
+// PutImageScanningConfigurationWithContext mocks base method
+func (m *MockECRAPI) PutImageScanningConfigurationWithContext(arg0 context.Context, arg1 *ecr.PutImageScanningConfigurationInput, arg2 ...request.Option) (*ecr.PutImageScanningConfigurationOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutImageScanningConfigurationWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.PutImageScanningConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutImageScanningConfigurationWithContext indicates an expected call of PutImageScanningConfigurationWithContext
+func (mr *MockECRAPIMockRecorder) PutImageScanningConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutImageScanningConfigurationWithContext", reflect.TypeOf((*MockECRAPI)(nil).PutImageScanningConfigurationWithContext), varargs...)
+}
+
+// PutImageTagMutability mocks base method
+func (m *MockECRAPI) PutImageTagMutability(arg0 *ecr.PutImageTagMutabilityInput) (*ecr.PutImageTagMutabilityOutput, error) {
+	ret := m.ctrl.Call(m, "PutImageTagMutability", arg0)
+	ret0, _ := ret[0].(*ecr.PutImageTagMutabilityOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutImageTagMutability indicates an expected call of PutImageTagMutability
+func (mr *MockECRAPIMockRecorder) PutImageTagMutability(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutImageTagMutability", reflect.TypeOf((*MockECRAPI)(nil).PutImageTagMutability), arg0)
+}
+
+// PutImageTagMutabilityRequest mocks base method
+func (m *MockECRAPI) PutImageTagMutabilityRequest(arg0 *ecr.PutImageTagMutabilityInput) (*request.Request, *ecr.PutImageTagMutabilityOutput) {
+	ret := m.ctrl.Call(m, "PutImageTagMutabilityRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.PutImageTagMutabilityOutput)
+	return ret0, ret1
+}
+
+// PutImageTagMutabilityRequest indicates an expected call of PutImageTagMutabilityRequest
+func (mr *MockECRAPIMockRecorder) PutImageTagMutabilityRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutImageTagMutabilityRequest", reflect.TypeOf((*MockECRAPI)(nil).PutImageTagMutabilityRequest), arg0)
+}
+
+// PutImageTagMutabilityWithContext mocks base method
+func (m *MockECRAPI) PutImageTagMutabilityWithContext(arg0 context.Context, arg1 *ecr.PutImageTagMutabilityInput, arg2 ...request.Option) (*ecr.PutImageTagMutabilityOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutImageTagMutabilityWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.PutImageTagMutabilityOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutImageTagMutabilityWithContext indicates an expected call of PutImageTagMutabilityWithContext
+func (mr *MockECRAPIMockRecorder) PutImageTagMutabilityWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutImageTagMutabilityWithContext", reflect.TypeOf((*MockECRAPI)(nil).PutImageTagMutabilityWithContext), varargs...)
+}
+
 // PutImageWithContext mocks base method
 func (m *MockECRAPI) PutImageWithContext(arg0 context.Context, arg1 *ecr.PutImageInput, arg2 ...request.Option) (*ecr.PutImageOutput, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -1016,6 +1338,94 @@ func (mr *MockECRAPIMockRecorder) PutLifecyclePolicyWithContext(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutLifecyclePolicyWithContext", reflect.TypeOf((*MockECRAPI)(nil).PutLifecyclePolicyWithContext), varargs...)
 }
 
+// PutRegistryPolicy mocks base method
+func (m *MockECRAPI) PutRegistryPolicy(arg0 *ecr.PutRegistryPolicyInput) (*ecr.PutRegistryPolicyOutput, error) {
+	ret := m.ctrl.Call(m, "PutRegistryPolicy", arg0)
+	ret0, _ := ret[0].(*ecr.PutRegistryPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutRegistryPolicy indicates an expected call of PutRegistryPolicy
+func (mr *MockECRAPIMockRecorder) PutRegistryPolicy(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRegistryPolicy", reflect.TypeOf((*MockECRAPI)(nil).PutRegistryPolicy), arg0)
+}
+
+// PutRegistryPolicyRequest mocks base method
+func (m *MockECRAPI) PutRegistryPolicyRequest(arg0 *ecr.PutRegistryPolicyInput) (*request.Request, *ecr.PutRegistryPolicyOutput) {
+	ret := m.ctrl.Call(m, "PutRegistryPolicyRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.PutRegistryPolicyOutput)
+	return ret0, ret1
+}
+
+// PutRegistryPolicyRequest indicates an expected call of PutRegistryPolicyRequest
+func (mr *MockECRAPIMockRecorder) PutRegistryPolicyRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRegistryPolicyRequest", reflect.TypeOf((*MockECRAPI)(nil).PutRegistryPolicyRequest), arg0)
+}
+
+// PutRegistryPolicyWithContext mocks base method
+func (m *MockECRAPI) PutRegistryPolicyWithContext(arg0 context.Context, arg1 *ecr.PutRegistryPolicyInput, arg2 ...request.Option) (*ecr.PutRegistryPolicyOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutRegistryPolicyWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.PutRegistryPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutRegistryPolicyWithContext indicates an expected call of PutRegistryPolicyWithContext
+func (mr *MockECRAPIMockRecorder) PutRegistryPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRegistryPolicyWithContext", reflect.TypeOf((*MockECRAPI)(nil).PutRegistryPolicyWithContext), varargs...)
+}
+
+// PutReplicationConfiguration mocks base method
+func (m *MockECRAPI) PutReplicationConfiguration(arg0 *ecr.PutReplicationConfigurationInput) (*ecr.PutReplicationConfigurationOutput, error) {
+	ret := m.ctrl.Call(m, "PutReplicationConfiguration", arg0)
+	ret0, _ := ret[0].(*ecr.PutReplicationConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutReplicationConfiguration indicates an expected call of PutReplicationConfiguration
+func (mr *MockECRAPIMockRecorder) PutReplicationConfiguration(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutReplicationConfiguration", reflect.TypeOf((*MockECRAPI)(nil).PutReplicationConfiguration), arg0)
+}
+
+// PutReplicationConfigurationRequest mocks base method
+func (m *MockECRAPI) PutReplicationConfigurationRequest(arg0 *ecr.PutReplicationConfigurationInput) (*request.Request, *ecr.PutReplicationConfigurationOutput) {
+	ret := m.ctrl.Call(m, "PutReplicationConfigurationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.PutReplicationConfigurationOutput)
+	return ret0, ret1
+}
+
+// PutReplicationConfigurationRequest indicates an expected call of PutReplicationConfigurationRequest
+func (mr *MockECRAPIMockRecorder) PutReplicationConfigurationRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutReplicationConfigurationRequest", reflect.TypeOf((*MockECRAPI)(nil).PutReplicationConfigurationRequest), arg0)
+}
+
+// PutReplicationConfigurationWithContext mocks base method
+func (m *MockECRAPI) PutReplicationConfigurationWithContext(arg0 context.Context, arg1 *ecr.PutReplicationConfigurationInput, arg2 ...request.Option) (*ecr.PutReplicationConfigurationOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutReplicationConfigurationWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.PutReplicationConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutReplicationConfigurationWithContext indicates an expected call of PutReplicationConfigurationWithContext
+func (mr *MockECRAPIMockRecorder) PutReplicationConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutReplicationConfigurationWithContext", reflect.TypeOf((*MockECRAPI)(nil).PutReplicationConfigurationWithContext), varargs...)
+}
+
 // SetRepositoryPolicy mocks base method
 func (m *MockECRAPI) SetRepositoryPolicy(arg0 *ecr.SetRepositoryPolicyInput) (*ecr.SetRepositoryPolicyOutput, error) {
 	ret := m.ctrl.Call(m, "SetRepositoryPolicy", arg0)
@@ -1060,6 +1470,50 @@ func (mr *MockECRAPIMockRecorder) SetRepositoryPolicyWithContext(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRepositoryPolicyWithContext", reflect.TypeOf((*MockECRAPI)(nil).SetRepositoryPolicyWithContext), varargs...)
 }
 
+// StartImageScan mocks base method
+func (m *MockECRAPI) StartImageScan(arg0 *ecr.StartImageScanInput) (*ecr.StartImageScanOutput, error) {
+	ret := m.ctrl.Call(m, "StartImageScan", arg0)
+	ret0, _ := ret[0].(*ecr.StartImageScanOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartImageScan indicates an expected call of StartImageScan
+func (mr *MockECRAPIMockRecorder) StartImageScan(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartImageScan", reflect.TypeOf((*MockECRAPI)(nil).StartImageScan), arg0)
+}
+
+// StartImageScanRequest mocks base method
+func (m *MockECRAPI) StartImageScanRequest(arg0 *ecr.StartImageScanInput) (*request.Request, *ecr.StartImageScanOutput) {
+	ret := m.ctrl.Call(m, "StartImageScanRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecr.StartImageScanOutput)
+	return ret0, ret1
+}
+
+// StartImageScanRequest indicates an expected call of StartImageScanRequest
+func (mr *MockECRAPIMockRecorder) StartImageScanRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartImageScanRequest", reflect.TypeOf((*MockECRAPI)(nil).StartImageScanRequest), arg0)
+}
+
+// StartImageScanWithContext mocks base method
+func (m *MockECRAPI) StartImageScanWithContext(arg0 context.Context, arg1 *ecr.StartImageScanInput, arg2 ...request.Option) (*ecr.StartImageScanOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartImageScanWithContext", varargs...)
+	ret0, _ := ret[0].(*ecr.StartImageScanOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartImageScanWithContext indicates an expected call of StartImageScanWithContext
+func (mr *MockECRAPIMockRecorder) StartImageScanWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartImageScanWithContext", reflect.TypeOf((*MockECRAPI)(nil).StartImageScanWithContext), varargs...)
+}
+
 // StartLifecyclePolicyPreview mocks base method
 func (m *MockECRAPI) StartLifecyclePolicyPreview(arg0 *ecr.StartLifecyclePolicyPreviewInput) (*ecr.StartLifecyclePolicyPreviewOutput, error) {
 	ret := m.ctrl.Call(m, "StartLifecyclePolicyPreview", arg0)
@@ -1235,3 +1689,61 @@ func (mr *MockECRAPIMockRecorder) UploadLayerPartWithContext(arg0, arg1 interfac
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadLayerPartWithContext", reflect.TypeOf((*MockECRAPI)(nil).UploadLayerPartWithContext), varargs...)
 }
+
+// WaitUntilImageScanComplete mocks base method
+func (m *MockECRAPI) WaitUntilImageScanComplete(arg0 *ecr.DescribeImageScanFindingsInput) error {
+	ret := m.ctrl.Call(m, "WaitUntilImageScanComplete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilImageScanComplete indicates an expected call of WaitUntilImageScanComplete
+func (mr *MockECRAPIMockRecorder) WaitUntilImageScanComplete(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilImageScanComplete", reflect.TypeOf((*MockECRAPI)(nil).WaitUntilImageScanComplete), arg0)
+}
+
+// WaitUntilImageScanCompleteWithContext mocks base method
+func (m *MockECRAPI) WaitUntilImageScanCompleteWithContext(arg0 context.Context, arg1 *ecr.DescribeImageScanFindingsInput, arg2 ...request.WaiterOption) error {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitUntilImageScanCompleteWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilImageScanCompleteWithContext indicates an expected call of WaitUntilImageScanCompleteWithContext
+func (mr *MockECRAPIMockRecorder) WaitUntilImageScanCompleteWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilImageScanCompleteWithContext", reflect.TypeOf((*MockECRAPI)(nil).WaitUntilImageScanCompleteWithContext), varargs...)
+}
+
+// WaitUntilLifecyclePolicyPreviewComplete mocks base method
+func (m *MockECRAPI) WaitUntilLifecyclePolicyPreviewComplete(arg0 *ecr.GetLifecyclePolicyPreviewInput) error {
+	ret := m.ctrl.Call(m, "WaitUntilLifecyclePolicyPreviewComplete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilLifecyclePolicyPreviewComplete indicates an expected call of WaitUntilLifecyclePolicyPreviewComplete
+func (mr *MockECRAPIMockRecorder) WaitUntilLifecyclePolicyPreviewComplete(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilLifecyclePolicyPreviewComplete", reflect.TypeOf((*MockECRAPI)(nil).WaitUntilLifecyclePolicyPreviewComplete), arg0)
+}
+
+// WaitUntilLifecyclePolicyPreviewCompleteWithContext mocks base method
+func (m *MockECRAPI) WaitUntilLifecyclePolicyPreviewCompleteWithContext(arg0 context.Context, arg1 *ecr.GetLifecyclePolicyPreviewInput, arg2 ...request.WaiterOption) error {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitUntilLifecyclePolicyPreviewCompleteWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilLifecyclePolicyPreviewCompleteWithContext indicates an expected call of WaitUntilLifecyclePolicyPreviewCompleteWithContext
+func (mr *MockECRAPIMockRecorder) WaitUntilLifecyclePolicyPreviewCompleteWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilLifecyclePolicyPreviewCompleteWithContext", reflect.TypeOf((*MockECRAPI)(nil).WaitUntilLifecyclePolicyPreviewCompleteWithContext), varargs...)
+}
@@ -47,9 +47,17 @@ type ECSClient interface {
 	DescribeService(serviceName string) (*ecs.DescribeServicesOutput, error)
 	DeleteService(serviceName string) error
 
+	// Task Set related
+	CreateTaskSet(input *ecs.CreateTaskSetInput) (*ecs.TaskSet, error)
+	DescribeTaskSet(serviceName, taskSetID string) (*ecs.TaskSet, error)
+	UpdateTaskSetScale(serviceName, taskSetID string, percent float64) error
+	UpdateServicePrimaryTaskSet(serviceName, taskSetID string) error
+	DeleteTaskSet(serviceName, taskSetID string) error
+
 	// Task Definition related
 	RegisterTaskDefinitionIfNeeded(request *ecs.RegisterTaskDefinitionInput, tdCache cache.Cache) (*ecs.TaskDefinition, error)
 	DescribeTaskDefinition(taskDefinitionName string) (*ecs.TaskDefinition, error)
+	ListTaskDefinitionRevisions(family string) ([]string, error)
 
 	// Tasks related
 	GetTasksPages(listTasksInput *ecs.ListTasksInput, fn ProcessTasksAction) error
@@ -93,7 +101,7 @@ func (c *ecsClient) CreateCluster(clusterName string, tags []*ecs.Tag) (string,
 	if len(tags) > 0 {
 		input.Tags = tags
 	}
-	resp, err := c.client.CreateCluster(input)
+	resp, err := c.client.CreateClusterWithContext(c.config.Context(), input)
 
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -111,7 +119,7 @@ func (c *ecsClient) CreateCluster(clusterName string, tags []*ecs.Tag) (string,
 }
 
 func (c *ecsClient) DeleteCluster(clusterName string) (string, error) {
-	resp, err := c.client.DeleteCluster(&ecs.DeleteClusterInput{Cluster: &clusterName})
+	resp, err := c.client.DeleteClusterWithContext(c.config.Context(), &ecs.DeleteClusterInput{Cluster: &clusterName})
 	if err != nil {
 		log.WithFields(log.Fields{
 			"cluster": clusterName,
@@ -126,7 +134,7 @@ func (c *ecsClient) DeleteCluster(clusterName string) (string, error) {
 }
 
 func (c *ecsClient) DeleteService(serviceName string) error {
-	_, err := c.client.DeleteService(&ecs.DeleteServiceInput{
+	_, err := c.client.DeleteServiceWithContext(c.config.Context(), &ecs.DeleteServiceInput{
 		Service: aws.String(serviceName),
 		Cluster: aws.String(c.config.Cluster),
 	})
@@ -142,7 +150,7 @@ func (c *ecsClient) DeleteService(serviceName string) error {
 }
 
 func (c *ecsClient) CreateService(input *ecs.CreateServiceInput) error {
-	if _, err := c.client.CreateService(input); err != nil {
+	if _, err := c.client.CreateServiceWithContext(c.config.Context(), input); err != nil {
 		log.WithFields(log.Fields{
 			"service": aws.StringValue(input.ServiceName),
 			"error":   err,
@@ -154,7 +162,7 @@ func (c *ecsClient) CreateService(input *ecs.CreateServiceInput) error {
 }
 
 func (c *ecsClient) UpdateService(input *ecs.UpdateServiceInput) error {
-	if _, err := c.client.UpdateService(input); err != nil {
+	if _, err := c.client.UpdateServiceWithContext(c.config.Context(), input); err != nil {
 		log.WithFields(log.Fields{
 			"service": aws.StringValue(input.Service),
 			"error":   err,
@@ -166,7 +174,7 @@ func (c *ecsClient) UpdateService(input *ecs.UpdateServiceInput) error {
 }
 
 func (c *ecsClient) DescribeService(serviceName string) (*ecs.DescribeServicesOutput, error) {
-	output, err := c.client.DescribeServices(&ecs.DescribeServicesInput{
+	output, err := c.client.DescribeServicesWithContext(c.config.Context(), &ecs.DescribeServicesInput{
 		Services: []*string{aws.String(serviceName)},
 		Cluster:  aws.String(c.config.Cluster),
 	})
@@ -181,7 +189,7 @@ func (c *ecsClient) DescribeService(serviceName string) (*ecs.DescribeServicesOu
 }
 
 func (c *ecsClient) registerTaskDefinition(request *ecs.RegisterTaskDefinitionInput) (*ecs.TaskDefinition, error) {
-	resp, err := c.client.RegisterTaskDefinition(request)
+	resp, err := c.client.RegisterTaskDefinitionWithContext(c.config.Context(), request)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"family": aws.StringValue(request.Family),
@@ -282,7 +290,7 @@ func persistTaskDefinition(request *ecs.RegisterTaskDefinitionInput, client *ecs
 }
 
 func (c *ecsClient) DescribeTaskDefinition(taskDefinitionName string) (*ecs.TaskDefinition, error) {
-	resp, err := c.client.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+	resp, err := c.client.DescribeTaskDefinitionWithContext(c.config.Context(), &ecs.DescribeTaskDefinitionInput{
 		TaskDefinition: aws.String(taskDefinitionName),
 	})
 	if err != nil {
@@ -297,7 +305,7 @@ func (c *ecsClient) DescribeTaskDefinition(taskDefinitionName string) (*ecs.Task
 func (c *ecsClient) GetTasksPages(listTasksInput *ecs.ListTasksInput, tasksFunc ProcessTasksAction) error {
 	listTasksInput.Cluster = aws.String(c.config.Cluster)
 	var outErr error
-	err := c.client.ListTasksPages(listTasksInput, func(page *ecs.ListTasksOutput, end bool) bool {
+	err := c.client.ListTasksPagesWithContext(c.config.Context(), listTasksInput, func(page *ecs.ListTasksOutput, end bool) bool {
 		if len(page.TaskArns) == 0 {
 			return false
 		}
@@ -333,7 +341,7 @@ func (c *ecsClient) DescribeTasks(taskArns []*string) ([]*ecs.Task, error) {
 		Tasks:   taskArns,
 		Cluster: aws.String(c.config.Cluster),
 	}
-	descTasksResp, err := c.client.DescribeTasks(descTasksRequest)
+	descTasksResp, err := c.client.DescribeTasksWithContext(c.config.Context(), descTasksRequest)
 	if descTasksResp == nil || err != nil {
 		log.WithFields(log.Fields{
 			"request": descTasksResp,
@@ -346,7 +354,7 @@ func (c *ecsClient) DescribeTasks(taskArns []*string) ([]*ecs.Task, error) {
 
 // RunTask issues a run task request for the input task definition
 func (c *ecsClient) RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error) {
-	resp, err := c.client.RunTask(input)
+	resp, err := c.client.RunTaskWithContext(c.config.Context(), input)
 
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -358,7 +366,7 @@ func (c *ecsClient) RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
 }
 
 func (c *ecsClient) StopTask(taskID string) error {
-	_, err := c.client.StopTask(&ecs.StopTaskInput{
+	_, err := c.client.StopTaskWithContext(c.config.Context(), &ecs.StopTaskInput{
 		Cluster: aws.String(c.config.Cluster),
 		Task:    aws.String(taskID),
 	})
@@ -381,7 +389,7 @@ func (c *ecsClient) GetEC2InstanceIDs(containerInstanceArns []*string) (map[stri
 		} else {
 			chunk = containerInstanceArns[i : i+ecsChunkSize]
 		}
-		descrContainerInstances, err := c.client.DescribeContainerInstances(&ecs.DescribeContainerInstancesInput{
+		descrContainerInstances, err := c.client.DescribeContainerInstancesWithContext(c.config.Context(), &ecs.DescribeContainerInstancesInput{
 			Cluster:            aws.String(c.config.Cluster),
 			ContainerInstances: chunk,
 		})
@@ -412,7 +420,7 @@ func (c *ecsClient) GetAttributesFromDescribeContainerInstances(containerInstanc
 			chunk = containerInstanceArns[i : i+ecsChunkSize]
 		}
 
-		descrContainerInstances, err := c.client.DescribeContainerInstances(&ecs.DescribeContainerInstancesInput{
+		descrContainerInstances, err := c.client.DescribeContainerInstancesWithContext(c.config.Context(), &ecs.DescribeContainerInstancesInput{
 			Cluster:            aws.String(c.config.Cluster),
 			ContainerInstances: chunk,
 		})
@@ -435,7 +443,7 @@ func (c *ecsClient) GetAttributesFromDescribeContainerInstances(containerInstanc
 
 // IsActiveCluster returns true if the cluster exists and can be described.
 func (c *ecsClient) IsActiveCluster(clusterName string) (bool, error) {
-	output, err := c.client.DescribeClusters(&ecs.DescribeClustersInput{
+	output, err := c.client.DescribeClustersWithContext(c.config.Context(), &ecs.DescribeClustersInput{
 		Clusters: []*string{aws.String(clusterName)},
 	})
 
@@ -460,5 +468,5 @@ func (c *ecsClient) IsActiveCluster(clusterName string) (bool, error) {
 
 // Checks if the given setting is enabled
 func (c *ecsClient) ListAccountSettings(input *ecs.ListAccountSettingsInput) (*ecs.ListAccountSettingsOutput, error) {
-	return c.client.ListAccountSettings(input)
+	return c.client.ListAccountSettingsWithContext(c.config.Context(), input)
 }
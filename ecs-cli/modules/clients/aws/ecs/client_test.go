@@ -146,21 +146,21 @@ func TestRegisterTDWithCache(t *testing.T) {
 
 	gomock.InOrder(
 		//First, we will mock the call to DescribeTaskDefinition
-		mockEcs.EXPECT().DescribeTaskDefinition(&describeTaskDefinitionInput1).
+		mockEcs.EXPECT().DescribeTaskDefinitionWithContext(gomock.Any(), &describeTaskDefinitionInput1).
 			Return(&ecs.DescribeTaskDefinitionOutput{TaskDefinition: &taskDefinition1}, nil),
 
 		// Next, expect a cache miss when it tries to register, so it actually
 		// registers
 		mockCache.EXPECT().Get(gomock.Any(), gomock.Any()).Return(errors.New("MISS")),
 
-		mockEcs.EXPECT().RegisterTaskDefinition(&registerTaskDefinitionInput1).
+		mockEcs.EXPECT().RegisterTaskDefinitionWithContext(gomock.Any(), &registerTaskDefinitionInput1).
 			Return(&ecs.RegisterTaskDefinitionOutput{TaskDefinition: &taskDefinition1}, nil),
 
 		mockCache.EXPECT().Put(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
 			cache[x.(string)] = y.(*ecs.TaskDefinition)
 		}).Return(nil),
 
-		mockEcs.EXPECT().DescribeTaskDefinition(&describeTaskDefinitionInput1).
+		mockEcs.EXPECT().DescribeTaskDefinitionWithContext(gomock.Any(), &describeTaskDefinitionInput1).
 			Return(&ecs.DescribeTaskDefinitionOutput{TaskDefinition: &taskDefinition1}, nil),
 
 		mockCache.EXPECT().Get(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
@@ -169,15 +169,15 @@ func TestRegisterTDWithCache(t *testing.T) {
 			*td = *cached
 		}).Return(nil),
 
-		mockEcs.EXPECT().DescribeTaskDefinition(&describeTaskDefinitionInput1WithRevision).
+		mockEcs.EXPECT().DescribeTaskDefinitionWithContext(gomock.Any(), &describeTaskDefinitionInput1WithRevision).
 			Return(&ecs.DescribeTaskDefinitionOutput{TaskDefinition: &taskDefinition1}, nil),
 
-		mockEcs.EXPECT().DescribeTaskDefinition(&describeTaskDefinitionInput2).
+		mockEcs.EXPECT().DescribeTaskDefinitionWithContext(gomock.Any(), &describeTaskDefinitionInput2).
 			Return(&ecs.DescribeTaskDefinitionOutput{TaskDefinition: &taskDefinition2}, nil),
 
 		mockCache.EXPECT().Get(gomock.Any(), gomock.Any()).Return(errors.New("MISS")),
 
-		mockEcs.EXPECT().RegisterTaskDefinition(&registerTaskDefinitionInput2).
+		mockEcs.EXPECT().RegisterTaskDefinitionWithContext(gomock.Any(), &registerTaskDefinitionInput2).
 			Return(&ecs.RegisterTaskDefinitionOutput{TaskDefinition: &taskDefinition2}, nil),
 
 		mockCache.EXPECT().Put(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
@@ -240,22 +240,22 @@ func TestRegisterTaskDefinitionIfNeededTDBecomesInactive(t *testing.T) {
 	cache := make(map[string]interface{})
 
 	gomock.InOrder(
-		mockEcs.EXPECT().DescribeTaskDefinition(&describeTaskDefinitionInput1).
+		mockEcs.EXPECT().DescribeTaskDefinitionWithContext(gomock.Any(), &describeTaskDefinitionInput1).
 			Return(&ecs.DescribeTaskDefinitionOutput{TaskDefinition: &taskDefinition1}, nil),
 
 		mockCache.EXPECT().Get(gomock.Any(), gomock.Any()).Return(errors.New("MISS")),
 
-		mockEcs.EXPECT().RegisterTaskDefinition(&registerTaskDefinitionInput1).
+		mockEcs.EXPECT().RegisterTaskDefinitionWithContext(gomock.Any(), &registerTaskDefinitionInput1).
 			Return(&ecs.RegisterTaskDefinitionOutput{TaskDefinition: &taskDefinition1}, nil),
 
 		mockCache.EXPECT().Put(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
 			cache[x.(string)] = y.(*ecs.TaskDefinition)
 		}).Return(nil),
 
-		mockEcs.EXPECT().DescribeTaskDefinition(&describeTaskDefinitionInput1).
+		mockEcs.EXPECT().DescribeTaskDefinitionWithContext(gomock.Any(), &describeTaskDefinitionInput1).
 			Return(&ecs.DescribeTaskDefinitionOutput{TaskDefinition: &taskDefinition1Inactive}, nil),
 
-		mockEcs.EXPECT().RegisterTaskDefinition(&registerTaskDefinitionInput1).
+		mockEcs.EXPECT().RegisterTaskDefinitionWithContext(gomock.Any(), &registerTaskDefinitionInput1).
 			Return(&ecs.RegisterTaskDefinitionOutput{TaskDefinition: &taskDefinition1Revision2}, nil),
 
 		mockCache.EXPECT().Put(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
@@ -316,10 +316,10 @@ func TestRegisterTaskDefinitionIfNeededTDLatestTDRevisionIsInactive(t *testing.T
 	}
 
 	gomock.InOrder(
-		mockEcs.EXPECT().DescribeTaskDefinition(&describeTaskDefinitionInput1).
+		mockEcs.EXPECT().DescribeTaskDefinitionWithContext(gomock.Any(), &describeTaskDefinitionInput1).
 			Return(&ecs.DescribeTaskDefinitionOutput{TaskDefinition: &taskDefinition1Inactive}, nil),
 
-		mockEcs.EXPECT().RegisterTaskDefinition(&registerTaskDefinitionInput1).
+		mockEcs.EXPECT().RegisterTaskDefinitionWithContext(gomock.Any(), &registerTaskDefinitionInput1).
 			Return(&ecs.RegisterTaskDefinitionOutput{TaskDefinition: &taskDefinition1}, nil),
 
 		mockCache.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil),
@@ -366,17 +366,17 @@ func TestRegisterTaskDefinitionIfNeededCachedTDIsInactive(t *testing.T) {
 	}
 
 	gomock.InOrder(
-		mockEcs.EXPECT().DescribeTaskDefinition(&describeTaskDefinitionInput2).
+		mockEcs.EXPECT().DescribeTaskDefinitionWithContext(gomock.Any(), &describeTaskDefinitionInput2).
 			Return(&ecs.DescribeTaskDefinitionOutput{TaskDefinition: &taskDefinition2}, nil),
 
 		mockCache.EXPECT().Get(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
 			*y.(*ecs.TaskDefinition) = taskDefinition1CachedInactive
 		}).Return(nil),
 
-		mockEcs.EXPECT().DescribeTaskDefinition(&describeTaskDefinitionInput1Inactive).
+		mockEcs.EXPECT().DescribeTaskDefinitionWithContext(gomock.Any(), &describeTaskDefinitionInput1Inactive).
 			Return(&ecs.DescribeTaskDefinitionOutput{TaskDefinition: &taskDefinition1CachedInactive}, nil),
 
-		mockEcs.EXPECT().RegisterTaskDefinition(&registerTaskDefinitionInput1).
+		mockEcs.EXPECT().RegisterTaskDefinitionWithContext(gomock.Any(), &registerTaskDefinitionInput1).
 			Return(&ecs.RegisterTaskDefinitionOutput{TaskDefinition: &taskDefinition2}, nil),
 
 		mockCache.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil),
@@ -389,6 +389,57 @@ func TestRegisterTaskDefinitionIfNeededCachedTDIsInactive(t *testing.T) {
 	}, "Expected revison of response to be incremented because the cached task definition is INACTIVE")
 }
 
+func TestConstructTaskDefinitionCacheHashIncludesPlacementConstraints(t *testing.T) {
+	defer os.Clearenv()
+
+	_, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	taskDefinition := &ecs.TaskDefinition{
+		TaskDefinitionArn: aws.String("arn:aws:ecs:region1:123456:task-definition/family1:1"),
+	}
+	newRequest := func(expression string) *ecs.RegisterTaskDefinitionInput {
+		return &ecs.RegisterTaskDefinitionInput{
+			Family:               aws.String("family1"),
+			ContainerDefinitions: []*ecs.ContainerDefinition{{Name: aws.String("foo")}},
+			PlacementConstraints: []*ecs.TaskDefinitionPlacementConstraint{
+				{Type: aws.String(ecs.TaskDefinitionPlacementConstraintTypeMemberOf), Expression: aws.String(expression)},
+			},
+		}
+	}
+
+	ecsClient := client.(*ecsClient)
+	hash := ecsClient.constructTaskDefinitionCacheHash(taskDefinition, newRequest("attribute:stack == prod"))
+	assert.Equal(t, hash, ecsClient.constructTaskDefinitionCacheHash(taskDefinition, newRequest("attribute:stack == prod")), "Expected identical requests to have the same hash")
+	assert.NotEqual(t, hash, ecsClient.constructTaskDefinitionCacheHash(taskDefinition, newRequest("attribute:stack == dev")), "Expected a changed placement constraint to change the hash")
+}
+
+func TestConstructTaskDefinitionCacheHashIncludesImageDigest(t *testing.T) {
+	defer os.Clearenv()
+
+	_, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	taskDefinition := &ecs.TaskDefinition{
+		TaskDefinitionArn: aws.String("arn:aws:ecs:region1:123456:task-definition/family1:1"),
+	}
+	newRequest := func(digest string) *ecs.RegisterTaskDefinitionInput {
+		return &ecs.RegisterTaskDefinitionInput{
+			Family: aws.String("family1"),
+			ContainerDefinitions: []*ecs.ContainerDefinition{{
+				Name:         aws.String("foo"),
+				Image:        aws.String("nginx@" + digest),
+				DockerLabels: map[string]*string{"com.amazonaws.ecs-cli.original-image": aws.String("nginx:1.15")},
+			}},
+		}
+	}
+
+	ecsClient := client.(*ecsClient)
+	hash := ecsClient.constructTaskDefinitionCacheHash(taskDefinition, newRequest("sha256:0123"))
+	assert.Equal(t, hash, ecsClient.constructTaskDefinitionCacheHash(taskDefinition, newRequest("sha256:0123")), "Expected identical requests to have the same hash")
+	assert.NotEqual(t, hash, ecsClient.constructTaskDefinitionCacheHash(taskDefinition, newRequest("sha256:4567")), "Expected a changed image digest to change the hash")
+}
+
 func TestGetTasksPages(t *testing.T) {
 	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
 	defer ctrl.Finish()
@@ -402,7 +453,7 @@ func TestGetTasksPages(t *testing.T) {
 		Family: aws.String(family),
 	}
 
-	mockEcs.EXPECT().ListTasksPages(gomock.Any(), gomock.Any()).Do(func(x, y interface{}) {
+	mockEcs.EXPECT().ListTasksPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Do(func(_, x, y interface{}) {
 		// verify input fields
 		req := x.(*ecs.ListTasksInput)
 		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
@@ -413,7 +464,7 @@ func TestGetTasksPages(t *testing.T) {
 		funct(&ecs.ListTasksOutput{TaskArns: taskIds}, false)
 	}).Return(nil)
 
-	mockEcs.EXPECT().DescribeTasks(gomock.Any()).Do(func(input interface{}) {
+	mockEcs.EXPECT().DescribeTasksWithContext(gomock.Any(), gomock.Any()).Do(func(_, input interface{}) {
 		// verify input fields
 		req := input.(*ecs.DescribeTasksInput)
 		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
@@ -438,7 +489,7 @@ func TestRunTask(t *testing.T) {
 	group := "taskGroup"
 	count := 5
 
-	mockEcs.EXPECT().RunTask(gomock.Any()).Do(func(input interface{}) {
+	mockEcs.EXPECT().RunTaskWithContext(gomock.Any(), gomock.Any()).Do(func(_, input interface{}) {
 		req := input.(*ecs.RunTaskInput)
 		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
 		assert.Equal(t, td, aws.StringValue(req.TaskDefinition), "Expected taskDefinition to match")
@@ -474,7 +525,7 @@ func TestRunTaskWithOverrides(t *testing.T) {
 		ContainerOverrides: []*ecs.ContainerOverride{containterOverride},
 	}
 
-	mockEcs.EXPECT().RunTask(gomock.Any()).Do(func(input interface{}) {
+	mockEcs.EXPECT().RunTaskWithContext(gomock.Any(), gomock.Any()).Do(func(_, input interface{}) {
 		req := input.(*ecs.RunTaskInput)
 		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
 		assert.Equal(t, td, aws.StringValue(req.TaskDefinition), "Expected taskDefinition to match")
@@ -505,7 +556,7 @@ func TestRunTaskWithLaunchTypeEC2(t *testing.T) {
 	group := "taskGroup"
 	count := 5
 
-	mockEcs.EXPECT().RunTask(gomock.Any()).Do(func(input interface{}) {
+	mockEcs.EXPECT().RunTaskWithContext(gomock.Any(), gomock.Any()).Do(func(_, input interface{}) {
 		req := input.(*ecs.RunTaskInput)
 		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
 		assert.Equal(t, td, aws.StringValue(req.TaskDefinition), "Expected taskDefinition to match")
@@ -545,7 +596,7 @@ func TestRunTaskWithLaunchTypeFargate(t *testing.T) {
 		AwsvpcConfiguration: awsVpcConfig,
 	}
 
-	mockEcs.EXPECT().RunTask(gomock.Any()).Do(func(input interface{}) {
+	mockEcs.EXPECT().RunTaskWithContext(gomock.Any(), gomock.Any()).Do(func(_, input interface{}) {
 		req := input.(*ecs.RunTaskInput)
 		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
 		assert.Equal(t, td, aws.StringValue(req.TaskDefinition), "Expected taskDefinition to match")
@@ -586,7 +637,7 @@ func TestRunTask_WithTaskNetworking(t *testing.T) {
 		AwsvpcConfiguration: awsVpcConfig,
 	}
 
-	mockEcs.EXPECT().RunTask(gomock.Any()).Do(func(input interface{}) {
+	mockEcs.EXPECT().RunTaskWithContext(gomock.Any(), gomock.Any()).Do(func(_, input interface{}) {
 		req := input.(*ecs.RunTaskInput)
 		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
 		assert.Equal(t, td, aws.StringValue(req.TaskDefinition), "Expected taskDefinition to match")
@@ -632,7 +683,7 @@ func TestRunTask_WithTaskPlacement(t *testing.T) {
 		},
 	}
 
-	mockEcs.EXPECT().RunTask(gomock.Any()).Do(func(input interface{}) {
+	mockEcs.EXPECT().RunTaskWithContext(gomock.Any(), gomock.Any()).Do(func(_, input interface{}) {
 		req := input.(*ecs.RunTaskInput)
 		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
 		assert.Equal(t, td, aws.StringValue(req.TaskDefinition), "Expected taskDefinition to match")
@@ -660,7 +711,7 @@ func TestIsActiveCluster(t *testing.T) {
 	defer ctrl.Finish()
 
 	// API error
-	mockEcs.EXPECT().DescribeClusters(gomock.Any()).Return(nil, errors.New("describe-clusters error"))
+	mockEcs.EXPECT().DescribeClustersWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("describe-clusters error"))
 	_, err := client.IsActiveCluster("")
 	assert.Error(t, err, "Expected error when calling IsActiveCluster")
 
@@ -668,7 +719,7 @@ func TestIsActiveCluster(t *testing.T) {
 	output := &ecs.DescribeClustersOutput{
 		Failures: []*ecs.Failure{&ecs.Failure{}},
 	}
-	mockEcs.EXPECT().DescribeClusters(gomock.Any()).Return(output, nil)
+	mockEcs.EXPECT().DescribeClustersWithContext(gomock.Any(), gomock.Any()).Return(output, nil)
 	active, err := client.IsActiveCluster("")
 	assert.NoError(t, err, "Unexpected error when calling IsActiveCluster")
 	assert.False(t, active, "Expected IsActiveCluster to return false when API returned failures")
@@ -677,7 +728,7 @@ func TestIsActiveCluster(t *testing.T) {
 	output = &ecs.DescribeClustersOutput{
 		Clusters: []*ecs.Cluster{&ecs.Cluster{Status: aws.String("INACTIVE")}},
 	}
-	mockEcs.EXPECT().DescribeClusters(gomock.Any()).Return(output, nil)
+	mockEcs.EXPECT().DescribeClustersWithContext(gomock.Any(), gomock.Any()).Return(output, nil)
 	active, err = client.IsActiveCluster("")
 	assert.NoError(t, err, "Unexpected error when calling IsActiveCluster")
 	assert.False(t, active, "Expected IsActiveCluster to return false when API returned inactive cluster")
@@ -686,7 +737,7 @@ func TestIsActiveCluster(t *testing.T) {
 	output = &ecs.DescribeClustersOutput{
 		Clusters: []*ecs.Cluster{&ecs.Cluster{Status: aws.String("ACTIVE")}},
 	}
-	mockEcs.EXPECT().DescribeClusters(gomock.Any()).Return(output, nil)
+	mockEcs.EXPECT().DescribeClustersWithContext(gomock.Any(), gomock.Any()).Return(output, nil)
 	active, err = client.IsActiveCluster("")
 	assert.NoError(t, err, "Unexpected error when calling IsActiveCluster")
 	assert.True(t, active, "Expected IsActiveCluster to return true when API returned active cluster")
@@ -706,7 +757,7 @@ func TestGetEC2InstanceIDs(t *testing.T) {
 		},
 	}
 
-	mockEcs.EXPECT().DescribeContainerInstances(gomock.Any()).Do(func(input interface{}) {
+	mockEcs.EXPECT().DescribeContainerInstancesWithContext(gomock.Any(), gomock.Any()).Do(func(_, input interface{}) {
 		req := input.(*ecs.DescribeContainerInstancesInput)
 		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
 		assert.Equal(t, len(containerInstanceArns), len(req.ContainerInstances), "Expected ContainerInstances to be the same length")
@@ -741,7 +792,7 @@ func TestGetEC2InstanceIDsWithNoEc2InstanceID(t *testing.T) {
 		},
 	}
 
-	mockEcs.EXPECT().DescribeContainerInstances(gomock.Any()).Return(&ecs.DescribeContainerInstancesOutput{
+	mockEcs.EXPECT().DescribeContainerInstancesWithContext(gomock.Any(), gomock.Any()).Return(&ecs.DescribeContainerInstancesOutput{
 		ContainerInstances: containerInstances,
 	}, nil)
 
@@ -757,7 +808,7 @@ func TestGetEC2InstanceIDsErrorCase(t *testing.T) {
 	containerInstanceArn := "containerInstanceArn"
 	containerInstanceArns := []*string{aws.String(containerInstanceArn)}
 
-	mockEcs.EXPECT().DescribeContainerInstances(gomock.Any()).Return(nil, errors.New("something wrong"))
+	mockEcs.EXPECT().DescribeContainerInstancesWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("something wrong"))
 
 	_, err := client.GetEC2InstanceIDs(containerInstanceArns)
 	assert.Error(t, err, "Expected error when calling GetEC2InstanceIDs")
@@ -779,7 +830,7 @@ func TestGetAttributesFromDescribeContainerInstances(t *testing.T) {
 		},
 	}
 
-	mockEcs.EXPECT().DescribeContainerInstances(gomock.Any()).Do(func(input interface{}) {
+	mockEcs.EXPECT().DescribeContainerInstancesWithContext(gomock.Any(), gomock.Any()).Do(func(_, input interface{}) {
 		req := input.(*ecs.DescribeContainerInstancesInput)
 		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
 		assert.Equal(t, len(containerInstanceArns), len(req.ContainerInstances), "Expected ContainerInstances to be the same length")
@@ -809,7 +860,7 @@ func TestGetAttributesFromDescribeContainerInstancesErrorCase(t *testing.T) {
 	containerInstanceArn := "containerInstanceArn"
 	containerInstanceArns := []*string{aws.String(containerInstanceArn)}
 
-	mockEcs.EXPECT().DescribeContainerInstances(gomock.Any()).Return(nil, errors.New("something wrong"))
+	mockEcs.EXPECT().DescribeContainerInstancesWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("something wrong"))
 
 	_, err := client.GetAttributesFromDescribeContainerInstances(containerInstanceArns)
 	assert.Error(t, err, "Expected error when calling GetAttributesFromDescribeContainerInstances")
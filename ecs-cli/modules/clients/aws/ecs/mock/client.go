@@ -74,6 +74,19 @@ func (mr *MockECSClientMockRecorder) CreateService(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateService", reflect.TypeOf((*MockECSClient)(nil).CreateService), arg0)
 }
 
+// CreateTaskSet mocks base method
+func (m *MockECSClient) CreateTaskSet(arg0 *ecs0.CreateTaskSetInput) (*ecs0.TaskSet, error) {
+	ret := m.ctrl.Call(m, "CreateTaskSet", arg0)
+	ret0, _ := ret[0].(*ecs0.TaskSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTaskSet indicates an expected call of CreateTaskSet
+func (mr *MockECSClientMockRecorder) CreateTaskSet(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTaskSet", reflect.TypeOf((*MockECSClient)(nil).CreateTaskSet), arg0)
+}
+
 // DeleteCluster mocks base method
 func (m *MockECSClient) DeleteCluster(arg0 string) (string, error) {
 	ret := m.ctrl.Call(m, "DeleteCluster", arg0)
@@ -99,6 +112,18 @@ func (mr *MockECSClientMockRecorder) DeleteService(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*MockECSClient)(nil).DeleteService), arg0)
 }
 
+// DeleteTaskSet mocks base method
+func (m *MockECSClient) DeleteTaskSet(arg0, arg1 string) error {
+	ret := m.ctrl.Call(m, "DeleteTaskSet", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTaskSet indicates an expected call of DeleteTaskSet
+func (mr *MockECSClientMockRecorder) DeleteTaskSet(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskSet", reflect.TypeOf((*MockECSClient)(nil).DeleteTaskSet), arg0, arg1)
+}
+
 // DescribeService mocks base method
 func (m *MockECSClient) DescribeService(arg0 string) (*ecs0.DescribeServicesOutput, error) {
 	ret := m.ctrl.Call(m, "DescribeService", arg0)
@@ -125,6 +150,19 @@ func (mr *MockECSClientMockRecorder) DescribeTaskDefinition(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskDefinition", reflect.TypeOf((*MockECSClient)(nil).DescribeTaskDefinition), arg0)
 }
 
+// DescribeTaskSet mocks base method
+func (m *MockECSClient) DescribeTaskSet(arg0, arg1 string) (*ecs0.TaskSet, error) {
+	ret := m.ctrl.Call(m, "DescribeTaskSet", arg0, arg1)
+	ret0, _ := ret[0].(*ecs0.TaskSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskSet indicates an expected call of DescribeTaskSet
+func (mr *MockECSClientMockRecorder) DescribeTaskSet(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskSet", reflect.TypeOf((*MockECSClient)(nil).DescribeTaskSet), arg0, arg1)
+}
+
 // DescribeTasks mocks base method
 func (m *MockECSClient) DescribeTasks(arg0 []*string) ([]*ecs0.Task, error) {
 	ret := m.ctrl.Call(m, "DescribeTasks", arg0)
@@ -202,6 +240,19 @@ func (mr *MockECSClientMockRecorder) ListAccountSettings(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountSettings", reflect.TypeOf((*MockECSClient)(nil).ListAccountSettings), arg0)
 }
 
+// ListTaskDefinitionRevisions mocks base method
+func (m *MockECSClient) ListTaskDefinitionRevisions(arg0 string) ([]string, error) {
+	ret := m.ctrl.Call(m, "ListTaskDefinitionRevisions", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskDefinitionRevisions indicates an expected call of ListTaskDefinitionRevisions
+func (mr *MockECSClientMockRecorder) ListTaskDefinitionRevisions(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskDefinitionRevisions", reflect.TypeOf((*MockECSClient)(nil).ListTaskDefinitionRevisions), arg0)
+}
+
 // RegisterTaskDefinitionIfNeeded mocks base method
 func (m *MockECSClient) RegisterTaskDefinitionIfNeeded(arg0 *ecs0.RegisterTaskDefinitionInput, arg1 cache.Cache) (*ecs0.TaskDefinition, error) {
 	ret := m.ctrl.Call(m, "RegisterTaskDefinitionIfNeeded", arg0, arg1)
@@ -251,3 +302,27 @@ func (m *MockECSClient) UpdateService(arg0 *ecs0.UpdateServiceInput) error {
 func (mr *MockECSClientMockRecorder) UpdateService(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateService", reflect.TypeOf((*MockECSClient)(nil).UpdateService), arg0)
 }
+
+// UpdateServicePrimaryTaskSet mocks base method
+func (m *MockECSClient) UpdateServicePrimaryTaskSet(arg0, arg1 string) error {
+	ret := m.ctrl.Call(m, "UpdateServicePrimaryTaskSet", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServicePrimaryTaskSet indicates an expected call of UpdateServicePrimaryTaskSet
+func (mr *MockECSClientMockRecorder) UpdateServicePrimaryTaskSet(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServicePrimaryTaskSet", reflect.TypeOf((*MockECSClient)(nil).UpdateServicePrimaryTaskSet), arg0, arg1)
+}
+
+// UpdateTaskSetScale mocks base method
+func (m *MockECSClient) UpdateTaskSetScale(arg0, arg1 string, arg2 float64) error {
+	ret := m.ctrl.Call(m, "UpdateTaskSetScale", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaskSetScale indicates an expected call of UpdateTaskSetScale
+func (mr *MockECSClientMockRecorder) UpdateTaskSetScale(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskSetScale", reflect.TypeOf((*MockECSClient)(nil).UpdateTaskSetScale), arg0, arg1, arg2)
+}
@@ -49,6 +49,50 @@ func (m *MockECSAPI) EXPECT() *MockECSAPIMockRecorder {
 	return m.recorder
 }
 
+// CreateCapacityProvider mocks base method
+func (m *MockECSAPI) CreateCapacityProvider(arg0 *ecs.CreateCapacityProviderInput) (*ecs.CreateCapacityProviderOutput, error) {
+	ret := m.ctrl.Call(m, "CreateCapacityProvider", arg0)
+	ret0, _ := ret[0].(*ecs.CreateCapacityProviderOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCapacityProvider indicates an expected call of CreateCapacityProvider
+func (mr *MockECSAPIMockRecorder) CreateCapacityProvider(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCapacityProvider", reflect.TypeOf((*MockECSAPI)(nil).CreateCapacityProvider), arg0)
+}
+
+// CreateCapacityProviderRequest mocks base method
+func (m *MockECSAPI) CreateCapacityProviderRequest(arg0 *ecs.CreateCapacityProviderInput) (*request.Request, *ecs.CreateCapacityProviderOutput) {
+	ret := m.ctrl.Call(m, "CreateCapacityProviderRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecs.CreateCapacityProviderOutput)
+	return ret0, ret1
+}
+
+// CreateCapacityProviderRequest indicates an expected call of CreateCapacityProviderRequest
+func (mr *MockECSAPIMockRecorder) CreateCapacityProviderRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCapacityProviderRequest", reflect.TypeOf((*MockECSAPI)(nil).CreateCapacityProviderRequest), arg0)
+}
+
+// CreateCapacityProviderWithContext mocks base method
+func (m *MockECSAPI) CreateCapacityProviderWithContext(arg0 context.Context, arg1 *ecs.CreateCapacityProviderInput, arg2 ...request.Option) (*ecs.CreateCapacityProviderOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCapacityProviderWithContext", varargs...)
+	ret0, _ := ret[0].(*ecs.CreateCapacityProviderOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCapacityProviderWithContext indicates an expected call of CreateCapacityProviderWithContext
+func (mr *MockECSAPIMockRecorder) CreateCapacityProviderWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCapacityProviderWithContext", reflect.TypeOf((*MockECSAPI)(nil).CreateCapacityProviderWithContext), varargs...)
+}
+
 // CreateCluster mocks base method
 func (m *MockECSAPI) CreateCluster(arg0 *ecs.CreateClusterInput) (*ecs.CreateClusterOutput, error) {
 	ret := m.ctrl.Call(m, "CreateCluster", arg0)
@@ -137,6 +181,50 @@ func (mr *MockECSAPIMockRecorder) CreateServiceWithContext(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceWithContext", reflect.TypeOf((*MockECSAPI)(nil).CreateServiceWithContext), varargs...)
 }
 
+// CreateTaskSet mocks base method
+func (m *MockECSAPI) CreateTaskSet(arg0 *ecs.CreateTaskSetInput) (*ecs.CreateTaskSetOutput, error) {
+	ret := m.ctrl.Call(m, "CreateTaskSet", arg0)
+	ret0, _ := ret[0].(*ecs.CreateTaskSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTaskSet indicates an expected call of CreateTaskSet
+func (mr *MockECSAPIMockRecorder) CreateTaskSet(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTaskSet", reflect.TypeOf((*MockECSAPI)(nil).CreateTaskSet), arg0)
+}
+
+// CreateTaskSetRequest mocks base method
+func (m *MockECSAPI) CreateTaskSetRequest(arg0 *ecs.CreateTaskSetInput) (*request.Request, *ecs.CreateTaskSetOutput) {
+	ret := m.ctrl.Call(m, "CreateTaskSetRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecs.CreateTaskSetOutput)
+	return ret0, ret1
+}
+
+// CreateTaskSetRequest indicates an expected call of CreateTaskSetRequest
+func (mr *MockECSAPIMockRecorder) CreateTaskSetRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTaskSetRequest", reflect.TypeOf((*MockECSAPI)(nil).CreateTaskSetRequest), arg0)
+}
+
+// CreateTaskSetWithContext mocks base method
+func (m *MockECSAPI) CreateTaskSetWithContext(arg0 context.Context, arg1 *ecs.CreateTaskSetInput, arg2 ...request.Option) (*ecs.CreateTaskSetOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTaskSetWithContext", varargs...)
+	ret0, _ := ret[0].(*ecs.CreateTaskSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTaskSetWithContext indicates an expected call of CreateTaskSetWithContext
+func (mr *MockECSAPIMockRecorder) CreateTaskSetWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTaskSetWithContext", reflect.TypeOf((*MockECSAPI)(nil).CreateTaskSetWithContext), varargs...)
+}
+
 // DeleteAccountSetting mocks base method
 func (m *MockECSAPI) DeleteAccountSetting(arg0 *ecs.DeleteAccountSettingInput) (*ecs.DeleteAccountSettingOutput, error) {
 	ret := m.ctrl.Call(m, "DeleteAccountSetting", arg0)
@@ -225,6 +313,50 @@ func (mr *MockECSAPIMockRecorder) DeleteAttributesWithContext(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttributesWithContext", reflect.TypeOf((*MockECSAPI)(nil).DeleteAttributesWithContext), varargs...)
 }
 
+// DeleteCapacityProvider mocks base method
+func (m *MockECSAPI) DeleteCapacityProvider(arg0 *ecs.DeleteCapacityProviderInput) (*ecs.DeleteCapacityProviderOutput, error) {
+	ret := m.ctrl.Call(m, "DeleteCapacityProvider", arg0)
+	ret0, _ := ret[0].(*ecs.DeleteCapacityProviderOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCapacityProvider indicates an expected call of DeleteCapacityProvider
+func (mr *MockECSAPIMockRecorder) DeleteCapacityProvider(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCapacityProvider", reflect.TypeOf((*MockECSAPI)(nil).DeleteCapacityProvider), arg0)
+}
+
+// DeleteCapacityProviderRequest mocks base method
+func (m *MockECSAPI) DeleteCapacityProviderRequest(arg0 *ecs.DeleteCapacityProviderInput) (*request.Request, *ecs.DeleteCapacityProviderOutput) {
+	ret := m.ctrl.Call(m, "DeleteCapacityProviderRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecs.DeleteCapacityProviderOutput)
+	return ret0, ret1
+}
+
+// DeleteCapacityProviderRequest indicates an expected call of DeleteCapacityProviderRequest
+func (mr *MockECSAPIMockRecorder) DeleteCapacityProviderRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCapacityProviderRequest", reflect.TypeOf((*MockECSAPI)(nil).DeleteCapacityProviderRequest), arg0)
+}
+
+// DeleteCapacityProviderWithContext mocks base method
+func (m *MockECSAPI) DeleteCapacityProviderWithContext(arg0 context.Context, arg1 *ecs.DeleteCapacityProviderInput, arg2 ...request.Option) (*ecs.DeleteCapacityProviderOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCapacityProviderWithContext", varargs...)
+	ret0, _ := ret[0].(*ecs.DeleteCapacityProviderOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCapacityProviderWithContext indicates an expected call of DeleteCapacityProviderWithContext
+func (mr *MockECSAPIMockRecorder) DeleteCapacityProviderWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCapacityProviderWithContext", reflect.TypeOf((*MockECSAPI)(nil).DeleteCapacityProviderWithContext), varargs...)
+}
+
 // DeleteCluster mocks base method
 func (m *MockECSAPI) DeleteCluster(arg0 *ecs.DeleteClusterInput) (*ecs.DeleteClusterOutput, error) {
 	ret := m.ctrl.Call(m, "DeleteCluster", arg0)
@@ -313,6 +445,50 @@ func (mr *MockECSAPIMockRecorder) DeleteServiceWithContext(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceWithContext", reflect.TypeOf((*MockECSAPI)(nil).DeleteServiceWithContext), varargs...)
 }
 
+// DeleteTaskSet mocks base method
+func (m *MockECSAPI) DeleteTaskSet(arg0 *ecs.DeleteTaskSetInput) (*ecs.DeleteTaskSetOutput, error) {
+	ret := m.ctrl.Call(m, "DeleteTaskSet", arg0)
+	ret0, _ := ret[0].(*ecs.DeleteTaskSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskSet indicates an expected call of DeleteTaskSet
+func (mr *MockECSAPIMockRecorder) DeleteTaskSet(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskSet", reflect.TypeOf((*MockECSAPI)(nil).DeleteTaskSet), arg0)
+}
+
+// DeleteTaskSetRequest mocks base method
+func (m *MockECSAPI) DeleteTaskSetRequest(arg0 *ecs.DeleteTaskSetInput) (*request.Request, *ecs.DeleteTaskSetOutput) {
+	ret := m.ctrl.Call(m, "DeleteTaskSetRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecs.DeleteTaskSetOutput)
+	return ret0, ret1
+}
+
+// DeleteTaskSetRequest indicates an expected call of DeleteTaskSetRequest
+func (mr *MockECSAPIMockRecorder) DeleteTaskSetRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskSetRequest", reflect.TypeOf((*MockECSAPI)(nil).DeleteTaskSetRequest), arg0)
+}
+
+// DeleteTaskSetWithContext mocks base method
+func (m *MockECSAPI) DeleteTaskSetWithContext(arg0 context.Context, arg1 *ecs.DeleteTaskSetInput, arg2 ...request.Option) (*ecs.DeleteTaskSetOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTaskSetWithContext", varargs...)
+	ret0, _ := ret[0].(*ecs.DeleteTaskSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskSetWithContext indicates an expected call of DeleteTaskSetWithContext
+func (mr *MockECSAPIMockRecorder) DeleteTaskSetWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskSetWithContext", reflect.TypeOf((*MockECSAPI)(nil).DeleteTaskSetWithContext), varargs...)
+}
+
 // DeregisterContainerInstance mocks base method
 func (m *MockECSAPI) DeregisterContainerInstance(arg0 *ecs.DeregisterContainerInstanceInput) (*ecs.DeregisterContainerInstanceOutput, error) {
 	ret := m.ctrl.Call(m, "DeregisterContainerInstance", arg0)
@@ -401,6 +577,50 @@ func (mr *MockECSAPIMockRecorder) DeregisterTaskDefinitionWithContext(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTaskDefinitionWithContext", reflect.TypeOf((*MockECSAPI)(nil).DeregisterTaskDefinitionWithContext), varargs...)
 }
 
+// DescribeCapacityProviders mocks base method
+func (m *MockECSAPI) DescribeCapacityProviders(arg0 *ecs.DescribeCapacityProvidersInput) (*ecs.DescribeCapacityProvidersOutput, error) {
+	ret := m.ctrl.Call(m, "DescribeCapacityProviders", arg0)
+	ret0, _ := ret[0].(*ecs.DescribeCapacityProvidersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCapacityProviders indicates an expected call of DescribeCapacityProviders
+func (mr *MockECSAPIMockRecorder) DescribeCapacityProviders(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCapacityProviders", reflect.TypeOf((*MockECSAPI)(nil).DescribeCapacityProviders), arg0)
+}
+
+// DescribeCapacityProvidersRequest mocks base method
+func (m *MockECSAPI) DescribeCapacityProvidersRequest(arg0 *ecs.DescribeCapacityProvidersInput) (*request.Request, *ecs.DescribeCapacityProvidersOutput) {
+	ret := m.ctrl.Call(m, "DescribeCapacityProvidersRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecs.DescribeCapacityProvidersOutput)
+	return ret0, ret1
+}
+
+// DescribeCapacityProvidersRequest indicates an expected call of DescribeCapacityProvidersRequest
+func (mr *MockECSAPIMockRecorder) DescribeCapacityProvidersRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCapacityProvidersRequest", reflect.TypeOf((*MockECSAPI)(nil).DescribeCapacityProvidersRequest), arg0)
+}
+
+// DescribeCapacityProvidersWithContext mocks base method
+func (m *MockECSAPI) DescribeCapacityProvidersWithContext(arg0 context.Context, arg1 *ecs.DescribeCapacityProvidersInput, arg2 ...request.Option) (*ecs.DescribeCapacityProvidersOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeCapacityProvidersWithContext", varargs...)
+	ret0, _ := ret[0].(*ecs.DescribeCapacityProvidersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCapacityProvidersWithContext indicates an expected call of DescribeCapacityProvidersWithContext
+func (mr *MockECSAPIMockRecorder) DescribeCapacityProvidersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCapacityProvidersWithContext", reflect.TypeOf((*MockECSAPI)(nil).DescribeCapacityProvidersWithContext), varargs...)
+}
+
 // DescribeClusters mocks base method
 func (m *MockECSAPI) DescribeClusters(arg0 *ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error) {
 	ret := m.ctrl.Call(m, "DescribeClusters", arg0)
@@ -577,6 +797,50 @@ func (mr *MockECSAPIMockRecorder) DescribeTaskDefinitionWithContext(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskDefinitionWithContext", reflect.TypeOf((*MockECSAPI)(nil).DescribeTaskDefinitionWithContext), varargs...)
 }
 
+// DescribeTaskSets mocks base method
+func (m *MockECSAPI) DescribeTaskSets(arg0 *ecs.DescribeTaskSetsInput) (*ecs.DescribeTaskSetsOutput, error) {
+	ret := m.ctrl.Call(m, "DescribeTaskSets", arg0)
+	ret0, _ := ret[0].(*ecs.DescribeTaskSetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskSets indicates an expected call of DescribeTaskSets
+func (mr *MockECSAPIMockRecorder) DescribeTaskSets(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskSets", reflect.TypeOf((*MockECSAPI)(nil).DescribeTaskSets), arg0)
+}
+
+// DescribeTaskSetsRequest mocks base method
+func (m *MockECSAPI) DescribeTaskSetsRequest(arg0 *ecs.DescribeTaskSetsInput) (*request.Request, *ecs.DescribeTaskSetsOutput) {
+	ret := m.ctrl.Call(m, "DescribeTaskSetsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecs.DescribeTaskSetsOutput)
+	return ret0, ret1
+}
+
+// DescribeTaskSetsRequest indicates an expected call of DescribeTaskSetsRequest
+func (mr *MockECSAPIMockRecorder) DescribeTaskSetsRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskSetsRequest", reflect.TypeOf((*MockECSAPI)(nil).DescribeTaskSetsRequest), arg0)
+}
+
+// DescribeTaskSetsWithContext mocks base method
+func (m *MockECSAPI) DescribeTaskSetsWithContext(arg0 context.Context, arg1 *ecs.DescribeTaskSetsInput, arg2 ...request.Option) (*ecs.DescribeTaskSetsOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskSetsWithContext", varargs...)
+	ret0, _ := ret[0].(*ecs.DescribeTaskSetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskSetsWithContext indicates an expected call of DescribeTaskSetsWithContext
+func (mr *MockECSAPIMockRecorder) DescribeTaskSetsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskSetsWithContext", reflect.TypeOf((*MockECSAPI)(nil).DescribeTaskSetsWithContext), varargs...)
+}
+
 // DescribeTasks mocks base method
 func (m *MockECSAPI) DescribeTasks(arg0 *ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error) {
 	ret := m.ctrl.Call(m, "DescribeTasks", arg0)
@@ -665,6 +929,50 @@ func (mr *MockECSAPIMockRecorder) DiscoverPollEndpointWithContext(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverPollEndpointWithContext", reflect.TypeOf((*MockECSAPI)(nil).DiscoverPollEndpointWithContext), varargs...)
 }
 
+// ExecuteCommand mocks base method
+func (m *MockECSAPI) ExecuteCommand(arg0 *ecs.ExecuteCommandInput) (*ecs.ExecuteCommandOutput, error) {
+	ret := m.ctrl.Call(m, "ExecuteCommand", arg0)
+	ret0, _ := ret[0].(*ecs.ExecuteCommandOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteCommand indicates an expected call of ExecuteCommand
+func (mr *MockECSAPIMockRecorder) ExecuteCommand(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockECSAPI)(nil).ExecuteCommand), arg0)
+}
+
+// ExecuteCommandRequest mocks base method
+func (m *MockECSAPI) ExecuteCommandRequest(arg0 *ecs.ExecuteCommandInput) (*request.Request, *ecs.ExecuteCommandOutput) {
+	ret := m.ctrl.Call(m, "ExecuteCommandRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecs.ExecuteCommandOutput)
+	return ret0, ret1
+}
+
+// ExecuteCommandRequest indicates an expected call of ExecuteCommandRequest
+func (mr *MockECSAPIMockRecorder) ExecuteCommandRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommandRequest", reflect.TypeOf((*MockECSAPI)(nil).ExecuteCommandRequest), arg0)
+}
+
+// ExecuteCommandWithContext mocks base method
+func (m *MockECSAPI) ExecuteCommandWithContext(arg0 context.Context, arg1 *ecs.ExecuteCommandInput, arg2 ...request.Option) (*ecs.ExecuteCommandOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExecuteCommandWithContext", varargs...)
+	ret0, _ := ret[0].(*ecs.ExecuteCommandOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteCommandWithContext indicates an expected call of ExecuteCommandWithContext
+func (mr *MockECSAPIMockRecorder) ExecuteCommandWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommandWithContext", reflect.TypeOf((*MockECSAPI)(nil).ExecuteCommandWithContext), varargs...)
+}
+
 // ListAccountSettings mocks base method
 func (m *MockECSAPI) ListAccountSettings(arg0 *ecs.ListAccountSettingsInput) (*ecs.ListAccountSettingsOutput, error) {
 	ret := m.ctrl.Call(m, "ListAccountSettings", arg0)
@@ -678,6 +986,35 @@ func (mr *MockECSAPIMockRecorder) ListAccountSettings(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountSettings", reflect.TypeOf((*MockECSAPI)(nil).ListAccountSettings), arg0)
 }
 
+// ListAccountSettingsPages mocks base method
+func (m *MockECSAPI) ListAccountSettingsPages(arg0 *ecs.ListAccountSettingsInput, arg1 func(*ecs.ListAccountSettingsOutput, bool) bool) error {
+	ret := m.ctrl.Call(m, "ListAccountSettingsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAccountSettingsPages indicates an expected call of ListAccountSettingsPages
+func (mr *MockECSAPIMockRecorder) ListAccountSettingsPages(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountSettingsPages", reflect.TypeOf((*MockECSAPI)(nil).ListAccountSettingsPages), arg0, arg1)
+}
+
+// ListAccountSettingsPagesWithContext mocks base method
+func (m *MockECSAPI) ListAccountSettingsPagesWithContext(arg0 context.Context, arg1 *ecs.ListAccountSettingsInput, arg2 func(*ecs.ListAccountSettingsOutput, bool) bool, arg3 ...request.Option) error {
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAccountSettingsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAccountSettingsPagesWithContext indicates an expected call of ListAccountSettingsPagesWithContext
+func (mr *MockECSAPIMockRecorder) ListAccountSettingsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountSettingsPagesWithContext", reflect.TypeOf((*MockECSAPI)(nil).ListAccountSettingsPagesWithContext), varargs...)
+}
+
 // ListAccountSettingsRequest mocks base method
 func (m *MockECSAPI) ListAccountSettingsRequest(arg0 *ecs.ListAccountSettingsInput) (*request.Request, *ecs.ListAccountSettingsOutput) {
 	ret := m.ctrl.Call(m, "ListAccountSettingsRequest", arg0)
@@ -722,6 +1059,35 @@ func (mr *MockECSAPIMockRecorder) ListAttributes(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttributes", reflect.TypeOf((*MockECSAPI)(nil).ListAttributes), arg0)
 }
 
+// ListAttributesPages mocks base method
+func (m *MockECSAPI) ListAttributesPages(arg0 *ecs.ListAttributesInput, arg1 func(*ecs.ListAttributesOutput, bool) bool) error {
+	ret := m.ctrl.Call(m, "ListAttributesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAttributesPages indicates an expected call of ListAttributesPages
+func (mr *MockECSAPIMockRecorder) ListAttributesPages(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttributesPages", reflect.TypeOf((*MockECSAPI)(nil).ListAttributesPages), arg0, arg1)
+}
+
+// ListAttributesPagesWithContext mocks base method
+func (m *MockECSAPI) ListAttributesPagesWithContext(arg0 context.Context, arg1 *ecs.ListAttributesInput, arg2 func(*ecs.ListAttributesOutput, bool) bool, arg3 ...request.Option) error {
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAttributesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAttributesPagesWithContext indicates an expected call of ListAttributesPagesWithContext
+func (mr *MockECSAPIMockRecorder) ListAttributesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttributesPagesWithContext", reflect.TypeOf((*MockECSAPI)(nil).ListAttributesPagesWithContext), varargs...)
+}
+
 // ListAttributesRequest mocks base method
 func (m *MockECSAPI) ListAttributesRequest(arg0 *ecs.ListAttributesInput) (*request.Request, *ecs.ListAttributesOutput) {
 	ret := m.ctrl.Call(m, "ListAttributesRequest", arg0)
@@ -1367,6 +1733,50 @@ func (mr *MockECSAPIMockRecorder) PutAttributesWithContext(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAttributesWithContext", reflect.TypeOf((*MockECSAPI)(nil).PutAttributesWithContext), varargs...)
 }
 
+// PutClusterCapacityProviders mocks base method
+func (m *MockECSAPI) PutClusterCapacityProviders(arg0 *ecs.PutClusterCapacityProvidersInput) (*ecs.PutClusterCapacityProvidersOutput, error) {
+	ret := m.ctrl.Call(m, "PutClusterCapacityProviders", arg0)
+	ret0, _ := ret[0].(*ecs.PutClusterCapacityProvidersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutClusterCapacityProviders indicates an expected call of PutClusterCapacityProviders
+func (mr *MockECSAPIMockRecorder) PutClusterCapacityProviders(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutClusterCapacityProviders", reflect.TypeOf((*MockECSAPI)(nil).PutClusterCapacityProviders), arg0)
+}
+
+// PutClusterCapacityProvidersRequest mocks base method
+func (m *MockECSAPI) PutClusterCapacityProvidersRequest(arg0 *ecs.PutClusterCapacityProvidersInput) (*request.Request, *ecs.PutClusterCapacityProvidersOutput) {
+	ret := m.ctrl.Call(m, "PutClusterCapacityProvidersRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecs.PutClusterCapacityProvidersOutput)
+	return ret0, ret1
+}
+
+// PutClusterCapacityProvidersRequest indicates an expected call of PutClusterCapacityProvidersRequest
+func (mr *MockECSAPIMockRecorder) PutClusterCapacityProvidersRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutClusterCapacityProvidersRequest", reflect.TypeOf((*MockECSAPI)(nil).PutClusterCapacityProvidersRequest), arg0)
+}
+
+// PutClusterCapacityProvidersWithContext mocks base method
+func (m *MockECSAPI) PutClusterCapacityProvidersWithContext(arg0 context.Context, arg1 *ecs.PutClusterCapacityProvidersInput, arg2 ...request.Option) (*ecs.PutClusterCapacityProvidersOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutClusterCapacityProvidersWithContext", varargs...)
+	ret0, _ := ret[0].(*ecs.PutClusterCapacityProvidersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutClusterCapacityProvidersWithContext indicates an expected call of PutClusterCapacityProvidersWithContext
+func (mr *MockECSAPIMockRecorder) PutClusterCapacityProvidersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutClusterCapacityProvidersWithContext", reflect.TypeOf((*MockECSAPI)(nil).PutClusterCapacityProvidersWithContext), varargs...)
+}
+
 // RegisterContainerInstance mocks base method
 func (m *MockECSAPI) RegisterContainerInstance(arg0 *ecs.RegisterContainerInstanceInput) (*ecs.RegisterContainerInstanceOutput, error) {
 	ret := m.ctrl.Call(m, "RegisterContainerInstance", arg0)
@@ -1587,6 +1997,50 @@ func (mr *MockECSAPIMockRecorder) StopTaskWithContext(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTaskWithContext", reflect.TypeOf((*MockECSAPI)(nil).StopTaskWithContext), varargs...)
 }
 
+// SubmitAttachmentStateChanges mocks base method
+func (m *MockECSAPI) SubmitAttachmentStateChanges(arg0 *ecs.SubmitAttachmentStateChangesInput) (*ecs.SubmitAttachmentStateChangesOutput, error) {
+	ret := m.ctrl.Call(m, "SubmitAttachmentStateChanges", arg0)
+	ret0, _ := ret[0].(*ecs.SubmitAttachmentStateChangesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitAttachmentStateChanges indicates an expected call of SubmitAttachmentStateChanges
+func (mr *MockECSAPIMockRecorder) SubmitAttachmentStateChanges(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAttachmentStateChanges", reflect.TypeOf((*MockECSAPI)(nil).SubmitAttachmentStateChanges), arg0)
+}
+
+// SubmitAttachmentStateChangesRequest mocks base method
+func (m *MockECSAPI) SubmitAttachmentStateChangesRequest(arg0 *ecs.SubmitAttachmentStateChangesInput) (*request.Request, *ecs.SubmitAttachmentStateChangesOutput) {
+	ret := m.ctrl.Call(m, "SubmitAttachmentStateChangesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecs.SubmitAttachmentStateChangesOutput)
+	return ret0, ret1
+}
+
+// SubmitAttachmentStateChangesRequest indicates an expected call of SubmitAttachmentStateChangesRequest
+func (mr *MockECSAPIMockRecorder) SubmitAttachmentStateChangesRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAttachmentStateChangesRequest", reflect.TypeOf((*MockECSAPI)(nil).SubmitAttachmentStateChangesRequest), arg0)
+}
+
+// SubmitAttachmentStateChangesWithContext mocks base method
+func (m *MockECSAPI) SubmitAttachmentStateChangesWithContext(arg0 context.Context, arg1 *ecs.SubmitAttachmentStateChangesInput, arg2 ...request.Option) (*ecs.SubmitAttachmentStateChangesOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubmitAttachmentStateChangesWithContext", varargs...)
+	ret0, _ := ret[0].(*ecs.SubmitAttachmentStateChangesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitAttachmentStateChangesWithContext indicates an expected call of SubmitAttachmentStateChangesWithContext
+func (mr *MockECSAPIMockRecorder) SubmitAttachmentStateChangesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAttachmentStateChangesWithContext", reflect.TypeOf((*MockECSAPI)(nil).SubmitAttachmentStateChangesWithContext), varargs...)
+}
+
 // SubmitContainerStateChange mocks base method
 func (m *MockECSAPI) SubmitContainerStateChange(arg0 *ecs.SubmitContainerStateChangeInput) (*ecs.SubmitContainerStateChangeOutput, error) {
 	ret := m.ctrl.Call(m, "SubmitContainerStateChange", arg0)
@@ -1763,6 +2217,138 @@ func (mr *MockECSAPIMockRecorder) UntagResourceWithContext(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceWithContext", reflect.TypeOf((*MockECSAPI)(nil).UntagResourceWithContext), varargs...)
 }
 
+// UpdateCapacityProvider mocks base method
+func (m *MockECSAPI) UpdateCapacityProvider(arg0 *ecs.UpdateCapacityProviderInput) (*ecs.UpdateCapacityProviderOutput, error) {
+	ret := m.ctrl.Call(m, "UpdateCapacityProvider", arg0)
+	ret0, _ := ret[0].(*ecs.UpdateCapacityProviderOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCapacityProvider indicates an expected call of UpdateCapacityProvider
+func (mr *MockECSAPIMockRecorder) UpdateCapacityProvider(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCapacityProvider", reflect.TypeOf((*MockECSAPI)(nil).UpdateCapacityProvider), arg0)
+}
+
+// UpdateCapacityProviderRequest mocks base method
+func (m *MockECSAPI) UpdateCapacityProviderRequest(arg0 *ecs.UpdateCapacityProviderInput) (*request.Request, *ecs.UpdateCapacityProviderOutput) {
+	ret := m.ctrl.Call(m, "UpdateCapacityProviderRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecs.UpdateCapacityProviderOutput)
+	return ret0, ret1
+}
+
+// UpdateCapacityProviderRequest indicates an expected call of UpdateCapacityProviderRequest
+func (mr *MockECSAPIMockRecorder) UpdateCapacityProviderRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCapacityProviderRequest", reflect.TypeOf((*MockECSAPI)(nil).UpdateCapacityProviderRequest), arg0)
+}
+
+// UpdateCapacityProviderWithContext mocks base method
+func (m *MockECSAPI) UpdateCapacityProviderWithContext(arg0 context.Context, arg1 *ecs.UpdateCapacityProviderInput, arg2 ...request.Option) (*ecs.UpdateCapacityProviderOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCapacityProviderWithContext", varargs...)
+	ret0, _ := ret[0].(*ecs.UpdateCapacityProviderOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCapacityProviderWithContext indicates an expected call of UpdateCapacityProviderWithContext
+func (mr *MockECSAPIMockRecorder) UpdateCapacityProviderWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCapacityProviderWithContext", reflect.TypeOf((*MockECSAPI)(nil).UpdateCapacityProviderWithContext), varargs...)
+}
+
+// UpdateCluster mocks base method
+func (m *MockECSAPI) UpdateCluster(arg0 *ecs.UpdateClusterInput) (*ecs.UpdateClusterOutput, error) {
+	ret := m.ctrl.Call(m, "UpdateCluster", arg0)
+	ret0, _ := ret[0].(*ecs.UpdateClusterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCluster indicates an expected call of UpdateCluster
+func (mr *MockECSAPIMockRecorder) UpdateCluster(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCluster", reflect.TypeOf((*MockECSAPI)(nil).UpdateCluster), arg0)
+}
+
+// UpdateClusterRequest mocks base method
+func (m *MockECSAPI) UpdateClusterRequest(arg0 *ecs.UpdateClusterInput) (*request.Request, *ecs.UpdateClusterOutput) {
+	ret := m.ctrl.Call(m, "UpdateClusterRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecs.UpdateClusterOutput)
+	return ret0, ret1
+}
+
+// UpdateClusterRequest indicates an expected call of UpdateClusterRequest
+func (mr *MockECSAPIMockRecorder) UpdateClusterRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterRequest", reflect.TypeOf((*MockECSAPI)(nil).UpdateClusterRequest), arg0)
+}
+
+// UpdateClusterSettings mocks base method
+func (m *MockECSAPI) UpdateClusterSettings(arg0 *ecs.UpdateClusterSettingsInput) (*ecs.UpdateClusterSettingsOutput, error) {
+	ret := m.ctrl.Call(m, "UpdateClusterSettings", arg0)
+	ret0, _ := ret[0].(*ecs.UpdateClusterSettingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterSettings indicates an expected call of UpdateClusterSettings
+func (mr *MockECSAPIMockRecorder) UpdateClusterSettings(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterSettings", reflect.TypeOf((*MockECSAPI)(nil).UpdateClusterSettings), arg0)
+}
+
+// UpdateClusterSettingsRequest mocks base method
+func (m *MockECSAPI) UpdateClusterSettingsRequest(arg0 *ecs.UpdateClusterSettingsInput) (*request.Request, *ecs.UpdateClusterSettingsOutput) {
+	ret := m.ctrl.Call(m, "UpdateClusterSettingsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecs.UpdateClusterSettingsOutput)
+	return ret0, ret1
+}
+
+// UpdateClusterSettingsRequest indicates an expected call of UpdateClusterSettingsRequest
+func (mr *MockECSAPIMockRecorder) UpdateClusterSettingsRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterSettingsRequest", reflect.TypeOf((*MockECSAPI)(nil).UpdateClusterSettingsRequest), arg0)
+}
+
+// UpdateClusterSettingsWithContext mocks base method
+func (m *MockECSAPI) UpdateClusterSettingsWithContext(arg0 context.Context, arg1 *ecs.UpdateClusterSettingsInput, arg2 ...request.Option) (*ecs.UpdateClusterSettingsOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateClusterSettingsWithContext", varargs...)
+	ret0, _ := ret[0].(*ecs.UpdateClusterSettingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterSettingsWithContext indicates an expected call of UpdateClusterSettingsWithContext
+func (mr *MockECSAPIMockRecorder) UpdateClusterSettingsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterSettingsWithContext", reflect.TypeOf((*MockECSAPI)(nil).UpdateClusterSettingsWithContext), varargs...)
+}
+
+// UpdateClusterWithContext mocks base method
+func (m *MockECSAPI) UpdateClusterWithContext(arg0 context.Context, arg1 *ecs.UpdateClusterInput, arg2 ...request.Option) (*ecs.UpdateClusterOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateClusterWithContext", varargs...)
+	ret0, _ := ret[0].(*ecs.UpdateClusterOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterWithContext indicates an expected call of UpdateClusterWithContext
+func (mr *MockECSAPIMockRecorder) UpdateClusterWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterWithContext", reflect.TypeOf((*MockECSAPI)(nil).UpdateClusterWithContext), varargs...)
+}
+
 // UpdateContainerAgent mocks base method
 func (m *MockECSAPI) UpdateContainerAgent(arg0 *ecs.UpdateContainerAgentInput) (*ecs.UpdateContainerAgentOutput, error) {
 	ret := m.ctrl.Call(m, "UpdateContainerAgent", arg0)
@@ -1864,6 +2450,50 @@ func (mr *MockECSAPIMockRecorder) UpdateService(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateService", reflect.TypeOf((*MockECSAPI)(nil).UpdateService), arg0)
 }
 
+// UpdateServicePrimaryTaskSet mocks base method
+func (m *MockECSAPI) UpdateServicePrimaryTaskSet(arg0 *ecs.UpdateServicePrimaryTaskSetInput) (*ecs.UpdateServicePrimaryTaskSetOutput, error) {
+	ret := m.ctrl.Call(m, "UpdateServicePrimaryTaskSet", arg0)
+	ret0, _ := ret[0].(*ecs.UpdateServicePrimaryTaskSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServicePrimaryTaskSet indicates an expected call of UpdateServicePrimaryTaskSet
+func (mr *MockECSAPIMockRecorder) UpdateServicePrimaryTaskSet(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServicePrimaryTaskSet", reflect.TypeOf((*MockECSAPI)(nil).UpdateServicePrimaryTaskSet), arg0)
+}
+
+// UpdateServicePrimaryTaskSetRequest mocks base method
+func (m *MockECSAPI) UpdateServicePrimaryTaskSetRequest(arg0 *ecs.UpdateServicePrimaryTaskSetInput) (*request.Request, *ecs.UpdateServicePrimaryTaskSetOutput) {
+	ret := m.ctrl.Call(m, "UpdateServicePrimaryTaskSetRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecs.UpdateServicePrimaryTaskSetOutput)
+	return ret0, ret1
+}
+
+// UpdateServicePrimaryTaskSetRequest indicates an expected call of UpdateServicePrimaryTaskSetRequest
+func (mr *MockECSAPIMockRecorder) UpdateServicePrimaryTaskSetRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServicePrimaryTaskSetRequest", reflect.TypeOf((*MockECSAPI)(nil).UpdateServicePrimaryTaskSetRequest), arg0)
+}
+
+// UpdateServicePrimaryTaskSetWithContext mocks base method
+func (m *MockECSAPI) UpdateServicePrimaryTaskSetWithContext(arg0 context.Context, arg1 *ecs.UpdateServicePrimaryTaskSetInput, arg2 ...request.Option) (*ecs.UpdateServicePrimaryTaskSetOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServicePrimaryTaskSetWithContext", varargs...)
+	ret0, _ := ret[0].(*ecs.UpdateServicePrimaryTaskSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServicePrimaryTaskSetWithContext indicates an expected call of UpdateServicePrimaryTaskSetWithContext
+func (mr *MockECSAPIMockRecorder) UpdateServicePrimaryTaskSetWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServicePrimaryTaskSetWithContext", reflect.TypeOf((*MockECSAPI)(nil).UpdateServicePrimaryTaskSetWithContext), varargs...)
+}
+
 // UpdateServiceRequest mocks base method
 func (m *MockECSAPI) UpdateServiceRequest(arg0 *ecs.UpdateServiceInput) (*request.Request, *ecs.UpdateServiceOutput) {
 	ret := m.ctrl.Call(m, "UpdateServiceRequest", arg0)
@@ -1895,6 +2525,50 @@ func (mr *MockECSAPIMockRecorder) UpdateServiceWithContext(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceWithContext", reflect.TypeOf((*MockECSAPI)(nil).UpdateServiceWithContext), varargs...)
 }
 
+// UpdateTaskSet mocks base method
+func (m *MockECSAPI) UpdateTaskSet(arg0 *ecs.UpdateTaskSetInput) (*ecs.UpdateTaskSetOutput, error) {
+	ret := m.ctrl.Call(m, "UpdateTaskSet", arg0)
+	ret0, _ := ret[0].(*ecs.UpdateTaskSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskSet indicates an expected call of UpdateTaskSet
+func (mr *MockECSAPIMockRecorder) UpdateTaskSet(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskSet", reflect.TypeOf((*MockECSAPI)(nil).UpdateTaskSet), arg0)
+}
+
+// UpdateTaskSetRequest mocks base method
+func (m *MockECSAPI) UpdateTaskSetRequest(arg0 *ecs.UpdateTaskSetInput) (*request.Request, *ecs.UpdateTaskSetOutput) {
+	ret := m.ctrl.Call(m, "UpdateTaskSetRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ecs.UpdateTaskSetOutput)
+	return ret0, ret1
+}
+
+// UpdateTaskSetRequest indicates an expected call of UpdateTaskSetRequest
+func (mr *MockECSAPIMockRecorder) UpdateTaskSetRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskSetRequest", reflect.TypeOf((*MockECSAPI)(nil).UpdateTaskSetRequest), arg0)
+}
+
+// UpdateTaskSetWithContext mocks base method
+func (m *MockECSAPI) UpdateTaskSetWithContext(arg0 context.Context, arg1 *ecs.UpdateTaskSetInput, arg2 ...request.Option) (*ecs.UpdateTaskSetOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskSetWithContext", varargs...)
+	ret0, _ := ret[0].(*ecs.UpdateTaskSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskSetWithContext indicates an expected call of UpdateTaskSetWithContext
+func (mr *MockECSAPIMockRecorder) UpdateTaskSetWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskSetWithContext", reflect.TypeOf((*MockECSAPI)(nil).UpdateTaskSetWithContext), varargs...)
+}
+
 // WaitUntilServicesInactive mocks base method
 func (m *MockECSAPI) WaitUntilServicesInactive(arg0 *ecs.DescribeServicesInput) error {
 	ret := m.ctrl.Call(m, "WaitUntilServicesInactive", arg0)
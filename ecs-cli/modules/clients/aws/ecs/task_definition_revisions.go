@@ -0,0 +1,48 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ecs
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	log "github.com/sirupsen/logrus"
+)
+
+// ListTaskDefinitionRevisions returns the ARNs of the active revisions of a task definition family, newest first.
+// Families which only start with the name of the family are left out.
+func (c *ecsClient) ListTaskDefinitionRevisions(family string) ([]string, error) {
+	arns := []string{}
+	familyResource := "/" + family + ":"
+	err := c.client.ListTaskDefinitionsPagesWithContext(c.config.Context(), &ecs.ListTaskDefinitionsInput{
+		FamilyPrefix: aws.String(family),
+		Sort:         aws.String(ecs.SortOrderDesc),
+	}, func(page *ecs.ListTaskDefinitionsOutput, lastPage bool) bool {
+		for _, arn := range aws.StringValueSlice(page.TaskDefinitionArns) {
+			if strings.Contains(arn, familyResource) {
+				arns = append(arns, arn)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"family": family,
+			"error":  err,
+		}).Error("Error listing task definitions")
+		return nil, err
+	}
+	return arns, nil
+}
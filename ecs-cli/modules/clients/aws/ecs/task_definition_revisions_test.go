@@ -0,0 +1,58 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ecs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const taskDefinitionArnPrefix = "arn:aws:ecs:us-west-2:123456789012:task-definition/"
+
+func TestListTaskDefinitionRevisions(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().ListTaskDefinitionsPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Do(func(_, x, y interface{}) {
+		req := x.(*ecs.ListTaskDefinitionsInput)
+		assert.Equal(t, "web", aws.StringValue(req.FamilyPrefix), "Expected FamilyPrefix to match")
+		assert.Equal(t, ecs.SortOrderDesc, aws.StringValue(req.Sort), "Expected newest revisions first")
+
+		funct := y.(func(page *ecs.ListTaskDefinitionsOutput, lastPage bool) bool)
+		funct(&ecs.ListTaskDefinitionsOutput{TaskDefinitionArns: aws.StringSlice([]string{
+			taskDefinitionArnPrefix + "web-worker:4", taskDefinitionArnPrefix + "web:3", taskDefinitionArnPrefix + "web:2",
+		})}, false)
+		funct(&ecs.ListTaskDefinitionsOutput{TaskDefinitionArns: aws.StringSlice([]string{taskDefinitionArnPrefix + "web:1"})}, true)
+	}).Return(nil)
+
+	arns, err := client.ListTaskDefinitionRevisions("web")
+	assert.NoError(t, err, "Unexpected error listing task definitions")
+	assert.Equal(t, []string{taskDefinitionArnPrefix + "web:3", taskDefinitionArnPrefix + "web:2", taskDefinitionArnPrefix + "web:1"}, arns,
+		"Expected only the revisions of the family")
+}
+
+func TestListTaskDefinitionRevisionsErrorCase(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().ListTaskDefinitionsPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("something failed"))
+
+	_, err := client.ListTaskDefinitionRevisions("web")
+	assert.Error(t, err, "Expected error listing task definitions")
+}
@@ -0,0 +1,125 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ecs
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// TaskSetStatusPrimary is the status of the task set serving production traffic
+	TaskSetStatusPrimary = "PRIMARY"
+	// TaskSetStatusActive is the status of a task set that is not serving production traffic
+	TaskSetStatusActive = "ACTIVE"
+)
+
+// CreateTaskSet creates a task set in a service using the EXTERNAL deployment controller
+func (c *ecsClient) CreateTaskSet(input *ecs.CreateTaskSetInput) (*ecs.TaskSet, error) {
+	if input.Cluster == nil {
+		input.Cluster = aws.String(c.config.Cluster)
+	}
+	output, err := c.client.CreateTaskSetWithContext(c.config.Context(), input)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"service": aws.StringValue(input.Service),
+			"error":   err,
+		}).Error("Error creating task set")
+		return nil, err
+	}
+	return output.TaskSet, nil
+}
+
+// DescribeTaskSet describes a task set of a service
+func (c *ecsClient) DescribeTaskSet(serviceName, taskSetID string) (*ecs.TaskSet, error) {
+	output, err := c.client.DescribeTaskSetsWithContext(c.config.Context(), &ecs.DescribeTaskSetsInput{
+		Cluster:  aws.String(c.config.Cluster),
+		Service:  aws.String(serviceName),
+		TaskSets: []*string{aws.String(taskSetID)},
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"service": serviceName,
+			"taskSet": taskSetID,
+			"error":   err,
+		}).Error("Error describing task set")
+		return nil, err
+	}
+	if len(output.Failures) > 0 {
+		return nil, fmt.Errorf("Got an error describing task set '%s' : '%s'", taskSetID, aws.StringValue(output.Failures[0].Reason))
+	}
+	if len(output.TaskSets) == 0 {
+		return nil, fmt.Errorf("Got an empty list of task sets while describing the task set '%s'", taskSetID)
+	}
+	return output.TaskSets[0], nil
+}
+
+// UpdateTaskSetScale sets the scale of a task set, as a percent of the desired count of its service
+func (c *ecsClient) UpdateTaskSetScale(serviceName, taskSetID string, percent float64) error {
+	_, err := c.client.UpdateTaskSetWithContext(c.config.Context(), &ecs.UpdateTaskSetInput{
+		Cluster: aws.String(c.config.Cluster),
+		Service: aws.String(serviceName),
+		TaskSet: aws.String(taskSetID),
+		Scale: &ecs.Scale{
+			Unit:  aws.String(ecs.ScaleUnitPercent),
+			Value: aws.Float64(percent),
+		},
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"service": serviceName,
+			"taskSet": taskSetID,
+			"error":   err,
+		}).Error("Error updating task set")
+	}
+	return err
+}
+
+// UpdateServicePrimaryTaskSet makes a task set the primary task set of its service
+func (c *ecsClient) UpdateServicePrimaryTaskSet(serviceName, taskSetID string) error {
+	_, err := c.client.UpdateServicePrimaryTaskSetWithContext(c.config.Context(), &ecs.UpdateServicePrimaryTaskSetInput{
+		Cluster:        aws.String(c.config.Cluster),
+		Service:        aws.String(serviceName),
+		PrimaryTaskSet: aws.String(taskSetID),
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"service": serviceName,
+			"taskSet": taskSetID,
+			"error":   err,
+		}).Error("Error updating primary task set")
+	}
+	return err
+}
+
+// DeleteTaskSet deletes a task set, even if it has not been scaled down to zero
+func (c *ecsClient) DeleteTaskSet(serviceName, taskSetID string) error {
+	_, err := c.client.DeleteTaskSetWithContext(c.config.Context(), &ecs.DeleteTaskSetInput{
+		Cluster: aws.String(c.config.Cluster),
+		Service: aws.String(serviceName),
+		TaskSet: aws.String(taskSetID),
+		Force:   aws.Bool(true),
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"service": serviceName,
+			"taskSet": taskSetID,
+			"error":   err,
+		}).Error("Error deleting task set")
+	}
+	return err
+}
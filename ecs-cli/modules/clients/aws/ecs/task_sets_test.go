@@ -0,0 +1,160 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ecs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTaskSet(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().CreateTaskSetWithContext(gomock.Any(), gomock.Any()).Do(func(_, input interface{}) {
+		req := input.(*ecs.CreateTaskSetInput)
+		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
+		assert.Equal(t, "web", aws.StringValue(req.Service), "Expected service to match")
+		assert.Equal(t, "web:2", aws.StringValue(req.TaskDefinition), "Expected taskDefinition to match")
+		assert.Equal(t, float64(10), aws.Float64Value(req.Scale.Value), "Expected scale to match")
+	}).Return(&ecs.CreateTaskSetOutput{
+		TaskSet: &ecs.TaskSet{
+			Id:              aws.String("ecs-svc/123"),
+			StabilityStatus: aws.String(ecs.StabilityStatusStabilizing),
+		},
+	}, nil)
+
+	taskSet, err := client.CreateTaskSet(&ecs.CreateTaskSetInput{
+		Service:        aws.String("web"),
+		TaskDefinition: aws.String("web:2"),
+		Scale: &ecs.Scale{
+			Unit:  aws.String(ecs.ScaleUnitPercent),
+			Value: aws.Float64(10),
+		},
+	})
+	assert.NoError(t, err, "Unexpected error creating task set")
+	if assert.NotNil(t, taskSet, "Expected the created task set") {
+		assert.Equal(t, "ecs-svc/123", aws.StringValue(taskSet.Id))
+	}
+}
+
+func TestCreateServiceWithExternalDeploymentController(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().CreateServiceWithContext(gomock.Any(), gomock.Any()).Do(func(_, input interface{}) {
+		req := input.(*ecs.CreateServiceInput)
+		assert.Equal(t, ecs.DeploymentControllerTypeExternal, aws.StringValue(req.DeploymentController.Type))
+		assert.Nil(t, req.TaskDefinition, "Expected no task definition")
+	}).Return(&ecs.CreateServiceOutput{}, nil)
+
+	err := client.CreateService(&ecs.CreateServiceInput{
+		ServiceName:  aws.String("web"),
+		DesiredCount: aws.Int64(0),
+		DeploymentController: &ecs.DeploymentController{
+			Type: aws.String(ecs.DeploymentControllerTypeExternal),
+		},
+	})
+	assert.NoError(t, err, "Unexpected error creating service without a task definition")
+}
+
+func TestDescribeTaskSet(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().DescribeTaskSetsWithContext(gomock.Any(), gomock.Any()).Do(func(_, input interface{}) {
+		req := input.(*ecs.DescribeTaskSetsInput)
+		assert.Equal(t, clusterName, aws.StringValue(req.Cluster), "Expected clusterName to match")
+		assert.Equal(t, "web", aws.StringValue(req.Service), "Expected service to match")
+		assert.Equal(t, []string{"ecs-svc/123"}, aws.StringValueSlice(req.TaskSets), "Expected task sets to match")
+	}).Return(&ecs.DescribeTaskSetsOutput{
+		TaskSets: []*ecs.TaskSet{{Id: aws.String("ecs-svc/123"), Status: aws.String(TaskSetStatusPrimary)}},
+	}, nil)
+
+	taskSet, err := client.DescribeTaskSet("web", "ecs-svc/123")
+	assert.NoError(t, err, "Unexpected error describing task set")
+	if assert.NotNil(t, taskSet, "Expected the described task set") {
+		assert.Equal(t, TaskSetStatusPrimary, aws.StringValue(taskSet.Status))
+	}
+}
+
+func TestDescribeTaskSetFailure(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().DescribeTaskSetsWithContext(gomock.Any(), gomock.Any()).Return(&ecs.DescribeTaskSetsOutput{
+		Failures: []*ecs.Failure{{Arn: aws.String("ecs-svc/123"), Reason: aws.String("MISSING")}},
+	}, nil)
+
+	_, err := client.DescribeTaskSet("web", "ecs-svc/123")
+	assert.Error(t, err, "Expected error describing missing task set")
+}
+
+func TestUpdateTaskSetScale(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().UpdateTaskSetWithContext(gomock.Any(), gomock.Any()).Do(func(_, input interface{}) {
+		req := input.(*ecs.UpdateTaskSetInput)
+		assert.Equal(t, "ecs-svc/123", aws.StringValue(req.TaskSet), "Expected task set to match")
+		assert.Equal(t, ecs.ScaleUnitPercent, aws.StringValue(req.Scale.Unit), "Expected scale unit to match")
+		assert.Equal(t, float64(0), aws.Float64Value(req.Scale.Value), "Expected scale to match")
+	}).Return(&ecs.UpdateTaskSetOutput{}, nil)
+
+	err := client.UpdateTaskSetScale("web", "ecs-svc/123", 0)
+	assert.NoError(t, err, "Unexpected error updating task set")
+}
+
+func TestUpdateServicePrimaryTaskSet(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().UpdateServicePrimaryTaskSetWithContext(gomock.Any(), gomock.Any()).Do(func(_, input interface{}) {
+		req := input.(*ecs.UpdateServicePrimaryTaskSetInput)
+		assert.Equal(t, "web", aws.StringValue(req.Service), "Expected service to match")
+		assert.Equal(t, "ecs-svc/123", aws.StringValue(req.PrimaryTaskSet), "Expected primary task set to match")
+	}).Return(&ecs.UpdateServicePrimaryTaskSetOutput{}, nil)
+
+	err := client.UpdateServicePrimaryTaskSet("web", "ecs-svc/123")
+	assert.NoError(t, err, "Unexpected error updating primary task set")
+}
+
+func TestDeleteTaskSet(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().DeleteTaskSetWithContext(gomock.Any(), gomock.Any()).Do(func(_, input interface{}) {
+		req := input.(*ecs.DeleteTaskSetInput)
+		assert.Equal(t, "ecs-svc/123", aws.StringValue(req.TaskSet), "Expected task set to match")
+		assert.True(t, aws.BoolValue(req.Force), "Expected the task set to be deleted even if it is not scaled down")
+	}).Return(&ecs.DeleteTaskSetOutput{}, nil)
+
+	err := client.DeleteTaskSet("web", "ecs-svc/123")
+	assert.NoError(t, err, "Unexpected error deleting task set")
+}
+
+func TestDeleteTaskSetErrorCase(t *testing.T) {
+	mockEcs, _, client, ctrl := setupTestController(t, getDefaultCLIConfigParams(t))
+	defer ctrl.Finish()
+
+	mockEcs.EXPECT().DeleteTaskSetWithContext(gomock.Any(), gomock.Any()).Return(nil, errors.New("something failed"))
+
+	err := client.DeleteTaskSet("web", "ecs-svc/123")
+	assert.Error(t, err, "Expected error deleting task set")
+}
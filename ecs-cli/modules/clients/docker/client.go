@@ -14,10 +14,14 @@
 package docker
 
 import (
-	log "github.com/sirupsen/logrus"
+	"os"
+	"sort"
+	"strings"
+
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/clients/docker/dockeriface"
 	"github.com/fsouza/go-dockerclient"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 )
 
 const (
@@ -27,6 +31,8 @@ const (
 // Client is an interface specifying the subset of
 // github.com/fsouza/go-dockerclient.DockerClient that the agent uses.
 type Client interface {
+	BuildImage(image, contextDir, dockerfile string, buildArgs map[string]string) error
+	ImageDigest(repository, tag string, auth docker.AuthConfiguration) (string, error)
 	PullImage(repository, tag string, auth docker.AuthConfiguration) error
 	PushImage(repository, tag, registry string, auth docker.AuthConfiguration) error
 	TagImage(image, repository, tag string) error
@@ -52,6 +58,41 @@ func newClient(client dockeriface.DockerAPI) Client {
 	}
 }
 
+// BuildImage builds the image from the Dockerfile in contextDir, streaming
+// the output of the build to stdout. An empty dockerfile means the
+// Dockerfile at the root of the context.
+func (c *dockerClient) BuildImage(image, contextDir, dockerfile string, buildArgs map[string]string) error {
+	log.WithFields(log.Fields{
+		"image":   image,
+		"context": contextDir,
+	}).Info("Building image")
+
+	names := make([]string, 0, len(buildArgs))
+	for name := range buildArgs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	args := []docker.BuildArg{}
+	for _, name := range names {
+		args = append(args, docker.BuildArg{Name: name, Value: buildArgs[name]})
+	}
+
+	opts := docker.BuildImageOptions{
+		Name:           image,
+		ContextDir:     contextDir,
+		Dockerfile:     dockerfile,
+		BuildArgs:      args,
+		RmTmpContainer: true,
+		OutputStream:   os.Stdout,
+	}
+
+	if err := c.client.BuildImage(opts); err != nil {
+		return errors.Wrap(err, "unable to build image")
+	}
+	log.Info("Image built")
+	return nil
+}
+
 func (c *dockerClient) PushImage(repository, tag, registry string, auth docker.AuthConfiguration) error {
 	log.WithFields(log.Fields{
 		"repository": repository,
@@ -108,3 +149,37 @@ func (c *dockerClient) PullImage(repository, tag string, auth docker.AuthConfigu
 	log.Info("Image pulled")
 	return nil
 }
+
+// ImageDigest pulls repository:tag and returns the digest the registry
+// reported for it, in the format sha256:...
+func (c *dockerClient) ImageDigest(repository, tag string, auth docker.AuthConfiguration) (string, error) {
+	if err := c.PullImage(repository, tag, auth); err != nil {
+		return "", err
+	}
+
+	image, err := c.client.InspectImage(repository + ":" + tag)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to inspect image")
+	}
+	if len(image.RepoDigests) == 0 {
+		return "", errors.Errorf("image %s:%s has no repository digest", repository, tag)
+	}
+
+	// Docker reports Docker Hub repositories by their short name, e.g. nginx
+	// for docker.io/library/nginx
+	name := familiarName(repository)
+	for _, repoDigest := range image.RepoDigests {
+		parts := strings.SplitN(repoDigest, "@", 2)
+		if len(parts) == 2 && familiarName(parts[0]) == name {
+			return parts[1], nil
+		}
+	}
+	// the image was pulled under another name, the manifest is the same
+	log.WithField("repoDigests", image.RepoDigests).Debugf("No repository digest matches %s", repository)
+	return strings.SplitN(image.RepoDigests[0], "@", 2)[1], nil
+}
+
+func familiarName(repository string) string {
+	repository = strings.TrimPrefix(repository, "docker.io/")
+	return strings.TrimPrefix(repository, "library/")
+}
@@ -119,6 +119,39 @@ func TestPullImageErrorCase(t *testing.T) {
 	assert.Error(t, err, "Expected error while PullImage is called")
 }
 
+func TestBuildImage(t *testing.T) {
+	mockDocker, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	image := "web:latest"
+	contextDir := "/src/web"
+	dockerfile := "Dockerfile.prod"
+	buildArgs := map[string]string{"VERSION": "1.2", "COMMIT": "abc"}
+
+	mockDocker.EXPECT().BuildImage(gomock.Any()).Do(func(opts interface{}) {
+		optsInput := opts.(docker.BuildImageOptions)
+		assert.Equal(t, image, optsInput.Name, "Expected image name to match")
+		assert.Equal(t, contextDir, optsInput.ContextDir, "Expected context dir to match")
+		assert.Equal(t, dockerfile, optsInput.Dockerfile, "Expected dockerfile to match")
+		expectedArgs := []docker.BuildArg{{Name: "COMMIT", Value: "abc"}, {Name: "VERSION", Value: "1.2"}}
+		assert.Equal(t, expectedArgs, optsInput.BuildArgs, "Expected build args to be sorted by name")
+		assert.NotNil(t, optsInput.OutputStream, "Expected an output stream")
+	}).Return(nil)
+
+	err := client.BuildImage(image, contextDir, dockerfile, buildArgs)
+	assert.NoError(t, err, "Build Image")
+}
+
+func TestBuildImageErrorCase(t *testing.T) {
+	mockDocker, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDocker.EXPECT().BuildImage(gomock.Any()).Return(errors.New("something failed"))
+
+	err := client.BuildImage("web", "/src/web", "", nil)
+	assert.Error(t, err, "Expected error while BuildImage is called")
+}
+
 func setupTestController(t *testing.T) (*mock_dockeriface.MockDockerAPI, Client, *gomock.Controller) {
 	ctrl := gomock.NewController(t)
 	mockDocker := mock_dockeriface.NewMockDockerAPI(ctrl)
@@ -126,3 +159,40 @@ func setupTestController(t *testing.T) (*mock_dockeriface.MockDockerAPI, Client,
 
 	return mockDocker, client, ctrl
 }
+
+func TestImageDigest(t *testing.T) {
+	mockDocker, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockDocker.EXPECT().PullImage(gomock.Any(), gomock.Any()).Return(nil),
+		mockDocker.EXPECT().InspectImage("docker.io/library/nginx:1.15").Return(&docker.Image{
+			RepoDigests: []string{"registry.example.com/nginx@sha256:other", "nginx@sha256:abc"},
+		}, nil),
+	)
+
+	digest, err := client.ImageDigest("docker.io/library/nginx", "1.15", docker.AuthConfiguration{})
+	assert.NoError(t, err, "Unexpected error getting image digest")
+	assert.Equal(t, "sha256:abc", digest, "Expected digest of the matching repository")
+}
+
+func TestImageDigestNoRepoDigests(t *testing.T) {
+	mockDocker, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDocker.EXPECT().PullImage(gomock.Any(), gomock.Any()).Return(nil)
+	mockDocker.EXPECT().InspectImage("myimage:latest").Return(&docker.Image{}, nil)
+
+	_, err := client.ImageDigest("myimage", "latest", docker.AuthConfiguration{})
+	assert.Error(t, err, "Expected error for an image without repository digest")
+}
+
+func TestImageDigestPullErrorCase(t *testing.T) {
+	mockDocker, client, ctrl := setupTestController(t)
+	defer ctrl.Finish()
+
+	mockDocker.EXPECT().PullImage(gomock.Any(), gomock.Any()).Return(errors.New("something failed"))
+
+	_, err := client.ImageDigest("myimage", "latest", docker.AuthConfiguration{})
+	assert.Error(t, err, "Expected error while ImageDigest is called")
+}
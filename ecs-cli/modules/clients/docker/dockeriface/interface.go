@@ -19,6 +19,8 @@ import "github.com/fsouza/go-dockerclient"
 // DockerAPI is an interface specifying the subset of
 // github.com/fsouza/go-dockerclient.Client
 type DockerAPI interface {
+	BuildImage(opts docker.BuildImageOptions) error
+	InspectImage(name string) (*docker.Image, error)
 	PushImage(opts docker.PushImageOptions, auth docker.AuthConfiguration) error
 	PullImage(opts docker.PullImageOptions, auth docker.AuthConfiguration) error
 	TagImage(name string, opts docker.TagImageOptions) error
@@ -47,6 +47,31 @@ func (m *MockDockerAPI) EXPECT() *MockDockerAPIMockRecorder {
 	return m.recorder
 }
 
+// BuildImage mocks base method
+func (m *MockDockerAPI) BuildImage(arg0 go_dockerclient.BuildImageOptions) error {
+	ret := m.ctrl.Call(m, "BuildImage", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuildImage indicates an expected call of BuildImage
+func (mr *MockDockerAPIMockRecorder) BuildImage(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildImage", reflect.TypeOf((*MockDockerAPI)(nil).BuildImage), arg0)
+}
+
+// InspectImage mocks base method
+func (m *MockDockerAPI) InspectImage(arg0 string) (*go_dockerclient.Image, error) {
+	ret := m.ctrl.Call(m, "InspectImage", arg0)
+	ret0, _ := ret[0].(*go_dockerclient.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InspectImage indicates an expected call of InspectImage
+func (mr *MockDockerAPIMockRecorder) InspectImage(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InspectImage", reflect.TypeOf((*MockDockerAPI)(nil).InspectImage), arg0)
+}
+
 // PullImage mocks base method
 func (m *MockDockerAPI) PullImage(arg0 go_dockerclient.PullImageOptions, arg1 go_dockerclient.AuthConfiguration) error {
 	ret := m.ctrl.Call(m, "PullImage", arg0, arg1)
@@ -47,6 +47,31 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// BuildImage mocks base method
+func (m *MockClient) BuildImage(arg0, arg1, arg2 string, arg3 map[string]string) error {
+	ret := m.ctrl.Call(m, "BuildImage", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuildImage indicates an expected call of BuildImage
+func (mr *MockClientMockRecorder) BuildImage(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildImage", reflect.TypeOf((*MockClient)(nil).BuildImage), arg0, arg1, arg2, arg3)
+}
+
+// ImageDigest mocks base method
+func (m *MockClient) ImageDigest(arg0, arg1 string, arg2 go_dockerclient.AuthConfiguration) (string, error) {
+	ret := m.ctrl.Call(m, "ImageDigest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageDigest indicates an expected call of ImageDigest
+func (mr *MockClientMockRecorder) ImageDigest(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageDigest", reflect.TypeOf((*MockClient)(nil).ImageDigest), arg0, arg1, arg2)
+}
+
 // PullImage mocks base method
 func (m *MockClient) PullImage(arg0, arg1 string, arg2 go_dockerclient.AuthConfiguration) error {
 	ret := m.ctrl.Call(m, "PullImage", arg0, arg1, arg2)
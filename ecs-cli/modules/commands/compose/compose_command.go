@@ -33,6 +33,15 @@ import (
 //   ecs-cli compose start       : invokes ECS.RunTask if count(running tasks) == 0
 //   ecs-cli compose up          : compose create ; compose start and does a deployment of new compose yml if changes were found
 //
+// Review the task definition without calling AWS:
+//   ecs-cli compose convert     : prints the ECS.RegisterTaskDefinition input and run params derived from the compose and ecs-params files
+//
+// Generate the compose and ecs-params files from an existing task definition:
+//   ecs-cli compose import      : calls ECS.DescribeTaskDefinition and writes the equivalent files
+//
+// Print the ecs-params files given with --ecs-params merged in order:
+//   ecs-cli compose params      : does not call AWS
+//
 // List containers in or view details of the project:
 //   ecs-cli compose ps          : calls ECS.ListTasks (running and stopped) filtered with Task group: this project
 //
@@ -60,7 +69,10 @@ func ComposeCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Before: ecscli.BeforeApp,
 		Flags:  flags.AppendFlags(composeFlags(), flags.DebugFlag(), flags.OptionalConfigFlags()),
 		Subcommands: []cli.Command{
+			convertCommand(factory),
 			createCommand(factory),
+			importCommand(),
+			paramsCommand(),
 			psCommand(factory),
 			runCommand(factory),
 			scaleCommand(factory),
@@ -95,14 +107,29 @@ func composeFlags() []cli.Flag {
 			Name:  flags.TaskRoleArnFlag,
 			Usage: "[Optional] Specifies the short name or full Amazon Resource Name (ARN) of the IAM role that containers in this task can assume. All containers in this task are granted the permissions that are specified in this role.",
 		},
-		cli.StringFlag{
+		cli.StringSliceFlag{
 			Name:  flags.ECSParamsFileNameFlag,
-			Usage: "[Optional] Specifies ecs-params file to use. Defaults to " + ecsParamsFileNameDefaultValue + " file, if one exists.",
+			Usage: "[Optional] Specifies one or more ecs-params files to use. Later files are merged over earlier ones. Defaults to " + ecsParamsFileNameDefaultValue + " file, if one exists.",
+			Value: &cli.StringSlice{},
+		},
+		cli.BoolFlag{
+			Name:  flags.ECSParamsLenientFlag,
+			Usage: "[Optional] Logs unknown keys and invalid values in the ecs-params file as warnings instead of failing.",
 		},
 		cli.StringFlag{
 			Name:  flags.RegistryCredsFileNameFlag,
 			Usage: "[Optional] Specifies the ecs-registry-creds file to use. Defaults to latest 'ecs-registry-creds' output file, if one exists.",
 		},
+		cli.StringSliceFlag{
+			Name:  flags.ServicesFlag,
+			Usage: "[Optional] Specifies the compose services to include in the task definition, as a comma separated list or by repeating the flag. Defaults to all services.",
+			Value: &cli.StringSlice{},
+		},
+		cli.StringSliceFlag{
+			Name:  flags.ExcludeServicesFlag,
+			Usage: "[Optional] Specifies compose services to leave out of the task definition, as a comma separated list or by repeating the flag.",
+			Value: &cli.StringSlice{},
+		},
 	}
 }
 
@@ -111,11 +138,40 @@ func createCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Name:         "create",
 		Usage:        "Creates an ECS task definition from your compose file. Note that we do not recommend using plain text environment variables for sensitive information, such as credential data.",
 		Action:       compose.WithProject(factory, compose.ProjectCreate, false),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), resourceTagsFlag(false)),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), resourceTagsFlag(false), flags.OptionalPinImageDigestsFlag()),
 		OnUsageError: flags.UsageErrorFactory("create"),
 	}
 }
 
+func convertCommand(factory composeFactory.ProjectFactory) cli.Command {
+	return cli.Command{
+		Name:         "convert",
+		Usage:        "Prints the ECS task definition and run parameters that would be created from your compose file, without calling AWS.",
+		Action:       compose.WithOfflineProject(factory, compose.ProjectConvert),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalPlatformVersionFlag(), resourceTagsFlag(false), outputFormatFlag()),
+		OnUsageError: flags.UsageErrorFactory("convert"),
+	}
+}
+
+func importCommand() cli.Command {
+	return cli.Command{
+		Name:         "import",
+		Usage:        "Writes a compose file and an ECS params file that are equivalent to an existing ECS task definition.",
+		Action:       compose.ImportTaskDefinition,
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), importFlags()),
+		OnUsageError: flags.UsageErrorFactory("import"),
+	}
+}
+
+func paramsCommand() cli.Command {
+	return cli.Command{
+		Name:         "params",
+		Usage:        "Prints the result of merging the ECS params files given with --" + flags.ECSParamsFileNameFlag + ", in order, without calling AWS.",
+		Action:       compose.PrintECSParams,
+		OnUsageError: flags.UsageErrorFactory("params"),
+	}
+}
+
 func psCommand(factory composeFactory.ProjectFactory) cli.Command {
 	return cli.Command{
 		Name:         "ps",
@@ -132,7 +188,7 @@ func upCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Name:         "up",
 		Usage:        "Creates an ECS task definition from your compose file (if it does not already exist) and runs one instance of that task on your cluster (a combination of create and start).",
 		Action:       compose.WithProject(factory, compose.ProjectUp, false),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), flags.OptionalForceUpdateFlag(), resourceTagsFlag(true), disableECSManagedTagsFlag()),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalPlatformVersionFlag(), flags.OptionalCreateLogsFlag(), flags.OptionalForceUpdateFlag(), resourceTagsFlag(true), disableECSManagedTagsFlag(), flags.OptionalBuildAndPushFlag(), flags.OptionalPinImageDigestsFlag()),
 		OnUsageError: flags.UsageErrorFactory("up"),
 	}
 }
@@ -142,7 +198,7 @@ func startCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Name:         "start",
 		Usage:        "Starts a single task from the task definition created from your compose file.",
 		Action:       compose.WithProject(factory, compose.ProjectStart, false),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), resourceTagsFlag(true), disableECSManagedTagsFlag()),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalPlatformVersionFlag(), flags.OptionalCreateLogsFlag(), resourceTagsFlag(true), disableECSManagedTagsFlag(), flags.OptionalPinImageDigestsFlag()),
 		OnUsageError: flags.UsageErrorFactory("start"),
 	}
 }
@@ -153,7 +209,7 @@ func runCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Usage:        "Starts all containers overriding commands with the supplied one-off commands for the containers.",
 		ArgsUsage:    "[CONTAINER_NAME] [\"COMMAND ...\"] [CONTAINER_NAME] [\"COMMAND ...\"] ...",
 		Action:       compose.WithProject(factory, compose.ProjectRun, false),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), resourceTagsFlag(true), disableECSManagedTagsFlag()),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalAttachFlag(), flags.OptionalRunOverrideFlags(), resourceTagsFlag(true), disableECSManagedTagsFlag(), flags.OptionalPinImageDigestsFlag()),
 		OnUsageError: flags.UsageErrorFactory("run"),
 	}
 }
@@ -174,7 +230,7 @@ func scaleCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Name:         "scale",
 		Usage:        "ecs-cli compose scale [count] - scales the number of running tasks to the specified count.",
 		Action:       compose.WithProject(factory, compose.ProjectScale, false),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), resourceTagsFlag(true), disableECSManagedTagsFlag()),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalPlatformVersionFlag(), resourceTagsFlag(true), disableECSManagedTagsFlag()),
 		OnUsageError: flags.UsageErrorFactory("scale"),
 	}
 }
@@ -192,6 +248,29 @@ func resourceTagsFlag(runTasks bool) []cli.Flag {
 	}
 }
 
+func importFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.TaskDefinitionFlag,
+			Usage: "Specifies the task definition to import, as a family and revision ('family:revision'), a family (the latest ACTIVE revision is used) or a full Amazon Resource Name (ARN).",
+		},
+		cli.BoolFlag{
+			Name:  flags.ForceFlag,
+			Usage: "[Optional] Overwrites the compose and ECS params files if they already exist.",
+		},
+	}
+}
+
+func outputFormatFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.OutputFormatFlag + ", o",
+			Value: compose.ConvertOutputFormatJSON,
+			Usage: "[Optional] Specifies the output format, either " + compose.ConvertOutputFormatJSON + " or " + compose.ConvertOutputFormatYAML + ".",
+		},
+	}
+}
+
 func disableECSManagedTagsFlag() []cli.Flag {
 	return []cli.Flag{
 		cli.BoolFlag{
@@ -25,8 +25,9 @@ import (
 
 //* ----------------- COMPOSE PROJECT with ECS Service ----------------- */
 // Note: A project is scoped to a single compose yaml with multiple containers defined
-// and today, 1 compose.yml has 1:1 mapping with a task definition and a ECS Service.
-// TODO: Split single compose to disjoint task definitions, so they can be run/scaled independently
+// and by default, 1 compose.yml has 1:1 mapping with a task definition and a ECS Service.
+// With --split-services, each compose service gets its own task definition and ECS Service,
+// named after the project and the compose service, and every command acts on all of them.
 //
 // ---- LIFECYCLE ----
 // Create and Start a project with service:
@@ -37,6 +38,7 @@ import (
 //   ecs-cli compose service ps          : calls ECS.ListTasks of this service
 // Modify containers
 //   ecs-cli compose service scale       : calls ECS.UpdateService with new count
+//   ecs-cli compose service abort       : calls ECS.UpdateTaskSet with scale=0 for the task sets of a deployment in progress
 // Stop and delete the project
 //   ecs-cli compose service stop        : calls ECS.UpdateService with count=0
 //   ecs-cli compose service down        : calls ECS.DeleteService
@@ -56,6 +58,10 @@ func ServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 			scaleServiceCommand(factory),
 			stopServiceCommand(factory),
 			rmServiceCommand(factory),
+			abortServiceCommand(factory),
+			diffServiceCommand(factory),
+			revisionsServiceCommand(factory),
+			rollbackServiceCommand(factory),
 		},
 		Flags: flags.OptionalConfigFlags(),
 	}
@@ -66,7 +72,7 @@ func createServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Name:         "create",
 		Usage:        "Creates an ECS service from your compose file. The service is created with a desired count of 0, so no containers are started by this command. Note that we do not recommend using plain text environment variables for sensitive information, such as credential data.",
 		Action:       compose.WithProject(factory, compose.ProjectCreate, true),
-		Flags:        flags.AppendFlags(deploymentConfigFlags(true), loadBalancerFlags(), flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), serviceDiscoveryFlags(), flags.OptionalSchedulingStrategyFlag(), taggingFlags()),
+		Flags:        flags.AppendFlags(deploymentConfigFlags(true), loadBalancerFlags(), flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalPlatformVersionFlag(), flags.OptionalCreateLogsFlag(), serviceDiscoveryFlags(), flags.OptionalSchedulingStrategyFlag(), taggingFlags(), splitServicesFlag(), flags.OptionalPinImageDigestsFlag(), deploymentControllerFlag()),
 		OnUsageError: flags.UsageErrorFactory("create"),
 	}
 }
@@ -76,7 +82,7 @@ func startServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Name:         "start",
 		Usage:        "Starts one copy of each of the containers on an existing ECS service by setting the desired count to 1 (only if the current desired count is 0).",
 		Action:       compose.WithProject(factory, compose.ProjectStart, true),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), flags.OptionalCreateLogsFlag(), ForceNewDeploymentFlag()),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), progressFlag(), flags.OptionalCreateLogsFlag(), ForceNewDeploymentFlag(), splitServicesFlag(), flags.OptionalPinImageDigestsFlag()),
 		OnUsageError: flags.UsageErrorFactory("start"),
 	}
 }
@@ -86,7 +92,7 @@ func upServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Name:         "up",
 		Usage:        "Creates a new ECS service or updates an existing one according to your compose file. For new services or existing services with a current desired count of 0, the desired count for the service is set to 1. For existing services with non-zero desired counts, a new task definition is created to reflect any changes to the compose file and the service is updated to use that task definition. In this case, the desired count does not change.",
 		Action:       compose.WithProject(factory, compose.ProjectUp, true),
-		Flags:        flags.AppendFlags(deploymentConfigFlags(true), loadBalancerFlags(), flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), flags.OptionalLaunchTypeFlag(), flags.OptionalCreateLogsFlag(), ForceNewDeploymentFlag(), serviceDiscoveryFlags(), updateServiceDiscoveryFlags(), flags.OptionalSchedulingStrategyFlag(), taggingFlags()),
+		Flags:        flags.AppendFlags(deploymentConfigFlags(true), loadBalancerFlags(), flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), progressFlag(), flags.OptionalLaunchTypeFlag(), flags.OptionalPlatformVersionFlag(), flags.OptionalCreateLogsFlag(), ForceNewDeploymentFlag(), noRollbackFlag(), serviceDiscoveryFlags(), updateServiceDiscoveryFlags(), flags.OptionalSchedulingStrategyFlag(), taggingFlags(), splitServicesFlag(), flags.OptionalBuildAndPushFlag(), flags.OptionalPinImageDigestsFlag(), deploymentControllerFlag(), taskSetDeploymentFlags()),
 		OnUsageError: flags.UsageErrorFactory("up"),
 	}
 }
@@ -97,7 +103,7 @@ func psServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Aliases:      []string{"list"},
 		Usage:        "Lists all the containers in your cluster that belong to the service created with the compose project.",
 		Action:       compose.WithProject(factory, compose.ProjectPs, true),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalDesiredStatusFlag()),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), flags.OptionalDesiredStatusFlag(), splitServicesFlag()),
 		OnUsageError: flags.UsageErrorFactory("ps"),
 	}
 }
@@ -107,7 +113,7 @@ func scaleServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Name:         "scale",
 		Usage:        "ecs-cli compose service scale [count] - scales the desired count of the service to the specified count",
 		Action:       compose.WithProject(factory, compose.ProjectScale, true),
-		Flags:        flags.AppendFlags(deploymentConfigFlags(false), flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag()),
+		Flags:        flags.AppendFlags(deploymentConfigFlags(false), flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), progressFlag(), splitServicesFlag()),
 		OnUsageError: flags.UsageErrorFactory("scale"),
 	}
 }
@@ -117,7 +123,7 @@ func stopServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Name:         "stop",
 		Usage:        "Stops the running tasks that belong to the service created with the compose project. This command updates the desired count of the service to 0.",
 		Action:       compose.WithProject(factory, compose.ProjectStop, true),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag()),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), progressFlag(), splitServicesFlag()),
 		OnUsageError: flags.UsageErrorFactory("stop"),
 	}
 }
@@ -128,11 +134,70 @@ func rmServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
 		Aliases:      []string{"delete", "down"},
 		Usage:        "Updates the desired count of the service to 0 and then deletes the service.",
 		Action:       compose.WithProject(factory, compose.ProjectDown, true),
-		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), deleteServiceDiscoveryFlags()),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), progressFlag(), deleteServiceDiscoveryFlags(), splitServicesFlag()),
 		OnUsageError: flags.UsageErrorFactory("rm"),
 	}
 }
 
+func abortServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
+	return cli.Command{
+		Name:         flags.AbortServiceCommandName,
+		Usage:        "Scales the task sets of a deployment in progress to zero, for services using the EXTERNAL deployment controller. The primary task set keeps serving the service.",
+		Action:       compose.WithProject(factory, compose.ProjectAbort, true),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), splitServicesFlag()),
+		OnUsageError: flags.UsageErrorFactory(flags.AbortServiceCommandName),
+	}
+}
+
+func diffServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
+	return cli.Command{
+		Name:         flags.DiffServiceCommandName,
+		Usage:        "Shows the differences between the ECS service and its task definition and what the up command would deploy from your compose file, without registering a task definition or updating the service. Exits with 0 if there are no differences, 2 if there are any and 1 on errors.",
+		Action:       compose.WithProject(factory, compose.ProjectDiff, true),
+		Flags:        flags.AppendFlags(deploymentConfigFlags(false), flags.OptionalConfigFlags(), flags.OptionalLaunchTypeFlag(), flags.OptionalPlatformVersionFlag(), splitServicesFlag(), flags.OptionalPinImageDigestsFlag()),
+		OnUsageError: flags.UsageErrorFactory(flags.DiffServiceCommandName),
+	}
+}
+
+func revisionsServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
+	return cli.Command{
+		Name:         flags.RevisionsServiceCommandName,
+		Usage:        "Lists the newest active revisions of the task definition family of the ECS service, with the images of their containers and when they were registered. The family can have revisions the service never ran; those it is deploying show the status of their deployment.",
+		Action:       compose.WithProject(factory, compose.ProjectRevisions, true),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), splitServicesFlag(), revisionsLimitFlag()),
+		OnUsageError: flags.UsageErrorFactory(flags.RevisionsServiceCommandName),
+	}
+}
+
+func rollbackServiceCommand(factory composeFactory.ProjectFactory) cli.Command {
+	return cli.Command{
+		Name:         flags.RollbackServiceCommandName,
+		Usage:        "Updates the ECS service to a previous revision of its task definition and waits for it to become stable. The desired count of the service does not change.",
+		Action:       compose.WithProject(factory, compose.ProjectRollback, true),
+		Flags:        flags.AppendFlags(flags.OptionalConfigFlags(), ComposeServiceTimeoutFlag(), progressFlag(), splitServicesFlag(), toRevisionFlag()),
+		OnUsageError: flags.UsageErrorFactory(flags.RollbackServiceCommandName),
+	}
+}
+
+func revisionsLimitFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.IntFlag{
+			Name:  flags.RevisionsLimitFlag,
+			Value: service.DefaultRevisionsLimit,
+			Usage: fmt.Sprintf("[Optional] Specifies the maximum number of revisions to list, from 1 to %d.", service.MaxRevisionsLimit),
+		},
+	}
+}
+
+func toRevisionFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.Int64Flag{
+			Name:  flags.ToRevisionFlag,
+			Usage: "[Optional] Specifies the revision of the task definition family of the service to roll back to. Defaults to the newest active revision older than the one the service is running.",
+		},
+	}
+}
+
 func serviceDiscoveryFlags() []cli.Flag {
 	return []cli.Flag{
 		cli.BoolFlag{
@@ -262,12 +327,22 @@ func ComposeServiceTimeoutFlag() []cli.Flag {
 			Name:  flags.ComposeServiceTimeOutFlag,
 			Value: service.DefaultUpdateServiceTimeout,
 			Usage: fmt.Sprintf(
-				"Specifies the timeout value in minutes (decimals supported) to wait for the running task count to change. If the running task count has not changed for the specified period of time, then the CLI times out and returns an error. Setting the timeout to 0 will cause the command to return without checking for success.",
+				"Specifies the timeout value in minutes (decimals supported) to wait for the running task count to change. If the running task count has not changed for the specified period of time, then the CLI times out and returns an error. Setting the timeout to 0 will cause the command to return without checking for success. It is independent of the global --timeout, given before the command as a duration such as 'ecs-cli --timeout 20m': whichever expires first ends the wait, and only this one rolls back the deployment.",
 			),
 		},
 	}
 }
 
+func progressFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.ProgressFlag,
+			Value: "auto",
+			Usage: "[Optional] Specifies how the progress of the deployments of the service, or of its task sets for services using the EXTERNAL deployment controller, is shown while waiting for it to become stable: auto (tty when the output is a terminal, plain otherwise), tty (a live table of the deployments or task sets, with new service events above it), plain (a line for every change of a deployment or task set and every service event) or json (the same data as plain, as newline delimited JSON).",
+		},
+	}
+}
+
 func ForceNewDeploymentFlag() []cli.Flag {
 	return []cli.Flag{
 		cli.BoolFlag{
@@ -277,6 +352,47 @@ func ForceNewDeploymentFlag() []cli.Flag {
 	}
 }
 
+func noRollbackFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.NoRollbackFlag,
+			Usage: "[Optional] Do not roll the service back to its previous task definition and desired count when the deployment of a new task definition fails to become stable.",
+		},
+	}
+}
+
+func deploymentControllerFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  flags.DeploymentControllerFlag,
+			Usage: "[Optional] Specifies the deployment controller of a new service, ECS (rolling updates, the default) or EXTERNAL (task sets, deployed by ecs-cli as canaries). Ignored for existing services.",
+		},
+	}
+}
+
+func taskSetDeploymentFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.Float64Flag{
+			Name:  flags.CanaryPercentFlag,
+			Value: 100,
+			Usage: "[Optional] For services using the EXTERNAL deployment controller, specifies the scale of the task set of a new task definition, as a percent of the desired count of the service, until it is promoted to primary.",
+		},
+		cli.Float64Flag{
+			Name:  flags.BakeTimeFlag,
+			Usage: "[Optional] For services using the EXTERNAL deployment controller, specifies how long, in minutes, the healthy task set of a new task definition runs at its canary scale before it is promoted to primary.",
+		},
+	}
+}
+
+func splitServicesFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  flags.SplitServicesFlag,
+			Usage: "[Optional] Deploy each service in the compose file as its own task definition and ECS service, named <project-name>-<service-name>. Per service desired_count, task_size, network_configuration and task_placement can be set under service_overrides in the ECS params file.",
+		},
+	}
+}
+
 func taggingFlags() []cli.Flag {
 	return []cli.Flag{
 		cli.BoolFlag{
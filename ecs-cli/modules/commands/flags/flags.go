@@ -31,6 +31,7 @@ const (
 	SessionTokenFlag        = "session-token"
 	RegionFlag              = "region"
 	EndpointFlag            = "endpoint"
+	GlobalTimeoutFlag       = "timeout"
 	AwsRegionEnvVar         = "AWS_REGION"
 	AwsDefaultRegionEnvVar  = "AWS_DEFAULT_REGION"
 	AwsDefaultProfileEnvVar = "AWS_DEFAULT_PROFILE"
@@ -83,7 +84,8 @@ const (
 	LaunchTypeFlag         = "launch-type"
 	DefaultLaunchTypeFlag  = "default-launch-type"
 	SchedulingStrategyFlag = "scheduling-strategy"
-	
+	PlatformVersionFlag    = "platform-version"
+
 	//attribute-checker
 	ContainerInstancesFlag = "container-instances"
 
@@ -117,8 +119,19 @@ const (
 	ComposeFileNameFlag       = "file"
 	TaskRoleArnFlag           = "task-role-arn"
 	ECSParamsFileNameFlag     = "ecs-params"
+	ECSParamsLenientFlag      = "ecs-params-lenient"
 	ForceUpdateFlag           = "force-update"
 	RegistryCredsFileNameFlag = "registry-creds"
+	OutputFormatFlag          = "output"
+	ServicesFlag              = "services"
+	ExcludeServicesFlag       = "exclude-services"
+	AttachFlag                = "attach"
+	EnvFlag                   = "env"
+	ContainerCPUFlag          = "container-cpu"
+	ContainerMemoryFlag       = "container-memory"
+	OverrideTaskRoleArnFlag   = "override-task-role-arn"
+	TaskCPUFlag               = "task-cpu"
+	TaskMemoryFlag            = "task-memory"
 
 	// Compose Service
 	CreateServiceCommandName                = "create"
@@ -134,6 +147,20 @@ const (
 	RoleFlag                                = "role"
 	ComposeServiceTimeOutFlag               = "timeout"
 	ForceDeploymentFlag                     = "force-deployment"
+	NoRollbackFlag                          = "no-rollback"
+	DeploymentControllerFlag                = "deployment-controller"
+	CanaryPercentFlag                       = "canary-percent"
+	BakeTimeFlag                            = "bake-time"
+	ProgressFlag                            = "progress"
+	AbortServiceCommandName                 = "abort"
+	DiffServiceCommandName                  = "diff"
+	RevisionsServiceCommandName             = "revisions"
+	RollbackServiceCommandName              = "rollback"
+	RevisionsLimitFlag                      = "limit"
+	ToRevisionFlag                          = "to-revision"
+	BuildAndPushFlag                        = "build-and-push"
+	PinImageDigestsFlag                     = "pin-image-digests"
+	SplitServicesFlag                       = "split-services"
 
 	// Registry Creds
 	UpdateExistingSecretsFlag = "update-existing-secrets"
@@ -211,6 +238,16 @@ func OptionalLaunchTypeFlag() []cli.Flag {
 	}
 }
 
+// OptionalPlatformVersionFlag allows users to specify the Fargate platform version of their tasks/service
+func OptionalPlatformVersionFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  PlatformVersionFlag,
+			Usage: "[Optional] Specifies the Fargate platform version, such as 1.3.0 or LATEST. Overrides the platform_version in the run_params of your ECS params file. Only valid with launch type FARGATE.",
+		},
+	}
+}
+
 // OptionalSchedulingStrategyFlag allows users to specify the scheduling strategy for their task/service/cluster
 func OptionalSchedulingStrategyFlag() []cli.Flag {
 	return []cli.Flag{
@@ -235,6 +272,28 @@ func OptionalCreateLogsFlag() []cli.Flag {
 	}
 }
 
+// OptionalBuildAndPushFlag allows users to build the images of compose
+// services with a build section and push them to ECR on compose up
+func OptionalBuildAndPushFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  BuildAndPushFlag,
+			Usage: "[Optional] Build the image of each service with a build section in your compose file(s), push it to an ECR repository, creating the repository if needed, and use the pushed image in the task definition. The repository is named after the service image, or <project-name>-<service-name> if it has none.",
+		},
+	}
+}
+
+// OptionalPinImageDigestsFlag allows users to register task definitions with
+// images pinned to the digest their tag points to.
+func OptionalPinImageDigestsFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  PinImageDigestsFlag,
+			Usage: "[Optional] Resolve the tag of each image to the digest it currently points to and register the task definition with REPOSITORY@sha256:... images. ECR images are resolved with DescribeImages, other images are pulled with docker. The original image is kept in the com.amazonaws.ecs-cli.original-image docker label, which ps shows.",
+		},
+	}
+}
+
 // OptionalForceUpdateFlag allows users to force an update of running tasks on compose up.
 func OptionalForceUpdateFlag() []cli.Flag {
 	return []cli.Flag{
@@ -245,6 +304,46 @@ func OptionalForceUpdateFlag() []cli.Flag {
 	}
 }
 
+// OptionalAttachFlag allows users to follow the task started by compose run until it stops.
+func OptionalAttachFlag() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  AttachFlag,
+			Usage: "[Optional] Waits for the task to stop while printing the CloudWatch logs of its containers, then exits with the exit code of its essential container. The containers must use the awslogs log driver with an awslogs-stream-prefix.",
+		},
+	}
+}
+
+// OptionalRunOverrideFlags allows users to override the containers and the size and role of the task started by compose run.
+func OptionalRunOverrideFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  EnvFlag + ", e",
+			Usage: "[Optional] Sets an environment variable in a container, as CONTAINER:KEY=VALUE. The container can be left out if the task definition has a single container. Can be repeated.",
+		},
+		cli.StringSliceFlag{
+			Name:  ContainerCPUFlag,
+			Usage: "[Optional] Overrides the CPU units of a container, as CONTAINER=UNITS. The container can be left out if the task definition has a single container. Can be repeated.",
+		},
+		cli.StringSliceFlag{
+			Name:  ContainerMemoryFlag,
+			Usage: "[Optional] Overrides the hard memory limit of a container, as CONTAINER=MiB. The container can be left out if the task definition has a single container. Can be repeated.",
+		},
+		cli.StringFlag{
+			Name:  OverrideTaskRoleArnFlag,
+			Usage: "[Optional] Overrides the IAM role the containers of the task assume.",
+		},
+		cli.StringFlag{
+			Name:  TaskCPUFlag,
+			Usage: "[Optional] Overrides the CPU of the task, in units (1024) or vCPUs (1 vCPU). Must be a supported task size with the FARGATE launch type.",
+		},
+		cli.StringFlag{
+			Name:  TaskMemoryFlag,
+			Usage: "[Optional] Overrides the memory of the task, in MiB (2048) or GB (2GB). Must be a supported task size with the FARGATE launch type.",
+		},
+	}
+}
+
 func DebugFlag() []cli.Flag {
 	return []cli.Flag{
 		cli.BoolFlag{
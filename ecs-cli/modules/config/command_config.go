@@ -14,10 +14,13 @@
 package config
 
 import (
+	"context"
 	"fmt"
 	"os"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/commands/flags"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
@@ -33,11 +36,21 @@ const (
 // CommandConfig contains the configuration parameters and AWS Session required to run a specific command
 type CommandConfig struct {
 	Cluster                  string
+	Region                   string // resolved even without a Session, unless no region is configured
 	Session                  *session.Session
 	ComposeServiceNamePrefix string
 	ComposeProjectNamePrefix string // Deprecated; remains for backwards compatibility
 	CFNStackName             string
 	LaunchType               string
+	Ctx                      context.Context // cancelled once the --timeout of the command expires
+}
+
+// Context returns the context of the command, or a context that is never cancelled if it is not set
+func (c *CommandConfig) Context() context.Context {
+	if c == nil || c.Ctx == nil {
+		return context.Background()
+	}
+	return c.Ctx
 }
 
 // Searches as far up the context as necessary. This function works no matter
@@ -55,6 +68,43 @@ func RecursiveFlagSearch(context *cli.Context, flag string) string {
 
 // NewCommandConfig creates a new CommandConfig object from the local ECS config file and flags
 func NewCommandConfig(context *cli.Context, rdwr ReadWriter) (*CommandConfig, error) {
+	ecsConfig, err := resolveLocalConfig(context, rdwr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Instantiate AWS Session
+	svcSession, err := ecsConfig.ToAWSSession(context)
+	if err != nil {
+		return nil, err
+	}
+
+	commandConfig := newCommandConfig(ecsConfig)
+	commandConfig.Session = svcSession
+	commandConfig.Region = aws.StringValue(svcSession.Config.Region)
+	commandConfig.Ctx = utils.CommandContext(context)
+	return commandConfig, nil
+}
+
+// NewLocalCommandConfig creates a new CommandConfig object from the local ECS
+// config file and flags without an AWS Session, for commands that do not make
+// any AWS API calls. Neither a region nor credentials need to be configured, but
+// the region is resolved like it is for a Session if there is one.
+func NewLocalCommandConfig(context *cli.Context, rdwr ReadWriter) (*CommandConfig, error) {
+	ecsConfig, err := resolveLocalConfig(context, rdwr)
+	if err != nil {
+		return nil, err
+	}
+	commandConfig := newCommandConfig(ecsConfig)
+	if region, err := ecsConfig.getRegion(); err == nil {
+		commandConfig.Region = region
+	}
+	commandConfig.Ctx = utils.CommandContext(context)
+	return commandConfig, nil
+}
+
+// resolveLocalConfig reads the local ECS config and applies the values of flags and environment variables
+func resolveLocalConfig(context *cli.Context, rdwr ReadWriter) (*LocalConfig, error) {
 	clusterConfig := RecursiveFlagSearch(context, flags.ClusterConfigFlag)
 	profileConfig := RecursiveFlagSearch(context, flags.ECSProfileFlag)
 	ecsConfig, err := rdwr.Get(clusterConfig, profileConfig)
@@ -100,12 +150,10 @@ func NewCommandConfig(context *cli.Context, rdwr ReadWriter) (*CommandConfig, er
 		ecsConfig.AWSSecretKey = ""
 	}
 
-	// Instantiate AWS Session
-	svcSession, err := ecsConfig.ToAWSSession(context)
-	if err != nil {
-		return nil, err
-	}
+	return ecsConfig, nil
+}
 
+func newCommandConfig(ecsConfig *LocalConfig) *CommandConfig {
 	// Determine Cloudformation StackName
 	if ecsConfig.Version == iniConfigVersion {
 		ecsConfig.CFNStackName = ecsConfig.CFNStackNamePrefix + ecsConfig.Cluster
@@ -116,12 +164,11 @@ func NewCommandConfig(context *cli.Context, rdwr ReadWriter) (*CommandConfig, er
 
 	return &CommandConfig{
 		Cluster:                  ecsConfig.Cluster,
-		Session:                  svcSession,
 		ComposeServiceNamePrefix: ecsConfig.ComposeServiceNamePrefix,
 		ComposeProjectNamePrefix: ecsConfig.ComposeProjectNamePrefix, // deprecated; remains for backwards compatibility
 		CFNStackName:             ecsConfig.CFNStackName,
 		LaunchType:               ecsConfig.DefaultLaunchType,
-	}, nil
+	}
 }
 
 // ValidateLaunchType checks that the launch type specified was an allowed value
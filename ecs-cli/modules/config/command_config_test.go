@@ -101,6 +101,7 @@ func TestNewCommandConfigFromEnvVarsWithRegionSpecifiedAsEnvVariable(t *testing.
 
 	configRegion := aws.StringValue(config.Session.Config.Region)
 	assert.Equal(t, region, configRegion, "Region should match")
+	assert.Equal(t, region, config.Region, "Expected the region of the session")
 }
 
 func TestNewCommandConfigFromEnvVarsWithRegionSpecifiedinAwsDefaultEnvVariable(t *testing.T) {
@@ -365,6 +366,56 @@ aws_secret_access_key = aws-secret
 	assert.Equal(t, awsSecretAWSProfile, creds.SecretAccessKey, "Expected AWS Secret Access Key to be read from the AWS Profile")
 }
 
+func TestNewLocalCommandConfigWithRegionNotSpecified(t *testing.T) {
+	context, rdwr := setupTest(t)
+
+	config, err := NewLocalCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error when region is not specified")
+	assert.Nil(t, config.Session, "Expected Session to be nil")
+	assert.Equal(t, clusterName, config.Cluster, "Expected Cluster to be set")
+}
+
+func TestNewLocalCommandConfigWithRegion(t *testing.T) {
+	context := defaultConfig()
+
+	rdwr := &mockReadWriter{}
+	config, err := NewLocalCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error when region is specified")
+	assert.Nil(t, config.Session, "Expected Session to be nil")
+	assert.Equal(t, "us-east-1", config.Region, "Expected the region of the flag")
+}
+
+func TestNewLocalCommandConfigWithRegionFromEnvVar(t *testing.T) {
+	context, rdwr := setupTest(t)
+
+	os.Setenv("AWS_REGION", "eu-west-1")
+	defer os.Unsetenv("AWS_REGION")
+
+	config, err := NewLocalCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error when region is specified using environment variable AWS_REGION")
+	assert.Equal(t, "eu-west-1", config.Region, "Expected the region of the environment variable")
+}
+
+func TestNewLocalCommandConfigLaunchTypeOverriddenFargate(t *testing.T) {
+	context := configWithLaunchType(LaunchTypeFargate)
+
+	rdwr := &mockReadWriter{isKeyPresentValue: true, version: yamlConfigVersion, fargate: false}
+	config, err := NewLocalCommandConfig(context, rdwr)
+	assert.NoError(t, err, "Unexpected error when getting new cli config")
+	assert.Nil(t, config.Session, "Expected Session to be nil")
+	assert.Equal(t, composeServiceNamePrefix, config.ComposeServiceNamePrefix, "Expected ComposeServiceNamePrefix to be set")
+	assert.Equal(t, cfnStackName, config.CFNStackName, "Expected CFNStackName to be set")
+	assert.Equal(t, LaunchTypeFargate, config.LaunchType)
+}
+
+func TestNewLocalCommandConfigInvalidLaunchType(t *testing.T) {
+	context := configWithLaunchType("fargate")
+
+	rdwr := &mockReadWriter{}
+	_, err := NewLocalCommandConfig(context, rdwr)
+	assert.Error(t, err, "Expected error with invalid launch type")
+}
+
 func defaultConfig() *cli.Context {
 	globalSet := flag.NewFlagSet("ecs-cli", 0)
 	globalContext := cli.NewContext(nil, globalSet, nil)
@@ -429,6 +429,9 @@ func TestCredentialsWhenUsingEC2InstanceRole(t *testing.T) {
 			fmt.Fprintln(w, ec2InstanceRoleName)
 		} else if r.URL.Path == "/latest/meta-data/iam/security-credentials/"+ec2InstanceRoleName {
 			fmt.Fprintf(w, ec2Creds, "2014-12-16T01:51:37Z")
+		} else if r.URL.Path == "/latest/api/token" {
+			// instance metadata without session tokens
+			http.NotFound(w, r)
 		} else {
 			http.Error(w, "bad request", http.StatusBadRequest)
 		}
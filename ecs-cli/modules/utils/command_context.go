@@ -0,0 +1,102 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// commandContextKey is the key of the context of the running command in the metadata of the app
+const commandContextKey = "commandContext"
+
+// make the interrupt signal easily mockable in tests
+var notifyInterrupt = func(interrupts chan<- os.Signal) {
+	signal.Notify(interrupts, os.Interrupt)
+}
+
+// WithInterrupt returns a context which is also cancelled when the process receives SIGINT,
+// so that a wait can stop and print the state it left behind. The handler is only installed
+// until cancel is called, so SIGINT terminates the process right away outside of such a wait,
+// and a second SIGINT terminates it while the wait stops.
+func WithInterrupt(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	interrupts := make(chan os.Signal, 1)
+	notifyInterrupt(interrupts)
+	go func() {
+		defer signal.Stop(interrupts)
+		select {
+		case <-interrupts:
+			log.Warn("Interrupted, stopping. Press Ctrl-C again to exit immediately")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// WithCommandTimeout returns ctx if timeout is not greater than zero, and otherwise a context
+// that is also cancelled once timeout has passed. Its resources are released when it is done,
+// so cancelling ctx is enough to release them.
+func WithCommandTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	if timeout <= 0 {
+		return ctx
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	go func() {
+		<-ctx.Done()
+		cancel()
+	}()
+	return ctx
+}
+
+// SetCommandContext stores the context of the running command in the metadata of the app
+func SetCommandContext(app *cli.App, ctx context.Context) {
+	if app.Metadata == nil {
+		app.Metadata = make(map[string]interface{})
+	}
+	app.Metadata[commandContextKey] = ctx
+}
+
+// CommandContext returns the context of the running command set with SetCommandContext,
+// or a context that is never cancelled if there is none
+func CommandContext(c *cli.Context) context.Context {
+	if c != nil && c.App != nil {
+		if ctx, ok := c.App.Metadata[commandContextKey].(context.Context); ok {
+			return ctx
+		}
+	}
+	return context.Background()
+}
+
+// IsInterrupted returns true if err was caused by the cancellation of the context of the
+// command, because it was interrupted or its --timeout expired
+func IsInterrupted(err error) bool {
+	switch cause := errors.Cause(err); cause {
+	case context.Canceled, context.DeadlineExceeded:
+		return true
+	default:
+		awsErr, ok := cause.(awserr.Error)
+		return ok && awsErr.Code() == request.CanceledErrorCode
+	}
+}
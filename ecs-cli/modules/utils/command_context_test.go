@@ -0,0 +1,134 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func TestCommandContextDefaultsToBackground(t *testing.T) {
+	assert.Equal(t, context.Background(), CommandContext(nil))
+	assert.Equal(t, context.Background(), CommandContext(cli.NewContext(nil, nil, nil)))
+	assert.Equal(t, context.Background(), CommandContext(cli.NewContext(cli.NewApp(), nil, nil)))
+}
+
+func TestSetCommandContext(t *testing.T) {
+	app := cli.NewApp()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	SetCommandContext(app, ctx)
+	assert.Equal(t, ctx, CommandContext(cli.NewContext(app, nil, nil)))
+}
+
+func TestWithCommandTimeout(t *testing.T) {
+	parent, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx := WithCommandTimeout(parent, time.Millisecond)
+
+	select {
+	case <-ctx.Done():
+		assert.Equal(t, context.DeadlineExceeded, ctx.Err())
+		assert.NoError(t, parent.Err(), "Expected the timeout to leave the command context running")
+	case <-time.After(time.Second):
+		t.Error("Expected the context to time out")
+	}
+}
+
+func TestWithCommandTimeoutWithoutTimeout(t *testing.T) {
+	parent, cancel := context.WithCancel(context.Background())
+	ctx := WithCommandTimeout(parent, 0)
+	_, hasDeadline := ctx.Deadline()
+	assert.False(t, hasDeadline, "Expected no deadline without a timeout")
+	assert.NoError(t, ctx.Err())
+
+	cancel()
+	assert.Equal(t, context.Canceled, ctx.Err())
+}
+
+func TestWithInterruptIsCancelledOnInterrupt(t *testing.T) {
+	defaultNotifyInterrupt := notifyInterrupt
+	defer func() { notifyInterrupt = defaultNotifyInterrupt }()
+	var interrupts chan<- os.Signal
+	notifyInterrupt = func(c chan<- os.Signal) {
+		interrupts = c
+	}
+
+	ctx, cancel := WithInterrupt(context.Background())
+	defer cancel()
+	interrupts <- os.Interrupt
+
+	select {
+	case <-ctx.Done():
+		assert.Equal(t, context.Canceled, ctx.Err())
+	case <-time.After(time.Second):
+		t.Error("Expected the context to be cancelled")
+	}
+}
+
+func TestWithInterruptStopsWithParent(t *testing.T) {
+	defaultNotifyInterrupt := notifyInterrupt
+	defer func() { notifyInterrupt = defaultNotifyInterrupt }()
+	notifyInterrupt = func(c chan<- os.Signal) {}
+
+	parent, cancelParent := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancelParent()
+	ctx, cancel := WithInterrupt(parent)
+	defer cancel()
+
+	select {
+	case <-ctx.Done():
+		assert.Equal(t, context.DeadlineExceeded, ctx.Err())
+	case <-time.After(time.Second):
+		t.Error("Expected the context to be done with its parent")
+	}
+}
+
+func TestIsInterrupted(t *testing.T) {
+	testCases := map[string]struct {
+		err         error
+		interrupted bool
+	}{
+		"nil":               {nil, false},
+		"other error":       {errors.New("something failed"), false},
+		"canceled":          {context.Canceled, true},
+		"deadline exceeded": {pkgerrors.Wrap(context.DeadlineExceeded, "Stopped waiting"), true},
+		"canceled request":  {awserr.New(request.CanceledErrorCode, "request context canceled", context.Canceled), true},
+		"other aws error":   {awserr.New("ValidationError", "invalid", nil), false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, testCase.interrupted, IsInterrupted(testCase.err))
+		})
+	}
+}
+
+func TestTimeSleeperStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := (&TimeSleeper{}).Sleep(ctx, time.Hour)
+	assert.Equal(t, context.Canceled, err)
+}
@@ -0,0 +1,139 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+// containerConditions maps compose depends_on conditions to ECS container conditions
+var containerConditions = map[string]string{
+	adapter.DependencyConditionStarted:               ecs.ContainerConditionStart,
+	adapter.DependencyConditionHealthy:               ecs.ContainerConditionHealthy,
+	adapter.DependencyConditionCompletedSuccessfully: ecs.ContainerConditionSuccess,
+	adapter.DependencyConditionCompleted:             ecs.ContainerConditionComplete,
+}
+
+// convertToECSContainerDependencies transforms depends_on entries into ECS ContainerDependencies
+func convertToECSContainerDependencies(dependencies []adapter.ContainerDependency) ([]*ecs.ContainerDependency, error) {
+	output := []*ecs.ContainerDependency{}
+	for _, dependency := range dependencies {
+		condition, ok := containerConditions[dependency.Condition]
+		if !ok {
+			return nil, fmt.Errorf("unsupported depends_on condition '%s' for %s", dependency.Condition, dependency.Name)
+		}
+		output = append(output, &ecs.ContainerDependency{
+			ContainerName: aws.String(dependency.Name),
+			Condition:     aws.String(condition),
+		})
+	}
+	return output, nil
+}
+
+// validateContainerDependencies checks that every depends_on entry refers to a
+// service in the project, and that the dependencies do not form a cycle
+func validateContainerDependencies(containerConfigs []adapter.ContainerConfig) error {
+	dependencies := make(map[string][]string)
+	for _, config := range containerConfigs {
+		dependencies[config.Name] = []string{}
+	}
+	for _, config := range containerConfigs {
+		for _, dependency := range config.DependsOn {
+			if _, ok := dependencies[dependency.Name]; !ok {
+				return fmt.Errorf("service %s depends on undefined service %s", config.Name, dependency.Name)
+			}
+			dependencies[config.Name] = append(dependencies[config.Name], dependency.Name)
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var path []string
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("circular dependency between services: %s -> %s", strings.Join(cyclePath(path, name), " -> "), name)
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, dependency := range dependencies[name] {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	// iterate over the configs rather than the map so that errors are deterministic
+	for _, config := range containerConfigs {
+		if err := visit(config.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cyclePath returns the part of the path starting at the service where the cycle begins
+func cyclePath(path []string, name string) []string {
+	for i, service := range path {
+		if service == name {
+			return path[i:]
+		}
+	}
+	return path
+}
+
+// validateContainerDependencyConditions checks the ECS requirements for the
+// containers that are depended on: a HEALTHY dependency needs a health check,
+// and SUCCESS and COMPLETE dependencies cannot be on essential containers
+func validateContainerDependencyConditions(containerDefs []*ecs.ContainerDefinition) error {
+	containers := make(map[string]*ecs.ContainerDefinition)
+	for _, def := range containerDefs {
+		containers[aws.StringValue(def.Name)] = def
+	}
+
+	for _, def := range containerDefs {
+		for _, dependency := range def.DependsOn {
+			target, ok := containers[aws.StringValue(dependency.ContainerName)]
+			if !ok {
+				continue
+			}
+			switch aws.StringValue(dependency.Condition) {
+			case ecs.ContainerConditionHealthy:
+				if target.HealthCheck == nil {
+					return fmt.Errorf("service %s depends on %s being healthy, but %s does not have a healthcheck", aws.StringValue(def.Name), aws.StringValue(target.Name), aws.StringValue(target.Name))
+				}
+			case ecs.ContainerConditionSuccess, ecs.ContainerConditionComplete:
+				if aws.BoolValue(target.Essential) {
+					return fmt.Errorf("service %s depends on %s completing, but %s is an essential container; set essential to false for %s in the ECS params file", aws.StringValue(def.Name), aws.StringValue(target.Name), aws.StringValue(target.Name), aws.StringValue(target.Name))
+				}
+			}
+		}
+	}
+	return nil
+}
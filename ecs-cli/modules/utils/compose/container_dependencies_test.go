@@ -0,0 +1,88 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertToECSContainerDependencies(t *testing.T) {
+	input := []adapter.ContainerDependency{
+		{Name: "a", Condition: adapter.DependencyConditionStarted},
+		{Name: "b", Condition: adapter.DependencyConditionHealthy},
+		{Name: "c", Condition: adapter.DependencyConditionCompletedSuccessfully},
+		{Name: "d", Condition: adapter.DependencyConditionCompleted},
+	}
+	expected := []*ecs.ContainerDependency{
+		{ContainerName: aws.String("a"), Condition: aws.String(ecs.ContainerConditionStart)},
+		{ContainerName: aws.String("b"), Condition: aws.String(ecs.ContainerConditionHealthy)},
+		{ContainerName: aws.String("c"), Condition: aws.String(ecs.ContainerConditionSuccess)},
+		{ContainerName: aws.String("d"), Condition: aws.String(ecs.ContainerConditionComplete)},
+	}
+
+	actual, err := convertToECSContainerDependencies(input)
+	assert.NoError(t, err, "Unexpected error converting dependencies")
+	assert.Equal(t, expected, actual)
+}
+
+func TestConvertToECSContainerDependencies_ErrorWithUnknownCondition(t *testing.T) {
+	input := []adapter.ContainerDependency{
+		{Name: "a", Condition: "service_ready"},
+	}
+	_, err := convertToECSContainerDependencies(input)
+	assert.Error(t, err, "Expected error converting unknown condition")
+}
+
+func TestValidateContainerDependencies(t *testing.T) {
+	containerConfigs := testContainerConfigs([]string{"web", "worker", "db", "cache"})
+	containerConfigs[0].DependsOn = []adapter.ContainerDependency{{Name: "worker"}, {Name: "db"}}
+	containerConfigs[1].DependsOn = []adapter.ContainerDependency{{Name: "db"}, {Name: "cache"}}
+	containerConfigs[2].DependsOn = []adapter.ContainerDependency{{Name: "cache"}}
+
+	assert.NoError(t, validateContainerDependencies(containerConfigs))
+}
+
+func TestValidateContainerDependencies_ErrorWithUnknownService(t *testing.T) {
+	containerConfigs := testContainerConfigs([]string{"web", "db"})
+	containerConfigs[0].DependsOn = []adapter.ContainerDependency{{Name: "cache"}}
+
+	err := validateContainerDependencies(containerConfigs)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "cache")
+	}
+}
+
+func TestValidateContainerDependencies_ErrorWithCycle(t *testing.T) {
+	containerConfigs := testContainerConfigs([]string{"web", "worker", "db"})
+	containerConfigs[0].DependsOn = []adapter.ContainerDependency{{Name: "worker"}}
+	containerConfigs[1].DependsOn = []adapter.ContainerDependency{{Name: "db"}}
+	containerConfigs[2].DependsOn = []adapter.ContainerDependency{{Name: "worker"}}
+
+	err := validateContainerDependencies(containerConfigs)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "worker -> db -> worker")
+	}
+}
+
+func TestValidateContainerDependencies_ErrorWithSelfReference(t *testing.T) {
+	containerConfigs := testContainerConfigs([]string{"web"})
+	containerConfigs[0].DependsOn = []adapter.ContainerDependency{{Name: "web"}}
+
+	assert.Error(t, validateContainerDependencies(containerConfigs))
+}
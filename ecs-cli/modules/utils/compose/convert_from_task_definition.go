@@ -0,0 +1,514 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// ImportedComposeVersion is the version of the compose files generated from task definitions
+	ImportedComposeVersion = "3"
+	// ImportedECSParamsVersion is the version of the ecs-params files generated from task definitions
+	ImportedECSParamsVersion = "1"
+
+	cpuUnitsPerCPU = 1024
+)
+
+// dependencyConditions maps ECS container conditions to compose depends_on conditions
+var dependencyConditions = map[string]string{
+	ecs.ContainerConditionStart:    adapter.DependencyConditionStarted,
+	ecs.ContainerConditionHealthy:  adapter.DependencyConditionHealthy,
+	ecs.ContainerConditionSuccess:  adapter.DependencyConditionCompletedSuccessfully,
+	ecs.ContainerConditionComplete: adapter.DependencyConditionCompleted,
+}
+
+// devicePermissions maps ECS device permissions to the compose device option characters
+var devicePermissions = map[string]string{
+	ecs.DeviceCgroupPermissionRead:  "r",
+	ecs.DeviceCgroupPermissionWrite: "w",
+	ecs.DeviceCgroupPermissionMknod: "m",
+}
+
+// ComposeFile holds the fields of a compose file (version 3) that can be
+// generated from an ECS task definition
+type ComposeFile struct {
+	Version  string                     `yaml:"version"`
+	Services map[string]*ComposeService `yaml:"services"`
+	Volumes  map[string]ComposeVolume   `yaml:"volumes,omitempty"`
+}
+
+// ComposeService holds the fields of a compose service that correspond to an ECS container definition
+type ComposeService struct {
+	Image           string                   `yaml:"image,omitempty"`
+	Command         []string                 `yaml:"command,omitempty"`
+	Entrypoint      []string                 `yaml:"entrypoint,omitempty"`
+	Environment     map[string]string        `yaml:"environment,omitempty"`
+	Ports           []string                 `yaml:"ports,omitempty"`
+	Volumes         []string                 `yaml:"volumes,omitempty"`
+	Links           []string                 `yaml:"links,omitempty"`
+	DependsOn       interface{}              `yaml:"depends_on,omitempty"`
+	Deploy          *ComposeDeploy           `yaml:"deploy,omitempty"`
+	CapAdd          []string                 `yaml:"cap_add,omitempty"`
+	CapDrop         []string                 `yaml:"cap_drop,omitempty"`
+	Devices         []string                 `yaml:"devices,omitempty"`
+	DNS             []string                 `yaml:"dns,omitempty"`
+	DNSSearch       []string                 `yaml:"dns_search,omitempty"`
+	ExtraHosts      []string                 `yaml:"extra_hosts,omitempty"`
+	Hostname        string                   `yaml:"hostname,omitempty"`
+	Labels          map[string]string        `yaml:"labels,omitempty"`
+	Logging         *ComposeLogging          `yaml:"logging,omitempty"`
+	Privileged      bool                     `yaml:"privileged,omitempty"`
+	ReadOnly        bool                     `yaml:"read_only,omitempty"`
+	SecurityOpt     []string                 `yaml:"security_opt,omitempty"`
+	StopGracePeriod string                   `yaml:"stop_grace_period,omitempty"`
+	Sysctls         map[string]string        `yaml:"sysctls,omitempty"`
+	Tmpfs           []string                 `yaml:"tmpfs,omitempty"`
+	Tty             bool                     `yaml:"tty,omitempty"`
+	Ulimits         map[string]ComposeUlimit `yaml:"ulimits,omitempty"`
+	User            string                   `yaml:"user,omitempty"`
+	WorkingDir      string                   `yaml:"working_dir,omitempty"`
+}
+
+// ComposeDeploy holds the deploy section of a compose service; only resources are supported
+type ComposeDeploy struct {
+	Resources ComposeResources `yaml:"resources"`
+}
+
+// ComposeResources holds the resource limits and reservations of a compose service
+type ComposeResources struct {
+	Limits       *ComposeResource `yaml:"limits,omitempty"`
+	Reservations *ComposeResource `yaml:"reservations,omitempty"`
+}
+
+// ComposeResource is a CPU and memory resource of a compose service
+type ComposeResource struct {
+	CPUs   string `yaml:"cpus,omitempty"`
+	Memory string `yaml:"memory,omitempty"`
+}
+
+// ComposeLogging holds the logging configuration of a compose service
+type ComposeLogging struct {
+	Driver  string            `yaml:"driver"`
+	Options map[string]string `yaml:"options,omitempty"`
+}
+
+// ComposeUlimit holds the soft and hard limits of a ulimit
+type ComposeUlimit struct {
+	Soft int64 `yaml:"soft"`
+	Hard int64 `yaml:"hard"`
+}
+
+// ComposeVolume is a named volume declared at the top level of a compose file
+type ComposeVolume struct{}
+
+// composeDependency is a depends_on entry written in the long syntax
+type composeDependency struct {
+	Condition string `yaml:"condition"`
+}
+
+// ConvertFromTaskDefinition transforms an ECS task definition into the
+// equivalent compose file and ecs-params. Fields that have no compose
+// equivalent are set in the ecs-params, and fields that cannot be expressed in
+// either file are skipped with a warning.
+func ConvertFromTaskDefinition(taskDefinition *ecs.TaskDefinition) (*ComposeFile, *ECSParams, error) {
+	if len(taskDefinition.ContainerDefinitions) == 0 {
+		return nil, nil, errors.New("cannot import a task definition with no containers")
+	}
+
+	composeFile := &ComposeFile{
+		Version:  ImportedComposeVersion,
+		Services: make(map[string]*ComposeService),
+	}
+	ecsParams := &ECSParams{
+		Version: ImportedECSParamsVersion,
+		TaskDefinition: EcsTaskDef{
+			NetworkMode:          aws.StringValue(taskDefinition.NetworkMode),
+			TaskRoleArn:          aws.StringValue(taskDefinition.TaskRoleArn),
+			ExecutionRole:        aws.StringValue(taskDefinition.ExecutionRoleArn),
+			PIDMode:              aws.StringValue(taskDefinition.PidMode),
+			IPCMode:              aws.StringValue(taskDefinition.IpcMode),
+			ContainerDefinitions: make(ContainerDefs),
+			TaskSize: TaskSize{
+				Cpu:    aws.StringValue(taskDefinition.Cpu),
+				Memory: aws.StringValue(taskDefinition.Memory),
+			},
+		},
+	}
+
+	for _, constraint := range taskDefinition.PlacementConstraints {
+		ecsParams.TaskDefinition.PlacementConstraints = append(ecsParams.TaskDefinition.PlacementConstraints, TaskDefinitionPlacementConstraint{
+			Type:       aws.StringValue(constraint.Type),
+			Expression: aws.StringValue(constraint.Expression),
+		})
+	}
+	if taskDefinition.ProxyConfiguration != nil {
+		ecsParams.TaskDefinition.ProxyConfiguration = convertFromECSProxyConfiguration(taskDefinition.ProxyConfiguration)
+	}
+
+	sourceVolumes := convertFromECSVolumes(taskDefinition.Volumes, composeFile, ecsParams)
+
+	for _, containerDef := range taskDefinition.ContainerDefinitions {
+		name := aws.StringValue(containerDef.Name)
+		service, err := convertFromContainerDefinition(containerDef, sourceVolumes)
+		if err != nil {
+			return nil, nil, err
+		}
+		composeFile.Services[name] = service
+		ecsParams.TaskDefinition.ContainerDefinitions[name] = convertFromContainerDefinitionParams(containerDef)
+	}
+
+	return composeFile, ecsParams, nil
+}
+
+// convertFromECSVolumes declares the named volumes in the compose file and the
+// Docker volume configurations in the ecs-params, and returns the source to
+// use in the compose volumes of a service for each ECS volume name
+func convertFromECSVolumes(volumes []*ecs.Volume, composeFile *ComposeFile, ecsParams *ECSParams) map[string]string {
+	sourceVolumes := make(map[string]string)
+	for _, volume := range volumes {
+		name := aws.StringValue(volume.Name)
+		if volume.Host != nil && aws.StringValue(volume.Host.SourcePath) != "" {
+			sourceVolumes[name] = aws.StringValue(volume.Host.SourcePath)
+			continue
+		}
+
+		if composeFile.Volumes == nil {
+			composeFile.Volumes = make(map[string]ComposeVolume)
+		}
+		composeFile.Volumes[name] = ComposeVolume{}
+		sourceVolumes[name] = name
+
+		if config := volume.DockerVolumeConfiguration; config != nil {
+			ecsParams.TaskDefinition.DockerVolumes = append(ecsParams.TaskDefinition.DockerVolumes, DockerVolume{
+				Name:          name,
+				Scope:         aws.StringValue(config.Scope),
+				Autoprovision: config.Autoprovision,
+				Driver:        aws.StringValue(config.Driver),
+				DriverOptions: aws.StringValueMap(config.DriverOpts),
+				Labels:        aws.StringValueMap(config.Labels),
+			})
+		}
+	}
+	return sourceVolumes
+}
+
+// convertFromContainerDefinition transforms the fields of a container definition that have a compose equivalent
+func convertFromContainerDefinition(containerDef *ecs.ContainerDefinition, sourceVolumes map[string]string) (*ComposeService, error) {
+	name := aws.StringValue(containerDef.Name)
+	service := &ComposeService{
+		Image:       aws.StringValue(containerDef.Image),
+		Command:     escapeInterpolationSlice(aws.StringValueSlice(containerDef.Command)),
+		Entrypoint:  escapeInterpolationSlice(aws.StringValueSlice(containerDef.EntryPoint)),
+		Links:       aws.StringValueSlice(containerDef.Links),
+		DNS:         aws.StringValueSlice(containerDef.DnsServers),
+		DNSSearch:   aws.StringValueSlice(containerDef.DnsSearchDomains),
+		Hostname:    aws.StringValue(containerDef.Hostname),
+		Privileged:  aws.BoolValue(containerDef.Privileged),
+		ReadOnly:    aws.BoolValue(containerDef.ReadonlyRootFilesystem),
+		SecurityOpt: aws.StringValueSlice(containerDef.DockerSecurityOptions),
+		Tty:         aws.BoolValue(containerDef.PseudoTerminal),
+		User:        aws.StringValue(containerDef.User),
+		WorkingDir:  aws.StringValue(containerDef.WorkingDirectory),
+	}
+
+	if len(containerDef.Environment) > 0 {
+		service.Environment = make(map[string]string)
+		for _, env := range containerDef.Environment {
+			service.Environment[aws.StringValue(env.Name)] = escapeInterpolation(aws.StringValue(env.Value))
+		}
+	}
+
+	if len(containerDef.DockerLabels) > 0 {
+		service.Labels = make(map[string]string)
+		for key, value := range containerDef.DockerLabels {
+			service.Labels[key] = escapeInterpolation(aws.StringValue(value))
+		}
+	}
+
+	for _, portMapping := range containerDef.PortMappings {
+		service.Ports = append(service.Ports, convertFromECSPortMapping(portMapping))
+	}
+
+	for _, mountPoint := range containerDef.MountPoints {
+		source, ok := sourceVolumes[aws.StringValue(mountPoint.SourceVolume)]
+		if !ok {
+			return nil, fmt.Errorf("container %s mounts volume %s, which is not defined in the task definition", name, aws.StringValue(mountPoint.SourceVolume))
+		}
+		volume := source + ":" + aws.StringValue(mountPoint.ContainerPath)
+		if aws.BoolValue(mountPoint.ReadOnly) {
+			volume += ":ro"
+		}
+		service.Volumes = append(service.Volumes, volume)
+	}
+
+	for _, extraHost := range containerDef.ExtraHosts {
+		ipAddress := aws.StringValue(extraHost.IpAddress)
+		if strings.Contains(ipAddress, ":") {
+			logWarningForImportField("extraHosts."+aws.StringValue(extraHost.Hostname), name)
+			continue
+		}
+		service.ExtraHosts = append(service.ExtraHosts, aws.StringValue(extraHost.Hostname)+":"+ipAddress)
+	}
+
+	service.DependsOn = convertFromECSContainerDependencies(containerDef.DependsOn)
+	service.Deploy = convertFromECSResources(containerDef)
+
+	if logConfig := containerDef.LogConfiguration; logConfig != nil {
+		service.Logging = &ComposeLogging{
+			Driver: aws.StringValue(logConfig.LogDriver),
+		}
+		if len(logConfig.Options) > 0 {
+			service.Logging.Options = make(map[string]string)
+			for key, value := range logConfig.Options {
+				service.Logging.Options[key] = escapeInterpolation(aws.StringValue(value))
+			}
+		}
+	}
+
+	if stopTimeout := containerDef.StopTimeout; stopTimeout != nil {
+		service.StopGracePeriod = fmt.Sprintf("%ds", aws.Int64Value(stopTimeout))
+	}
+
+	if len(containerDef.SystemControls) > 0 {
+		service.Sysctls = make(map[string]string)
+		for _, systemControl := range containerDef.SystemControls {
+			service.Sysctls[aws.StringValue(systemControl.Namespace)] = aws.StringValue(systemControl.Value)
+		}
+	}
+
+	if len(containerDef.Ulimits) > 0 {
+		service.Ulimits = make(map[string]ComposeUlimit)
+		for _, ulimit := range containerDef.Ulimits {
+			service.Ulimits[aws.StringValue(ulimit.Name)] = ComposeUlimit{
+				Soft: aws.Int64Value(ulimit.SoftLimit),
+				Hard: aws.Int64Value(ulimit.HardLimit),
+			}
+		}
+	}
+
+	if linuxParams := containerDef.LinuxParameters; linuxParams != nil {
+		if capabilities := linuxParams.Capabilities; capabilities != nil {
+			service.CapAdd = aws.StringValueSlice(capabilities.Add)
+			service.CapDrop = aws.StringValueSlice(capabilities.Drop)
+		}
+		for _, device := range linuxParams.Devices {
+			service.Devices = append(service.Devices, convertFromECSDevice(device))
+		}
+		for _, tmpfs := range linuxParams.Tmpfs {
+			service.Tmpfs = append(service.Tmpfs, convertFromECSTmpfs(tmpfs))
+		}
+		if linuxParams.SharedMemorySize != nil {
+			logWarningForImportField("linuxParameters.sharedMemorySize", name)
+		}
+	}
+
+	if len(containerDef.VolumesFrom) > 0 {
+		logWarningForImportField("volumesFrom", name)
+	}
+	if aws.BoolValue(containerDef.DisableNetworking) {
+		logWarningForImportField("disableNetworking", name)
+	}
+	if aws.BoolValue(containerDef.Interactive) {
+		logWarningForImportField("interactive", name)
+	}
+	if containerDef.StartTimeout != nil {
+		logWarningForImportField("startTimeout", name)
+	}
+
+	return service, nil
+}
+
+// convertFromContainerDefinitionParams returns the fields of a container definition that can only be set in the ecs-params
+func convertFromContainerDefinitionParams(containerDef *ecs.ContainerDefinition) ContainerDef {
+	ecsContainerDef := ContainerDef{
+		// DescribeTaskDefinition always returns essential, which defaults to true
+		Essential: containerDef.Essential == nil || aws.BoolValue(containerDef.Essential),
+		Secrets:   convertFromECSSecrets(containerDef.Secrets),
+	}
+
+	if linuxParams := containerDef.LinuxParameters; linuxParams != nil {
+		ecsContainerDef.InitProcessEnabled = aws.BoolValue(linuxParams.InitProcessEnabled)
+	}
+
+	if repoCreds := containerDef.RepositoryCredentials; repoCreds != nil {
+		ecsContainerDef.RepositoryCredentials.CredentialsParameter = aws.StringValue(repoCreds.CredentialsParameter)
+	}
+
+	if healthCheck := containerDef.HealthCheck; healthCheck != nil {
+		ecsContainerDef.HealthCheck = &HealthCheck{
+			Command:     aws.StringValueSlice(healthCheck.Command),
+			Interval:    convertFromSeconds(healthCheck.Interval),
+			Timeout:     convertFromSeconds(healthCheck.Timeout),
+			Retries:     aws.Int64Value(healthCheck.Retries),
+			StartPeriod: convertFromSeconds(healthCheck.StartPeriod),
+		}
+	}
+
+	for _, requirement := range containerDef.ResourceRequirements {
+		if aws.StringValue(requirement.Type) == ecs.ResourceTypeGpu {
+			ecsContainerDef.GPU = aws.StringValue(requirement.Value)
+		} else {
+			logWarningForImportField("resourceRequirements."+aws.StringValue(requirement.Type), aws.StringValue(containerDef.Name))
+		}
+	}
+
+	return ecsContainerDef
+}
+
+// convertFromECSPortMapping returns a port in the format [hostPort:]containerPort[/protocol]
+func convertFromECSPortMapping(portMapping *ecs.PortMapping) string {
+	port := strconv.FormatInt(aws.Int64Value(portMapping.ContainerPort), 10)
+	if hostPort := aws.Int64Value(portMapping.HostPort); hostPort != 0 {
+		port = strconv.FormatInt(hostPort, 10) + ":" + port
+	}
+	if protocol := aws.StringValue(portMapping.Protocol); protocol != "" && protocol != ecs.TransportProtocolTcp {
+		port += "/" + protocol
+	}
+	return port
+}
+
+// convertFromECSContainerDependencies returns depends_on in the short syntax
+// if all dependencies use the default condition, and in the long syntax otherwise
+func convertFromECSContainerDependencies(dependencies []*ecs.ContainerDependency) interface{} {
+	if len(dependencies) == 0 {
+		return nil
+	}
+
+	names := []string{}
+	conditions := make(map[string]composeDependency)
+	longSyntax := false
+	for _, dependency := range dependencies {
+		name := aws.StringValue(dependency.ContainerName)
+		condition := dependencyConditions[aws.StringValue(dependency.Condition)]
+		if condition == "" {
+			condition = adapter.DependencyConditionStarted
+		}
+		if condition != adapter.DependencyConditionStarted {
+			longSyntax = true
+		}
+		names = append(names, name)
+		conditions[name] = composeDependency{Condition: condition}
+	}
+
+	if longSyntax {
+		return conditions
+	}
+	return names
+}
+
+// convertFromECSResources returns the container CPU and memory as compose deploy resources
+func convertFromECSResources(containerDef *ecs.ContainerDefinition) *ComposeDeploy {
+	resources := ComposeResources{}
+	if memory := aws.Int64Value(containerDef.Memory); memory > 0 {
+		resources.Limits = &ComposeResource{Memory: fmt.Sprintf("%dM", memory)}
+	}
+	cpu := aws.Int64Value(containerDef.Cpu)
+	memoryReservation := aws.Int64Value(containerDef.MemoryReservation)
+	if cpu > 0 || memoryReservation > 0 {
+		resources.Reservations = &ComposeResource{}
+		if cpu > 0 {
+			resources.Reservations.CPUs = strconv.FormatFloat(float64(cpu)/cpuUnitsPerCPU, 'f', -1, 64)
+		}
+		if memoryReservation > 0 {
+			resources.Reservations.Memory = fmt.Sprintf("%dM", memoryReservation)
+		}
+	}
+
+	if resources.Limits == nil && resources.Reservations == nil {
+		return nil
+	}
+	return &ComposeDeploy{Resources: resources}
+}
+
+// convertFromECSDevice returns a device in the format hostPath[:containerPath[:permissions]]
+func convertFromECSDevice(device *ecs.Device) string {
+	hostPath := aws.StringValue(device.HostPath)
+	containerPath := aws.StringValue(device.ContainerPath)
+	permissions := ""
+	for _, permission := range device.Permissions {
+		permissions += devicePermissions[aws.StringValue(permission)]
+	}
+
+	if permissions != "" {
+		if containerPath == "" {
+			containerPath = hostPath
+		}
+		return hostPath + ":" + containerPath + ":" + permissions
+	}
+	if containerPath != "" {
+		return hostPath + ":" + containerPath
+	}
+	return hostPath
+}
+
+// convertFromECSTmpfs returns a tmpfs mount in the format path:size=<size>m[,options]
+func convertFromECSTmpfs(tmpfs *ecs.Tmpfs) string {
+	options := append([]string{fmt.Sprintf("size=%dm", aws.Int64Value(tmpfs.Size))}, aws.StringValueSlice(tmpfs.MountOptions)...)
+	return aws.StringValue(tmpfs.ContainerPath) + ":" + strings.Join(options, ",")
+}
+
+func convertFromECSSecrets(secrets []*ecs.Secret) []Secret {
+	var output []Secret
+	for _, secret := range secrets {
+		output = append(output, Secret{
+			Name:      aws.StringValue(secret.Name),
+			ValueFrom: aws.StringValue(secret.ValueFrom),
+		})
+	}
+	return output
+}
+
+// convertFromSeconds returns a duration in seconds in the format accepted by the ecs-params healthcheck
+func convertFromSeconds(seconds *int64) string {
+	if seconds == nil {
+		return ""
+	}
+	return fmt.Sprintf("%ds", aws.Int64Value(seconds))
+}
+
+// escapeInterpolation escapes the dollar signs in a compose value, so that
+// it is not substituted with environment variables when the file is read
+func escapeInterpolation(value string) string {
+	return strings.Replace(value, "$", "$$", -1)
+}
+
+func escapeInterpolationSlice(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	output := make([]string, len(values))
+	for i, value := range values {
+		output[i] = escapeInterpolation(value)
+	}
+	return output
+}
+
+func logWarningForImportField(option, containerName string) {
+	fields := log.Fields{
+		"option name": option,
+	}
+	if containerName != "" {
+		fields["container name"] = containerName
+	}
+	log.WithFields(fields).Warn("Skipping task definition field with no equivalent in the compose or ECS params files...")
+}
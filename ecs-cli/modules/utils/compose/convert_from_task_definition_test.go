@@ -0,0 +1,243 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func TestConvertFromTaskDefinition(t *testing.T) {
+	taskDefinition := &ecs.TaskDefinition{
+		Family:           aws.String("web-app"),
+		NetworkMode:      aws.String(ecs.NetworkModeAwsvpc),
+		TaskRoleArn:      aws.String("arn:aws:iam::123456789012:role/task"),
+		ExecutionRoleArn: aws.String("arn:aws:iam::123456789012:role/execution"),
+		Cpu:              aws.String("512"),
+		Memory:           aws.String("1GB"),
+		PlacementConstraints: []*ecs.TaskDefinitionPlacementConstraint{
+			{Type: aws.String(ecs.TaskDefinitionPlacementConstraintTypeMemberOf), Expression: aws.String("attribute:ecs.instance-type =~ t2.*")},
+		},
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:        aws.String("web"),
+				Image:       aws.String("nginx:latest"),
+				Command:     aws.StringSlice([]string{"nginx", "-g", "daemon off;"}),
+				Environment: []*ecs.KeyValuePair{{Name: aws.String("PATH_PREFIX"), Value: aws.String("$HOME/app")}},
+				Essential:   aws.Bool(true),
+				Cpu:         aws.Int64(256),
+				Memory:      aws.Int64(512),
+				PortMappings: []*ecs.PortMapping{
+					{ContainerPort: aws.Int64(80), HostPort: aws.Int64(80), Protocol: aws.String(ecs.TransportProtocolTcp)},
+					{ContainerPort: aws.Int64(53), HostPort: aws.Int64(0), Protocol: aws.String(ecs.TransportProtocolUdp)},
+				},
+				DependsOn: []*ecs.ContainerDependency{
+					{ContainerName: aws.String("migrate"), Condition: aws.String(ecs.ContainerConditionSuccess)},
+				},
+				Secrets: []*ecs.Secret{{Name: aws.String("DB_PASSWORD"), ValueFrom: aws.String("arn:aws:ssm:us-east-1:123456789012:parameter/db")}},
+				HealthCheck: &ecs.HealthCheck{
+					Command:  aws.StringSlice([]string{"CMD-SHELL", "curl -f http://localhost"}),
+					Interval: aws.Int64(30),
+					Retries:  aws.Int64(3),
+				},
+				StopTimeout:    aws.Int64(20),
+				SystemControls: []*ecs.SystemControl{{Namespace: aws.String("net.core.somaxconn"), Value: aws.String("1024")}},
+				Ulimits:        []*ecs.Ulimit{{Name: aws.String("nofile"), SoftLimit: aws.Int64(1024), HardLimit: aws.Int64(2048)}},
+			},
+			{
+				Name:      aws.String("migrate"),
+				Image:     aws.String("migrate:latest"),
+				Essential: aws.Bool(false),
+				RepositoryCredentials: &ecs.RepositoryCredentials{
+					CredentialsParameter: aws.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:creds"),
+				},
+				LinuxParameters: &ecs.LinuxParameters{InitProcessEnabled: aws.Bool(true)},
+			},
+		},
+	}
+
+	composeFile, ecsParams, err := ConvertFromTaskDefinition(taskDefinition)
+	assert.NoError(t, err, "Unexpected error converting task definition")
+
+	assert.Equal(t, ImportedComposeVersion, composeFile.Version, "Expected compose version to match")
+	web := composeFile.Services["web"]
+	if assert.NotNil(t, web, "Expected web service") {
+		assert.Equal(t, "nginx:latest", web.Image, "Expected image to match")
+		assert.Equal(t, []string{"nginx", "-g", "daemon off;"}, web.Command, "Expected command to match")
+		assert.Equal(t, map[string]string{"PATH_PREFIX": "$$HOME/app"}, web.Environment, "Expected dollar signs to be escaped")
+		assert.Equal(t, []string{"80:80", "53/udp"}, web.Ports, "Expected ports to match")
+		assert.Equal(t, map[string]composeDependency{"migrate": {Condition: "service_completed_successfully"}}, web.DependsOn, "Expected depends_on to use the long syntax")
+		assert.Equal(t, &ComposeDeploy{Resources: ComposeResources{
+			Limits:       &ComposeResource{Memory: "512M"},
+			Reservations: &ComposeResource{CPUs: "0.25"},
+		}}, web.Deploy, "Expected deploy resources to match")
+		assert.Equal(t, "20s", web.StopGracePeriod, "Expected stop_grace_period to match")
+		assert.Equal(t, map[string]string{"net.core.somaxconn": "1024"}, web.Sysctls, "Expected sysctls to match")
+		assert.Equal(t, map[string]ComposeUlimit{"nofile": {Soft: 1024, Hard: 2048}}, web.Ulimits, "Expected ulimits to match")
+	}
+
+	assert.Equal(t, ImportedECSParamsVersion, ecsParams.Version, "Expected ecs-params version to match")
+	taskDef := ecsParams.TaskDefinition
+	assert.Equal(t, ecs.NetworkModeAwsvpc, taskDef.NetworkMode, "Expected network mode to match")
+	assert.Equal(t, "arn:aws:iam::123456789012:role/task", taskDef.TaskRoleArn, "Expected task role to match")
+	assert.Equal(t, "arn:aws:iam::123456789012:role/execution", taskDef.ExecutionRole, "Expected execution role to match")
+	assert.Equal(t, TaskSize{Cpu: "512", Memory: "1GB"}, taskDef.TaskSize, "Expected task size to match")
+	assert.Equal(t, []TaskDefinitionPlacementConstraint{{Type: "memberOf", Expression: "attribute:ecs.instance-type =~ t2.*"}}, taskDef.PlacementConstraints, "Expected placement constraints to match")
+
+	webParams := taskDef.ContainerDefinitions["web"]
+	assert.True(t, webParams.Essential, "Expected web to be essential")
+	assert.Equal(t, []Secret{{Name: "DB_PASSWORD", ValueFrom: "arn:aws:ssm:us-east-1:123456789012:parameter/db"}}, webParams.Secrets, "Expected secrets to match")
+	if assert.NotNil(t, webParams.HealthCheck, "Expected healthcheck to be set") {
+		assert.Equal(t, []string{"CMD-SHELL", "curl -f http://localhost"}, []string(webParams.HealthCheck.Command), "Expected healthcheck command to match")
+		assert.Equal(t, "30s", webParams.HealthCheck.Interval, "Expected healthcheck interval to match")
+		assert.Equal(t, int64(3), webParams.HealthCheck.Retries, "Expected healthcheck retries to match")
+		assert.Empty(t, webParams.HealthCheck.Timeout, "Expected healthcheck timeout to be unset")
+	}
+
+	migrateParams := taskDef.ContainerDefinitions["migrate"]
+	assert.False(t, migrateParams.Essential, "Expected migrate not to be essential")
+	assert.True(t, migrateParams.InitProcessEnabled, "Expected init process to be enabled")
+	assert.Equal(t, "arn:aws:secretsmanager:us-east-1:123456789012:secret:creds", migrateParams.RepositoryCredentials.CredentialsParameter, "Expected repository credentials to match")
+}
+
+func TestConvertFromTaskDefinition_Volumes(t *testing.T) {
+	taskDefinition := &ecs.TaskDefinition{
+		Volumes: []*ecs.Volume{
+			{Name: aws.String("logs"), Host: &ecs.HostVolumeProperties{SourcePath: aws.String("/var/log/app")}},
+			{Name: aws.String("scratch"), Host: &ecs.HostVolumeProperties{}},
+			{
+				Name: aws.String("data"),
+				DockerVolumeConfiguration: &ecs.DockerVolumeConfiguration{
+					Scope:         aws.String(ecs.ScopeShared),
+					Autoprovision: aws.Bool(true),
+					Driver:        aws.String("local"),
+				},
+			},
+		},
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:  aws.String("web"),
+				Image: aws.String("nginx"),
+				MountPoints: []*ecs.MountPoint{
+					{SourceVolume: aws.String("logs"), ContainerPath: aws.String("/logs")},
+					{SourceVolume: aws.String("scratch"), ContainerPath: aws.String("/scratch")},
+					{SourceVolume: aws.String("data"), ContainerPath: aws.String("/data"), ReadOnly: aws.Bool(true)},
+				},
+			},
+		},
+	}
+
+	composeFile, ecsParams, err := ConvertFromTaskDefinition(taskDefinition)
+	assert.NoError(t, err, "Unexpected error converting task definition")
+
+	assert.Equal(t, []string{"/var/log/app:/logs", "scratch:/scratch", "data:/data:ro"}, composeFile.Services["web"].Volumes, "Expected volumes to match")
+	assert.Equal(t, map[string]ComposeVolume{"scratch": {}, "data": {}}, composeFile.Volumes, "Expected named volumes to be declared")
+	assert.Equal(t, []DockerVolume{{Name: "data", Scope: ecs.ScopeShared, Autoprovision: aws.Bool(true), Driver: "local", DriverOptions: map[string]string{}, Labels: map[string]string{}}}, ecsParams.TaskDefinition.DockerVolumes, "Expected docker volumes to match")
+}
+
+func TestConvertFromTaskDefinition_ErrorWithUndefinedVolume(t *testing.T) {
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:        aws.String("web"),
+				MountPoints: []*ecs.MountPoint{{SourceVolume: aws.String("logs"), ContainerPath: aws.String("/logs")}},
+			},
+		},
+	}
+
+	_, _, err := ConvertFromTaskDefinition(taskDefinition)
+	assert.Error(t, err, "Expected error when a mount point refers to an undefined volume")
+}
+
+func TestConvertFromTaskDefinition_ErrorWithNoContainers(t *testing.T) {
+	_, _, err := ConvertFromTaskDefinition(&ecs.TaskDefinition{})
+	assert.Error(t, err, "Expected error when the task definition has no containers")
+}
+
+func TestConvertFromTaskDefinition_LinuxParameters(t *testing.T) {
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name: aws.String("web"),
+				LinuxParameters: &ecs.LinuxParameters{
+					Capabilities: &ecs.KernelCapabilities{
+						Add:  aws.StringSlice([]string{"NET_ADMIN"}),
+						Drop: aws.StringSlice([]string{"MKNOD"}),
+					},
+					Devices: []*ecs.Device{
+						{HostPath: aws.String("/dev/sda")},
+						{HostPath: aws.String("/dev/xvdc"), ContainerPath: aws.String("/dev/sdc")},
+						{HostPath: aws.String("/dev/fuse"), Permissions: aws.StringSlice([]string{ecs.DeviceCgroupPermissionRead, ecs.DeviceCgroupPermissionMknod})},
+					},
+					Tmpfs: []*ecs.Tmpfs{
+						{ContainerPath: aws.String("/run"), Size: aws.Int64(64), MountOptions: aws.StringSlice([]string{"rw", "noexec"})},
+					},
+				},
+			},
+		},
+	}
+
+	composeFile, _, err := ConvertFromTaskDefinition(taskDefinition)
+	assert.NoError(t, err, "Unexpected error converting task definition")
+
+	web := composeFile.Services["web"]
+	assert.Equal(t, []string{"NET_ADMIN"}, web.CapAdd, "Expected cap_add to match")
+	assert.Equal(t, []string{"MKNOD"}, web.CapDrop, "Expected cap_drop to match")
+	assert.Equal(t, []string{"/dev/sda", "/dev/xvdc:/dev/sdc", "/dev/fuse:/dev/fuse:rm"}, web.Devices, "Expected devices to match")
+	assert.Equal(t, []string{"/run:size=64m,rw,noexec"}, web.Tmpfs, "Expected tmpfs to match")
+}
+
+func TestConvertFromTaskDefinition_DependsOnShortSyntax(t *testing.T) {
+	taskDefinition := &ecs.TaskDefinition{
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{
+				Name:      aws.String("web"),
+				DependsOn: []*ecs.ContainerDependency{{ContainerName: aws.String("db"), Condition: aws.String(ecs.ContainerConditionStart)}},
+			},
+			{Name: aws.String("db")},
+		},
+	}
+
+	composeFile, _, err := ConvertFromTaskDefinition(taskDefinition)
+	assert.NoError(t, err, "Unexpected error converting task definition")
+	assert.Equal(t, []string{"db"}, composeFile.Services["web"].DependsOn, "Expected depends_on to use the short syntax")
+	assert.Nil(t, composeFile.Services["db"].DependsOn, "Expected no depends_on")
+}
+
+func TestConvertFromTaskDefinition_MarshalledECSParamsOmitEmptyFields(t *testing.T) {
+	taskDefinition := &ecs.TaskDefinition{
+		NetworkMode: aws.String(ecs.NetworkModeBridge),
+		ContainerDefinitions: []*ecs.ContainerDefinition{
+			{Name: aws.String("web"), Essential: aws.Bool(true)},
+		},
+	}
+
+	_, ecsParams, err := ConvertFromTaskDefinition(taskDefinition)
+	assert.NoError(t, err, "Unexpected error converting task definition")
+
+	data, err := yaml.Marshal(ecsParams)
+	assert.NoError(t, err, "Unexpected error marshalling ecs-params")
+	expected := `version: "1"
+task_definition:
+  ecs_network_mode: bridge
+  services:
+    web:
+      essential: true
+`
+	assert.Equal(t, expected, string(data), "Expected only the fields that are set to be written")
+}
@@ -15,6 +15,7 @@ package utils
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils/regcredio"
@@ -30,10 +31,13 @@ type TaskDefParams struct {
 	taskRoleArn      string
 	cpu              string
 	memory           string
+	autoTaskSize     bool
 	pidMode          string
 	ipcMode          string
 	containerDefs    ContainerDefs
 	executionRoleArn string
+	proxyConfig      *ecs.ProxyConfiguration
+	constraints      []*ecs.TaskDefinitionPlacementConstraint
 }
 
 // ConvertTaskDefParams contains the inputs required to convert compose & ECS inputs into an ECS task definition
@@ -59,11 +63,26 @@ func ConvertToTaskDefinition(params ConvertTaskDefParams) (*ecs.TaskDefinition,
 		return nil, err
 	}
 
+	// check an explicit Fargate task size before anything is registered or created
+	if params.RequiredCompatibilites == ecs.LaunchTypeFargate && !taskDefParams.autoTaskSize && (taskDefParams.cpu != "" || taskDefParams.memory != "") {
+		if err := ValidateFargateTaskSize(taskDefParams.cpu, taskDefParams.memory); err != nil {
+			return nil, err
+		}
+	}
+
 	// The task-role-arn flag takes precedence over a taskRoleArn value specified in ecs-params file.
 	if params.TaskRoleArn == "" {
 		params.TaskRoleArn = taskDefParams.taskRoleArn
 	}
 
+	if err := validateContainerDependencies(params.ContainerConfigs); err != nil {
+		return nil, err
+	}
+
+	if err := validateProxyContainer(taskDefParams.proxyConfig, params.ContainerConfigs); err != nil {
+		return nil, err
+	}
+
 	// Create containerDefinitions
 	containerDefinitions := []*ecs.ContainerDefinition{}
 
@@ -76,8 +95,7 @@ func ConvertToTaskDefinition(params ConvertTaskDefParams) (*ecs.TaskDefinition,
 		}
 
 		// Validate essential containers
-		count := len(params.ContainerConfigs)
-		if !hasEssential(taskDefParams.containerDefs, count) {
+		if !hasEssential(taskDefParams.containerDefs, params.ContainerConfigs) {
 			return nil, errors.New("Task definition does not have any essential containers")
 		}
 
@@ -93,6 +111,16 @@ func ConvertToTaskDefinition(params ConvertTaskDefParams) (*ecs.TaskDefinition,
 		containerDefinitions = append(containerDefinitions, containerDef)
 	}
 
+	if err := validateContainerDependencyConditions(containerDefinitions); err != nil {
+		return nil, err
+	}
+
+	if taskDefParams.autoTaskSize {
+		if taskDefParams.cpu, taskDefParams.memory, err = resolveAutoTaskSize(containerDefinitions); err != nil {
+			return nil, err
+		}
+	}
+
 	ecsVolumes, err := convertToECSVolumes(params.Volumes, params.ECSParams)
 	if err != nil {
 		return nil, err
@@ -147,9 +175,32 @@ func ConvertToTaskDefinition(params ConvertTaskDefParams) (*ecs.TaskDefinition,
 	if taskDefParams.ipcMode != "" {
 		taskDefinition.SetIpcMode(taskDefParams.ipcMode)
 	}
+	if taskDefParams.proxyConfig != nil {
+		taskDefinition.SetProxyConfiguration(taskDefParams.proxyConfig)
+	}
+	if len(taskDefParams.constraints) > 0 {
+		taskDefinition.SetPlacementConstraints(taskDefParams.constraints)
+	}
 	return taskDefinition, nil
 }
 
+// validateProxyContainer checks that the container named in the proxy
+// configuration is one of the services of the compose project
+func validateProxyContainer(proxyConfig *ecs.ProxyConfiguration, containerConfigs []adapter.ContainerConfig) error {
+	if proxyConfig == nil {
+		return nil
+	}
+	proxyContainer := aws.StringValue(proxyConfig.ContainerName)
+	names := make([]string, len(containerConfigs))
+	for i, containerConfig := range containerConfigs {
+		if containerConfig.Name == proxyContainer {
+			return nil
+		}
+		names[i] = containerConfig.Name
+	}
+	return fmt.Errorf("proxy_configuration.container_name %s is not a service in the compose file; expected one of %s", proxyContainer, strings.Join(names, ", "))
+}
+
 func resolveHealthCheck(serviceName string, healthCheck *ecs.HealthCheck, ecsParamsHealthCheck *HealthCheck) (*ecs.HealthCheck, error) {
 	if ecsParamsHealthCheck != nil {
 		healthCheckOverride, err := ecsParamsHealthCheck.ConvertToECSHealthCheck()
@@ -290,7 +341,7 @@ func mergeVolumesWithoutHost(composeVolumes []string, ecsParams *ECSParams) ([]*
 	return output, nil
 }
 
-func hasEssential(ecsParamsContainerDefs ContainerDefs, count int) bool {
+func hasEssential(ecsParamsContainerDefs ContainerDefs, containerConfigs []adapter.ContainerConfig) bool {
 	// If the customer does not set the "essential" field on any container
 	// definition, ECS will mark all containers in a TaskDefinition as
 	// essential. Previously, since the customer could not pass in the
@@ -302,16 +353,17 @@ func hasEssential(ecsParamsContainerDefs ContainerDefs, count int) bool {
 	// least one essential container, i.e. that the customer does not
 	// explicitly set all containers to be non-essential.
 
-	nonEssentialCount := 0
-
-	for _, containerDef := range ecsParamsContainerDefs {
-		if !containerDef.Essential {
-			nonEssentialCount++
+	// Only the containers of the task definition count, so that ecs-params
+	// entries for services that are not in the compose file, or that were
+	// left out with --exclude-services, do not hide a missing essential
+	// container.
+	for _, containerConfig := range containerConfigs {
+		containerDef, ok := ecsParamsContainerDefs[containerConfig.Name]
+		if !ok || containerDef.Essential {
+			return true
 		}
 	}
-
-	// 'count' is the total number of containers specified in the service config
-	return nonEssentialCount != count
+	return false
 }
 
 // Converts fields from ecsParams into the appropriate types for fields on an
@@ -327,10 +379,25 @@ func convertTaskDefParams(ecsParams *ECSParams) (params TaskDefParams, e error)
 	params.containerDefs = taskDef.ContainerDefinitions
 	params.cpu = taskDef.TaskSize.Cpu
 	params.memory = taskDef.TaskSize.Memory
+	params.autoTaskSize = taskDef.TaskSize.Auto
 	params.executionRoleArn = taskDef.ExecutionRole
 	params.ipcMode = taskDef.IPCMode
 	params.pidMode = taskDef.PIDMode
 
+	if taskDef.ProxyConfiguration != nil {
+		params.proxyConfig, e = taskDef.ProxyConfiguration.ConvertToECSProxyConfiguration()
+		if e != nil {
+			return params, e
+		}
+	}
+
+	if len(taskDef.PlacementConstraints) > 0 {
+		params.constraints, e = ConvertToECSTaskDefinitionPlacementConstraints(taskDef.PlacementConstraints)
+		if e != nil {
+			return params, e
+		}
+	}
+
 	return params, nil
 }
 
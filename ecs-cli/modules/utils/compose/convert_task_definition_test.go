@@ -1846,6 +1846,155 @@ task_definition:
 // helper functions //
 //////////////////////
 
+func TestConvertToTaskDefinitionWithDependsOn(t *testing.T) {
+	containerConfigs := testContainerConfigs([]string{"web", "migrations", "db"})
+	containerConfigs[0].DependsOn = []adapter.ContainerDependency{
+		{Name: "migrations", Condition: adapter.DependencyConditionCompletedSuccessfully},
+		{Name: "db", Condition: adapter.DependencyConditionHealthy},
+	}
+	containerConfigs[1].DependsOn = []adapter.ContainerDependency{
+		{Name: "db", Condition: adapter.DependencyConditionStarted},
+	}
+	containerConfigs[2].HealthCheck = &ecs.HealthCheck{
+		Command: aws.StringSlice([]string{"CMD-SHELL", "pg_isready"}),
+	}
+
+	ecsParamsString := `version: 1
+task_definition:
+  services:
+    migrations:
+      essential: false`
+
+	ecsParams, err := createTempECSParamsForTest(t, ecsParamsString)
+	assert.NoError(t, err, "Unexpected error reading ECS Params")
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+	if assert.NoError(t, err) {
+		web := findContainerByName("web", taskDefinition.ContainerDefinitions)
+		assert.Equal(t, []*ecs.ContainerDependency{
+			{ContainerName: aws.String("migrations"), Condition: aws.String(ecs.ContainerConditionSuccess)},
+			{ContainerName: aws.String("db"), Condition: aws.String(ecs.ContainerConditionHealthy)},
+		}, web.DependsOn, "Expected DependsOn to match")
+
+		migrations := findContainerByName("migrations", taskDefinition.ContainerDefinitions)
+		assert.Equal(t, []*ecs.ContainerDependency{
+			{ContainerName: aws.String("db"), Condition: aws.String(ecs.ContainerConditionStart)},
+		}, migrations.DependsOn, "Expected DependsOn to match")
+
+		db := findContainerByName("db", taskDefinition.ContainerDefinitions)
+		assert.Nil(t, db.DependsOn, "Expected DependsOn to be nil")
+	}
+}
+
+func TestConvertToTaskDefinitionWithDependsOn_ErrorWhenUnknownService(t *testing.T) {
+	containerConfigs := testContainerConfigs([]string{"web"})
+	containerConfigs[0].DependsOn = []adapter.ContainerDependency{
+		{Name: "db", Condition: adapter.DependencyConditionStarted},
+	}
+
+	_, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", nil, nil)
+	assert.Error(t, err, "Expected error when depending on an undefined service")
+}
+
+func TestConvertToTaskDefinitionWithDependsOn_ErrorWhenCompletedContainerIsEssential(t *testing.T) {
+	containerConfigs := testContainerConfigs([]string{"web", "migrations"})
+	containerConfigs[0].DependsOn = []adapter.ContainerDependency{
+		{Name: "migrations", Condition: adapter.DependencyConditionCompleted},
+	}
+
+	_, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", nil, nil)
+	assert.Error(t, err, "Expected error when depending on an essential container completing")
+}
+
+func TestConvertToTaskDefinitionWithDependsOn_ErrorWhenHealthyContainerHasNoHealthCheck(t *testing.T) {
+	containerConfigs := testContainerConfigs([]string{"web", "db"})
+	containerConfigs[0].DependsOn = []adapter.ContainerDependency{
+		{Name: "db", Condition: adapter.DependencyConditionHealthy},
+	}
+
+	_, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", nil, nil)
+	assert.Error(t, err, "Expected error when depending on a container without a healthcheck being healthy")
+}
+
+func TestConvertToTaskDefinitionWithStopTimeoutAndSysctls(t *testing.T) {
+	containerConfigs := testContainerConfigs([]string{"web", "worker"})
+	containerConfigs[0].StopTimeout = aws.Int64(60)
+	containerConfigs[0].Sysctls = map[string]string{
+		"net.ipv4.tcp_keepalive_time": "200",
+		"net.core.somaxconn":          "1024",
+	}
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", nil, nil)
+	if assert.NoError(t, err) {
+		web := findContainerByName("web", taskDefinition.ContainerDefinitions)
+		assert.Equal(t, int64(60), aws.Int64Value(web.StopTimeout), "Expected StopTimeout to match")
+		assert.Equal(t, []*ecs.SystemControl{
+			{Namespace: aws.String("net.core.somaxconn"), Value: aws.String("1024")},
+			{Namespace: aws.String("net.ipv4.tcp_keepalive_time"), Value: aws.String("200")},
+		}, web.SystemControls, "Expected SystemControls to match")
+
+		worker := findContainerByName("worker", taskDefinition.ContainerDefinitions)
+		assert.Nil(t, worker.StopTimeout, "Expected StopTimeout to be nil")
+		assert.Nil(t, worker.SystemControls, "Expected SystemControls to be nil")
+	}
+}
+
+func TestConvertToTaskDefinitionWithECSParams_StopTimeoutAndSysctlsOverrideCompose(t *testing.T) {
+	containerConfigs := testContainerConfigs([]string{"web", "worker"})
+	containerConfigs[0].StopTimeout = aws.Int64(60)
+	containerConfigs[0].Sysctls = map[string]string{
+		"net.core.somaxconn":          "1024",
+		"net.ipv4.tcp_keepalive_time": "200",
+	}
+
+	ecsParamsString := `version: 1
+task_definition:
+  services:
+    web:
+      stop_grace_period: 2m
+      sysctls:
+        net.core.somaxconn: 4096
+    worker:
+      stop_grace_period: 10s
+      sysctls:
+        - net.ipv4.ip_forward=1`
+
+	ecsParams, err := createTempECSParamsForTest(t, ecsParamsString)
+	assert.NoError(t, err, "Unexpected error reading ECS Params")
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+	if assert.NoError(t, err) {
+		web := findContainerByName("web", taskDefinition.ContainerDefinitions)
+		assert.Equal(t, int64(120), aws.Int64Value(web.StopTimeout), "Expected StopTimeout to match")
+		assert.Equal(t, []*ecs.SystemControl{
+			{Namespace: aws.String("net.core.somaxconn"), Value: aws.String("4096")},
+			{Namespace: aws.String("net.ipv4.tcp_keepalive_time"), Value: aws.String("200")},
+		}, web.SystemControls, "Expected SystemControls to match")
+
+		worker := findContainerByName("worker", taskDefinition.ContainerDefinitions)
+		assert.Equal(t, int64(10), aws.Int64Value(worker.StopTimeout), "Expected StopTimeout to match")
+		assert.Equal(t, []*ecs.SystemControl{
+			{Namespace: aws.String("net.ipv4.ip_forward"), Value: aws.String("1")},
+		}, worker.SystemControls, "Expected SystemControls to match")
+	}
+}
+
+func TestConvertToTaskDefinitionWithECSParams_ErrorWithInvalidStopGracePeriod(t *testing.T) {
+	containerConfigs := testContainerConfigs([]string{"web"})
+
+	ecsParamsString := `version: 1
+task_definition:
+  services:
+    web:
+      stop_grace_period: soon`
+
+	ecsParams, err := createTempECSParamsForTest(t, ecsParamsString)
+	assert.NoError(t, err, "Unexpected error reading ECS Params")
+
+	_, err = convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+	assert.Error(t, err, "Expected error with invalid stop_grace_period")
+}
+
 func convertToTaskDefinitionForTest(t *testing.T, containerConfigs []adapter.ContainerConfig, taskRoleArn string, launchType string, ecsParams *ECSParams, ecsRegCreds *regcredio.ECSRegistryCredsOutput) (*ecs.TaskDefinition, error) {
 	volumeConfigs := &adapter.Volumes{
 		VolumeEmptyHost: []string{namedVolume},
@@ -1897,3 +2046,128 @@ func createTempECSParamsForTest(t *testing.T, content string) (*ECSParams, error
 	assert.NoError(t, err, "Could not read ECS Params file")
 	return ecsParams, err
 }
+
+func TestConvertToTaskDefinitionWithECSParams_WithAutoTaskSize(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{
+		{Name: "web", CPU: int64(256), Memory: int64(1024)},
+		{Name: "sidecar", CPU: int64(128), MemoryReservation: int64(256)},
+	}
+	ecsParams := &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			TaskSize: TaskSize{Auto: true},
+		},
+	}
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "512", aws.StringValue(taskDefinition.Cpu), "Expected CPU to fit the containers")
+		assert.Equal(t, "2048", aws.StringValue(taskDefinition.Memory), "Expected memory to fit the containers")
+	}
+}
+
+func TestConvertToTaskDefinitionWithECSParams_InvalidFargateTaskSize(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{
+		{Name: "web", Image: "httpd"},
+	}
+	ecsParams := &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			NetworkMode: "awsvpc",
+			TaskSize:    TaskSize{Cpu: "256", Memory: "4GB"},
+		},
+	}
+
+	_, err := convertToTaskDefinitionForTest(t, containerConfigs, "", ecs.LaunchTypeFargate, ecsParams, nil)
+	assert.Error(t, err, "Expected error when the task size is not a valid Fargate combination")
+
+	_, err = convertToTaskDefinitionForTest(t, containerConfigs, "", ecs.LaunchTypeEc2, ecsParams, nil)
+	assert.NoError(t, err, "Expected task size not to be validated for EC2")
+}
+
+func TestConvertToTaskDefinitionWithECSParams_ProxyConfiguration(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{
+		{Name: "web", Image: "httpd"},
+		{Name: "envoy", Image: "envoy"},
+	}
+	ecsParams := &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			ProxyConfiguration: appMeshProxyConfiguration(),
+		},
+	}
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+
+	if assert.NoError(t, err) && assert.NotNil(t, taskDefinition.ProxyConfiguration) {
+		assert.Equal(t, "envoy", aws.StringValue(taskDefinition.ProxyConfiguration.ContainerName))
+		assert.Equal(t, ecs.ProxyConfigurationTypeAppmesh, aws.StringValue(taskDefinition.ProxyConfiguration.Type))
+		assert.Len(t, taskDefinition.ProxyConfiguration.Properties, 5)
+	}
+}
+
+func TestConvertToTaskDefinitionWithECSParams_ErrorWhenProxyContainerIsUnknown(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{
+		{Name: "web", Image: "httpd"},
+	}
+	ecsParams := &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			ProxyConfiguration: appMeshProxyConfiguration(),
+		},
+	}
+
+	_, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+	assert.EqualError(t, err, "proxy_configuration.container_name envoy is not a service in the compose file; expected one of web")
+}
+
+func TestConvertToTaskDefinitionWithECSParams_PlacementConstraints(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{
+		{Name: "web", Image: "httpd"},
+	}
+	ecsParams := &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			PlacementConstraints: []TaskDefinitionPlacementConstraint{
+				{Expression: "attribute:ecs.instance-type =~ t2.*"},
+			},
+		},
+	}
+
+	taskDefinition, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+
+	if assert.NoError(t, err) && assert.Len(t, taskDefinition.PlacementConstraints, 1) {
+		assert.Equal(t, ecs.TaskDefinitionPlacementConstraintTypeMemberOf, aws.StringValue(taskDefinition.PlacementConstraints[0].Type))
+		assert.Equal(t, "attribute:ecs.instance-type =~ t2.*", aws.StringValue(taskDefinition.PlacementConstraints[0].Expression))
+	}
+}
+
+func TestConvertToTaskDefinitionWithECSParams_ErrorWithInvalidPlacementExpression(t *testing.T) {
+	containerConfigs := []adapter.ContainerConfig{
+		{Name: "web", Image: "httpd"},
+	}
+	ecsParams := &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			PlacementConstraints: []TaskDefinitionPlacementConstraint{
+				{Expression: "attribute:ecs.instance-type =~"},
+			},
+		},
+	}
+
+	_, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+	assert.Error(t, err, "Expected error with an invalid placement expression")
+}
+
+func TestConvertToTaskDefinitionWithECSParams_ErrorWhenNoSelectedServiceIsEssential(t *testing.T) {
+	// ecs-params may describe services that were left out of the task
+	// definition; they must not count towards the essential containers
+	containerConfigs := testContainerConfigs([]string{"mysql", "wordpress"})
+	ecsParams := &ECSParams{
+		TaskDefinition: EcsTaskDef{
+			ContainerDefinitions: ContainerDefs{
+				"mysql":     {Essential: false},
+				"wordpress": {Essential: false},
+				"mock":      {Essential: false},
+			},
+		},
+	}
+
+	_, err := convertToTaskDefinitionForTest(t, containerConfigs, "", "", ecsParams, nil)
+	assert.EqualError(t, err, "Task definition does not have any essential containers")
+}
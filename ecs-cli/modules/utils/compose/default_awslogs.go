@@ -0,0 +1,64 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+const (
+	// DefaultAWSLogsGroup is the log group template used when default_awslogs has no log_group
+	DefaultAWSLogsGroup = "/ecs/{cluster}/{project}"
+	// DefaultAWSLogsStreamPrefix is the stream prefix used when default_awslogs has no stream_prefix
+	DefaultAWSLogsStreamPrefix = "ecs"
+
+	awslogsGroupOption        = "awslogs-group"
+	awslogsRegionOption       = "awslogs-region"
+	awslogsStreamPrefixOption = "awslogs-stream-prefix"
+)
+
+// LogRetentionInDays lists the retention periods accepted by CloudWatch Logs
+var LogRetentionInDays = []string{"1", "3", "5", "7", "14", "30", "60", "90", "120", "150", "180", "365", "400", "545", "731", "1827", "3653"}
+
+// LogConfiguration returns the awslogs log configuration of a container
+// without one, sending its logs to the log group named by expanding the
+// LogGroup template in the given region
+func (l *DefaultAWSLogs) LogConfiguration(cluster, project, container, region string) *ecs.LogConfiguration {
+	logGroup := l.LogGroup
+	if logGroup == "" {
+		logGroup = DefaultAWSLogsGroup
+	}
+	logGroup = strings.NewReplacer(
+		"{cluster}", cluster,
+		"{project}", project,
+		"{container}", container,
+	).Replace(logGroup)
+
+	streamPrefix := l.StreamPrefix
+	if streamPrefix == "" {
+		streamPrefix = DefaultAWSLogsStreamPrefix
+	}
+
+	return &ecs.LogConfiguration{
+		LogDriver: aws.String(ecs.LogDriverAwslogs),
+		Options: map[string]*string{
+			awslogsGroupOption:        aws.String(logGroup),
+			awslogsRegionOption:       aws.String(region),
+			awslogsStreamPrefixOption: aws.String(streamPrefix),
+		},
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultAWSLogsLogConfiguration(t *testing.T) {
+	defaults := &DefaultAWSLogs{}
+
+	logConfig := defaults.LogConfiguration("prod", "shop", "web", "us-west-2")
+
+	assert.Equal(t, ecs.LogDriverAwslogs, aws.StringValue(logConfig.LogDriver))
+	assert.Equal(t, map[string]*string{
+		"awslogs-group":         aws.String("/ecs/prod/shop"),
+		"awslogs-region":        aws.String("us-west-2"),
+		"awslogs-stream-prefix": aws.String("ecs"),
+	}, logConfig.Options)
+}
+
+func TestDefaultAWSLogsLogConfiguration_WithTemplate(t *testing.T) {
+	defaults := &DefaultAWSLogs{
+		LogGroup:     "{cluster}-{project}/{container}",
+		StreamPrefix: "shop",
+	}
+
+	logConfig := defaults.LogConfiguration("prod", "shop", "web", "us-west-2")
+
+	assert.Equal(t, "prod-shop/web", aws.StringValue(logConfig.Options["awslogs-group"]))
+	assert.Equal(t, "shop", aws.StringValue(logConfig.Options["awslogs-stream-prefix"]))
+}
@@ -20,13 +20,16 @@ import (
 	"io/ioutil"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
+	cliUtils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	libYaml "github.com/docker/libcompose/yaml"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
 )
 
@@ -36,21 +39,25 @@ import (
 
 // ECSParams contains the information parsed from the ecs-params.yml file
 type ECSParams struct {
-	Version        string
-	TaskDefinition EcsTaskDef `yaml:"task_definition"`
-	RunParams      RunParams  `yaml:"run_params"`
+	Version          string
+	TaskDefinition   EcsTaskDef                 `yaml:"task_definition,omitempty"`
+	RunParams        RunParams                  `yaml:"run_params,omitempty"`
+	ServiceOverrides map[string]ServiceOverride `yaml:"service_overrides,omitempty"`
 }
 
 // EcsTaskDef corresponds to fields in an ECS TaskDefinition
 type EcsTaskDef struct {
-	NetworkMode          string         `yaml:"ecs_network_mode"`
-	TaskRoleArn          string         `yaml:"task_role_arn"`
-	PIDMode              string         `yaml:"pid_mode"`
-	IPCMode              string         `yaml:"ipc_mode"`
-	ContainerDefinitions ContainerDefs  `yaml:"services"`
-	ExecutionRole        string         `yaml:"task_execution_role"`
-	TaskSize             TaskSize       `yaml:"task_size"` // Needed to run FARGATE tasks
-	DockerVolumes        []DockerVolume `yaml:"docker_volumes"`
+	NetworkMode          string                              `yaml:"ecs_network_mode,omitempty"`
+	TaskRoleArn          string                              `yaml:"task_role_arn,omitempty"`
+	PIDMode              string                              `yaml:"pid_mode,omitempty"`
+	IPCMode              string                              `yaml:"ipc_mode,omitempty"`
+	ContainerDefinitions ContainerDefs                       `yaml:"services,omitempty"`
+	ExecutionRole        string                              `yaml:"task_execution_role,omitempty"`
+	TaskSize             TaskSize                            `yaml:"task_size,omitempty"` // Needed to run FARGATE tasks
+	DockerVolumes        []DockerVolume                      `yaml:"docker_volumes,omitempty"`
+	ProxyConfiguration   *ProxyConfiguration                 `yaml:"proxy_configuration,omitempty"`
+	PlacementConstraints []TaskDefinitionPlacementConstraint `yaml:"placement_constraints,omitempty"`
+	DefaultAWSLogs       *DefaultAWSLogs                     `yaml:"default_awslogs,omitempty"`
 }
 
 // ContainerDefs is a map of ContainerDefs within a task definition
@@ -59,74 +66,126 @@ type ContainerDefs map[string]ContainerDef
 // ContainerDef holds fields for an ECS Container Definition that are not supplied by docker-compose
 type ContainerDef struct {
 	Essential             bool                  `yaml:"essential"`
-	InitProcessEnabled    bool                  `yaml:"init_process_enabled"`
-	RepositoryCredentials RepositoryCredentials `yaml:"repository_credentials"`
+	InitProcessEnabled    bool                  `yaml:"init_process_enabled,omitempty"`
+	RepositoryCredentials RepositoryCredentials `yaml:"repository_credentials,omitempty"`
 	// resource field yaml names correspond to equivalent docker-compose field
-	Cpu               int64                  `yaml:"cpu_shares"`
-	Memory            libYaml.MemStringorInt `yaml:"mem_limit"`
-	MemoryReservation libYaml.MemStringorInt `yaml:"mem_reservation"`
-	HealthCheck       *HealthCheck           `yaml:"healthcheck"`
-	Secrets           []Secret               `yaml:"secrets"`
-	GPU               string                 `yaml:"gpu"`
+	Cpu               int64                  `yaml:"cpu_shares,omitempty"`
+	Memory            libYaml.MemStringorInt `yaml:"mem_limit,omitempty"`
+	MemoryReservation libYaml.MemStringorInt `yaml:"mem_reservation,omitempty"`
+	HealthCheck       *HealthCheck           `yaml:"healthcheck,omitempty"`
+	Secrets           []Secret               `yaml:"secrets,omitempty"`
+	GPU               string                 `yaml:"gpu,omitempty"`
+	StopGracePeriod   string                 `yaml:"stop_grace_period,omitempty"`
+	Sysctls           Sysctls                `yaml:"sysctls,omitempty"`
 }
 
 type DockerVolume struct {
-	Name          string            `yaml:"name"`
-	Scope         string            `yaml:"scope"`
-	Autoprovision *bool             `yaml:"autoprovision"`
-	Driver        string            `yaml:"driver"`
-	DriverOptions map[string]string `yaml:"driver_opts"`
-	Labels        map[string]string `yaml:"labels"`
+	Name          string            `yaml:"name,omitempty"`
+	Scope         string            `yaml:"scope,omitempty"`
+	Autoprovision *bool             `yaml:"autoprovision,omitempty"`
+	Driver        string            `yaml:"driver,omitempty"`
+	DriverOptions map[string]string `yaml:"driver_opts,omitempty"`
+	Labels        map[string]string `yaml:"labels,omitempty"`
 }
 
 // HealthCheck holds all possible fields for HealthCheck, including fields
 // supported by docker compose vs ECS
 type HealthCheck struct {
-	Test        libYaml.Stringorslice
-	Command     libYaml.Stringorslice
-	Timeout     string `yaml:"timeout,omitempty"`
-	Interval    string `yaml:"interval,omitempty"`
-	Retries     int64  `yaml:"retries,omitempty"`
-	StartPeriod string `yaml:"start_period,omitempty"`
+	Test        libYaml.Stringorslice `yaml:"test,omitempty"`
+	Command     libYaml.Stringorslice `yaml:"command,omitempty"`
+	Timeout     string                `yaml:"timeout,omitempty"`
+	Interval    string                `yaml:"interval,omitempty"`
+	Retries     int64                 `yaml:"retries,omitempty"`
+	StartPeriod string                `yaml:"start_period,omitempty"`
 }
 
 // RepositoryCredentials holds CredentialParameters for a ContainerDef
 type RepositoryCredentials struct {
-	CredentialsParameter string `yaml:"credentials_parameter"`
+	CredentialsParameter string `yaml:"credentials_parameter,omitempty"`
 }
 
 // Secret supports the ECS Secrets integration with SSM Parameter Store
 type Secret struct {
-	ValueFrom string `yaml:"value_from"`
-	Name      string `yaml:"name"`
+	ValueFrom string `yaml:"value_from,omitempty"`
+	Name      string `yaml:"name,omitempty"`
 }
 
+// Sysctls holds kernel parameters for a ContainerDef. Can be specified as a
+// map of parameter to value, or as a list of "parameter=value" strings.
+type Sysctls map[string]string
+
 // TaskSize holds Cpu and Memory values needed for Fargate tasks
 // https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task-cpu-memory-error.html
+// Auto is set when task_size is "auto", in which case the smallest Fargate
+// size that fits the containers is used.
 type TaskSize struct {
-	Cpu    string `yaml:"cpu_limit"`
-	Memory string `yaml:"mem_limit"`
+	Cpu    string `yaml:"cpu_limit,omitempty"`
+	Memory string `yaml:"mem_limit,omitempty"`
+	Auto   bool   `yaml:"-"`
+}
+
+// ProxyConfiguration holds the App Mesh proxy configuration of a task. Each
+// field other than Type and ContainerName is passed to ECS as the property of
+// the same name.
+type ProxyConfiguration struct {
+	Type               string   `yaml:"type,omitempty"`
+	ContainerName      string   `yaml:"container_name,omitempty"`
+	AppPorts           []int64  `yaml:"app_ports,omitempty"`
+	ProxyIngressPort   *int64   `yaml:"proxy_ingress_port,omitempty"`
+	ProxyEgressPort    *int64   `yaml:"proxy_egress_port,omitempty"`
+	EgressIgnoredIPs   []string `yaml:"egress_ignored_ips,omitempty"`
+	EgressIgnoredPorts []int64  `yaml:"egress_ignored_ports,omitempty"`
+	IgnoredUID         *int64   `yaml:"ignored_uid,omitempty"`
+	IgnoredGID         *int64   `yaml:"ignored_gid,omitempty"`
+}
+
+// TaskDefinitionPlacementConstraint is a placement constraint registered with
+// the task definition, which applies to every task and service that uses it.
+// Type defaults to memberOf, the only type ECS supports here.
+type TaskDefinitionPlacementConstraint struct {
+	Type       string `yaml:"type,omitempty"`
+	Expression string `yaml:"expression,omitempty"`
+}
+
+// DefaultAWSLogs configures the awslogs log driver of every container that
+// has no logging configuration in the compose file. LogGroup is a template
+// that may contain the {cluster}, {project} and {container} placeholders.
+type DefaultAWSLogs struct {
+	LogGroup        string `yaml:"log_group,omitempty"`
+	StreamPrefix    string `yaml:"stream_prefix,omitempty"`
+	RetentionInDays int64  `yaml:"retention_in_days,omitempty"`
+}
+
+// ServiceOverride holds the parameters of a single compose service that is
+// deployed as its own task definition and ECS service. The fields that are
+// set replace the project wide values for that service.
+type ServiceOverride struct {
+	DesiredCount         *int64                `yaml:"desired_count,omitempty"`
+	TaskSize             *TaskSize             `yaml:"task_size,omitempty"`
+	NetworkConfiguration *NetworkConfiguration `yaml:"network_configuration,omitempty"`
+	TaskPlacement        *TaskPlacement        `yaml:"task_placement,omitempty"`
 }
 
 // RunParams specifies non-TaskDefinition specific parameters
 type RunParams struct {
-	NetworkConfiguration NetworkConfiguration `yaml:"network_configuration"`
-	TaskPlacement        TaskPlacement        `yaml:"task_placement"`
-	ServiceDiscovery     ServiceDiscovery     `yaml:"service_discovery"`
+	NetworkConfiguration NetworkConfiguration `yaml:"network_configuration,omitempty"`
+	TaskPlacement        TaskPlacement        `yaml:"task_placement,omitempty"`
+	ServiceDiscovery     ServiceDiscovery     `yaml:"service_discovery,omitempty"`
+	PlatformVersion      string               `yaml:"platform_version,omitempty"`
 }
 
 // NetworkConfiguration specifies the network config for the task definition.
 // Supports values 'awsvpc' (required for Fargate), 'bridge', 'host' or 'none'
 type NetworkConfiguration struct {
-	AwsVpcConfiguration AwsVpcConfiguration `yaml:"awsvpc_configuration"`
+	AwsVpcConfiguration AwsVpcConfiguration `yaml:"awsvpc_configuration,omitempty"`
 }
 
 // AwsVpcConfiguration specifies the networking resources available to
 // tasks running in 'awsvpc' networking mode
 type AwsVpcConfiguration struct {
-	Subnets        []string       `yaml:"subnets"`
-	SecurityGroups []string       `yaml:"security_groups"`
-	AssignPublicIp AssignPublicIp `yaml:"assign_public_ip"` // Needed to run FARGATE tasks
+	Subnets        []string       `yaml:"subnets,omitempty"`
+	SecurityGroups []string       `yaml:"security_groups,omitempty"`
+	AssignPublicIp AssignPublicIp `yaml:"assign_public_ip,omitempty"` // Needed to run FARGATE tasks
 }
 
 // TODO: Remove; use enum in aws-sdk-go instead (AssignPublicIpEnabled, AssignPublicIpDisabled)
@@ -134,24 +193,24 @@ type AssignPublicIp string
 
 // ServiceDiscovery holds information related to ECS/Route53 Service Discovery
 type ServiceDiscovery struct {
-	ContainerName           string                  `yaml:"container_name"`
-	ContainerPort           *int64                  `yaml:"container_port"`
-	PrivateDNSNamespace     PrivateDNSNamespace     `yaml:"private_dns_namespace"`
-	PublicDNSNamespace      PublicDNSNamespace      `yaml:"public_dns_namespace"`
-	ServiceDiscoveryService ServiceDiscoveryService `yaml:"service_discovery_service"`
+	ContainerName           string                  `yaml:"container_name,omitempty"`
+	ContainerPort           *int64                  `yaml:"container_port,omitempty"`
+	PrivateDNSNamespace     PrivateDNSNamespace     `yaml:"private_dns_namespace,omitempty"`
+	PublicDNSNamespace      PublicDNSNamespace      `yaml:"public_dns_namespace,omitempty"`
+	ServiceDiscoveryService ServiceDiscoveryService `yaml:"service_discovery_service,omitempty"`
 }
 
 // Namespace holds the basic information for any type of namespace
 type Namespace struct {
-	ID   string `yaml:"id"`
-	Name string `yaml:"name"`
+	ID   string `yaml:"id,omitempty"`
+	Name string `yaml:"name,omitempty"`
 }
 
 // PrivateDNSNamespace holds information related to Route53 private DNS namespaces
 type PrivateDNSNamespace struct {
 	Namespace   `yaml:",inline"`
-	VPC         string `yaml:"vpc"`
-	Description string `yaml:"description"`
+	VPC         string `yaml:"vpc,omitempty"`
+	Description string `yaml:"description,omitempty"`
 }
 
 // PublicDNSNamespace holds information related to Route53 public DNS namespaces
@@ -161,21 +220,21 @@ type PublicDNSNamespace struct {
 
 // ServiceDiscoveryService holds information related to Route53 Service Discovery Services
 type ServiceDiscoveryService struct {
-	Name                    string                  `yaml:"name"`
-	Description             string                  `yaml:"description"`
-	DNSConfig               DNSConfig               `yaml:"dns_config"`
-	HealthCheckCustomConfig HealthCheckCustomConfig `yaml:"healthcheck_custom_config"`
+	Name                    string                  `yaml:"name,omitempty"`
+	Description             string                  `yaml:"description,omitempty"`
+	DNSConfig               DNSConfig               `yaml:"dns_config,omitempty"`
+	HealthCheckCustomConfig HealthCheckCustomConfig `yaml:"healthcheck_custom_config,omitempty"`
 }
 
 // DNSConfig holds the dns configuration for Service Discovery Services
 type DNSConfig struct {
-	Type string `yaml:"type"`
-	TTL  *int64 `yaml:"ttl"`
+	Type string `yaml:"type,omitempty"`
+	TTL  *int64 `yaml:"ttl,omitempty"`
 }
 
 // HealthCheckCustomConfig
 type HealthCheckCustomConfig struct {
-	FailureThreshold *int64 `yaml:"failure_threshold"`
+	FailureThreshold *int64 `yaml:"failure_threshold,omitempty"`
 }
 
 const (
@@ -184,18 +243,18 @@ const (
 )
 
 type TaskPlacement struct {
-	Strategies  []Strategy   `yaml:"strategy"`
-	Constraints []Constraint `yaml:"constraints"`
+	Strategies  []Strategy   `yaml:"strategy,omitempty"`
+	Constraints []Constraint `yaml:"constraints,omitempty"`
 }
 
 type Strategy struct {
-	Field string `yaml:"field"`
-	Type  string `yaml:"type"`
+	Field string `yaml:"field,omitempty"`
+	Type  string `yaml:"type,omitempty"`
 }
 
 type Constraint struct {
-	Expression string `yaml:"expression"`
-	Type       string `yaml:"type"`
+	Expression string `yaml:"expression,omitempty"`
+	Type       string `yaml:"type,omitempty"`
 }
 
 /////////////////////////////
@@ -214,31 +273,189 @@ func (cd *ContainerDef) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return nil
 }
 
+// UnmarshalYAML accepts sysctls as either a map or a list, allowing numeric values in the map form
+func (s *Sysctls) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var list []string
+	if err := unmarshal(&list); err == nil {
+		sysctls := make(Sysctls)
+		for _, entry := range list {
+			parts := strings.SplitN(entry, "=", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				return fmt.Errorf("sysctls entry '%s' must be of the form name=value", entry)
+			}
+			sysctls[parts[0]] = parts[1]
+		}
+		*s = sysctls
+		return nil
+	}
+
+	var mapping map[string]interface{}
+	if err := unmarshal(&mapping); err != nil {
+		return errors.New("sysctls must be a mapping or a list of name=value strings")
+	}
+	sysctls := make(Sysctls)
+	for name, value := range mapping {
+		if value == nil {
+			return fmt.Errorf("sysctls.%s must have a value", name)
+		}
+		sysctls[name] = fmt.Sprint(value)
+	}
+	*s = sysctls
+	return nil
+}
+
 // ReadECSParams parses the ecs-params.yml file and puts it into an ECSParams struct.
+// The file is validated against the ECSParams schema first; unknown keys, values
+// of the wrong type and invalid enum values are returned as an
+// *ECSParamsValidationError.
 func ReadECSParams(filename string) (*ECSParams, error) {
-	if filename == "" {
+	return ReadECSParamsFiles([]string{filename}, false)
+}
+
+// ReadECSParamsFiles parses one or more ecs-params files and merges them in
+// order into an ECSParams struct. Mappings, such as the services of the task
+// definition or the run params, are merged key by key, so a later file only
+// needs to contain the values it overrides; any other value, including a list,
+// replaces the value from the earlier files. With lenient set, the problems
+// found by schema validation are logged as warnings instead of returned.
+func ReadECSParamsFiles(filenames []string, lenient bool) (*ECSParams, error) {
+	if len(filenames) == 0 || (len(filenames) == 1 && filenames[0] == "") {
 		defaultFilename := "ecs-params.yml"
-		if _, err := os.Stat(defaultFilename); err == nil {
-			filename = defaultFilename
-		} else {
+		if _, err := os.Stat(defaultFilename); err != nil {
 			return nil, nil
 		}
+		filenames = []string{defaultFilename}
+	}
+
+	var ecsParamsData []byte
+	if len(filenames) == 1 {
+		data, err := readECSParamsFile(filenames[0], lenient)
+		if err != nil {
+			return nil, err
+		}
+		ecsParamsData = data
+	} else {
+		merged := yaml.MapSlice{}
+		for _, filename := range filenames {
+			data, err := readECSParamsFile(filename, lenient)
+			if err != nil {
+				return nil, err
+			}
+			document := yaml.MapSlice{}
+			if err = yaml.Unmarshal(data, &document); err != nil {
+				return nil, errors.Wrapf(err, "Error unmarshalling yaml data from ECS params file: %v", filename)
+			}
+			merged = mergeYAMLMappings(merged, document)
+		}
+		data, err := yaml.Marshal(merged)
+		if err != nil {
+			return nil, errors.Wrap(err, "Error merging ECS params files")
+		}
+		ecsParamsData = data
+	}
+
+	ecsParams := &ECSParams{}
+	if err := yaml.Unmarshal(ecsParamsData, &ecsParams); err != nil {
+		return nil, errors.Wrapf(err, "Error unmarshalling yaml data from ECS params file: %v", strings.Join(filenames, ", "))
 	}
 
+	return ecsParams, nil
+}
+
+// readECSParamsFile returns the contents of an ecs-params file after
+// environment variables are interpolated and the file is validated
+func readECSParamsFile(filename string, lenient bool) ([]byte, error) {
 	// NOTE: Readfile reads all data into memory and closes file. Could
 	// eventually refactor this to read different sections separately.
 	ecsParamsData, err := ioutil.ReadFile(filename)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Error reading file '%v'", filename)
 	}
-	ecsParamsData = []byte(os.ExpandEnv(string(ecsParamsData)))
-	ecsParams := &ECSParams{}
+	mapping, err := cliUtils.GetDefaultEnvironmentMapping()
+	if err != nil {
+		return nil, err
+	}
+	if ecsParamsData, err = cliUtils.InterpolateFileVariables(filename, ecsParamsData, mapping); err != nil {
+		return nil, errors.Wrap(err, "Error interpolating environment variables in ECS params file")
+	}
 
-	if err = yaml.Unmarshal([]byte(ecsParamsData), &ecsParams); err != nil {
-		return nil, errors.Wrapf(err, "Error unmarshalling yaml data from ECS params file: %v", filename)
+	if err = validateECSParams(filename, ecsParamsData); err != nil {
+		validationErr, ok := err.(*ECSParamsValidationError)
+		if !ok {
+			return nil, errors.Wrapf(err, "Error unmarshalling yaml data from ECS params file: %v", filename)
+		}
+		if !lenient {
+			return nil, err
+		}
+		for _, problem := range validationErr.Problems {
+			log.Warn(problem)
+		}
 	}
+	return ecsParamsData, nil
+}
 
-	return ecsParams, nil
+// mergeYAMLMappings returns base with the keys of overlay merged into it.
+// Nested mappings are merged recursively, null values in overlay are ignored
+// and any other value in overlay replaces the value in base.
+func mergeYAMLMappings(base, overlay yaml.MapSlice) yaml.MapSlice {
+	merged := make(yaml.MapSlice, len(base))
+	copy(merged, base)
+	for _, item := range overlay {
+		found := false
+		for i, baseItem := range merged {
+			if fmt.Sprint(baseItem.Key) != fmt.Sprint(item.Key) {
+				continue
+			}
+			found = true
+			baseMapping, baseIsMapping := baseItem.Value.(yaml.MapSlice)
+			overlayMapping, overlayIsMapping := item.Value.(yaml.MapSlice)
+			switch {
+			case item.Value == nil:
+			case baseIsMapping && overlayIsMapping:
+				merged[i].Value = mergeYAMLMappings(baseMapping, overlayMapping)
+			default:
+				merged[i].Value = item.Value
+			}
+			break
+		}
+		if !found {
+			merged = append(merged, item)
+		}
+	}
+	return merged
+}
+
+// ForComposeService returns a copy of the ECSParams in which the values set
+// in service_overrides for the compose service replace the project wide
+// values. The receiver is not modified.
+func (p *ECSParams) ForComposeService(name string) *ECSParams {
+	if p == nil {
+		return nil
+	}
+	params := *p
+	override, ok := p.ServiceOverrides[name]
+	if !ok {
+		return &params
+	}
+	if override.TaskSize != nil {
+		params.TaskDefinition.TaskSize = *override.TaskSize
+	}
+	if override.NetworkConfiguration != nil {
+		params.RunParams.NetworkConfiguration = *override.NetworkConfiguration
+	}
+	if override.TaskPlacement != nil {
+		params.RunParams.TaskPlacement = *override.TaskPlacement
+	}
+	return &params
+}
+
+// DesiredCount returns the desired_count set in service_overrides for the
+// compose service, or nil if there is none
+func (p *ECSParams) DesiredCount(name string) *int64 {
+	if p == nil {
+		return nil
+	}
+	return p.ServiceOverrides[name].DesiredCount
 }
 
 /////////////////////
@@ -198,7 +198,8 @@ run_params:
       security_groups:
         - sg-bafff1ed
         - sg-c0ffeefe
-      assign_public_ip: ENABLED`
+      assign_public_ip: ENABLED
+  platform_version: 1.3.0`
 
 	content := []byte(ecsParamsString)
 
@@ -229,6 +230,7 @@ run_params:
 		assert.Equal(t, 2, len(awsvpcConfig.SecurityGroups), "Expected 2 securityGroups")
 		assert.Equal(t, []string{"sg-bafff1ed", "sg-c0ffeefe"}, awsvpcConfig.SecurityGroups, "Expected security groups to match")
 		assert.Equal(t, Enabled, awsvpcConfig.AssignPublicIp, "Expected AssignPublicIp to match")
+		assert.Equal(t, "1.3.0", ecsParams.RunParams.PlatformVersion, "Expected PlatformVersion to match")
 	}
 }
 
@@ -384,6 +386,44 @@ task_definition:
 	}
 }
 
+func TestReadECSParams_WithStopGracePeriodAndSysctls(t *testing.T) {
+	ecsParamsString := `version: 1
+task_definition:
+  services:
+    web:
+      stop_grace_period: 1m30s
+      sysctls:
+        net.core.somaxconn: 1024
+    worker:
+      sysctls:
+        - net.ipv4.tcp_keepalive_time=200`
+
+	content := []byte(ecsParamsString)
+
+	tmpfile, err := ioutil.TempFile("", "ecs-params")
+	assert.NoError(t, err, "Could not create ecs-params tempfile")
+
+	ecsParamsFileName := tmpfile.Name()
+	defer os.Remove(ecsParamsFileName)
+
+	_, err = tmpfile.Write(content)
+	assert.NoError(t, err, "Could not write data to ecs-params tempfile")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Could not close tempfile")
+
+	ecsParams, err := ReadECSParams(ecsParamsFileName)
+
+	if assert.NoError(t, err) {
+		containerDefs := ecsParams.TaskDefinition.ContainerDefinitions
+		web := containerDefs["web"]
+		assert.Equal(t, "1m30s", web.StopGracePeriod, "Expected StopGracePeriod to be set")
+		assert.Equal(t, "1024", web.Sysctls["net.core.somaxconn"], "Expected Sysctls to be set")
+		worker := containerDefs["worker"]
+		assert.Equal(t, "200", worker.Sysctls["net.ipv4.tcp_keepalive_time"], "Expected Sysctls to be set")
+	}
+}
+
 /** ConvertToECSNetworkConfiguration tests **/
 
 func TestConvertToECSNetworkConfiguration(t *testing.T) {
@@ -961,3 +1001,309 @@ func TestConvertToECSHealthCheck_TestFieldBlank(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	}
 }
+
+func TestReadECSParams_InvalidFields(t *testing.T) {
+	ecsParamsString := `version: 1
+task_definition:
+  ecs_network_mode: awsvpc
+  task_execution_rol: arn:aws:iam::123456789012:role/execution`
+
+	content := []byte(ecsParamsString)
+
+	tmpfile, err := ioutil.TempFile("", "ecs-params")
+	assert.NoError(t, err, "Could not create ecs-params tempfile")
+
+	ecsParamsFileName := tmpfile.Name()
+	defer os.Remove(ecsParamsFileName)
+
+	_, err = tmpfile.Write(content)
+	assert.NoError(t, err, "Could not write data to ecs-params tempfile")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Could not close tempfile")
+
+	_, err = ReadECSParams(ecsParamsFileName)
+	if assert.Error(t, err) {
+		validationErr, ok := err.(*ECSParamsValidationError)
+		assert.True(t, ok, "Expected a validation error")
+		assert.Equal(t, []string{ecsParamsFileName + `:4:3: unknown field "task_execution_rol" in task_definition, did you mean "task_execution_role"?`}, validationErr.Problems)
+	}
+
+	ecsParams, err := ReadECSParamsFiles([]string{ecsParamsFileName}, true)
+	if assert.NoError(t, err, "Expected lenient mode to ignore unknown fields") {
+		assert.Equal(t, "awsvpc", ecsParams.TaskDefinition.NetworkMode, "Expected network mode to match")
+		assert.Empty(t, ecsParams.TaskDefinition.ExecutionRole)
+	}
+}
+
+func TestReadECSParams_WithEnvironmentVariables(t *testing.T) {
+	os.Setenv("ECS_PARAMS_TEST_SUBNET", "subnet-feedface")
+	defer os.Unsetenv("ECS_PARAMS_TEST_SUBNET")
+
+	ecsParamsString := `version: 1
+task_definition:
+  ecs_network_mode: ${ECS_PARAMS_TEST_NETWORK_MODE:-awsvpc}
+run_params:
+  network_configuration:
+    awsvpc_configuration:
+      subnets:
+        - $ECS_PARAMS_TEST_SUBNET
+        - ${ECS_PARAMS_TEST_SUBNET}-b`
+
+	content := []byte(ecsParamsString)
+
+	tmpfile, err := ioutil.TempFile("", "ecs-params")
+	assert.NoError(t, err, "Could not create ecs-params tempfile")
+
+	ecsParamsFileName := tmpfile.Name()
+	defer os.Remove(ecsParamsFileName)
+
+	_, err = tmpfile.Write(content)
+	assert.NoError(t, err, "Could not write data to ecs-params tempfile")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Could not close tempfile")
+
+	ecsParams, err := ReadECSParams(ecsParamsFileName)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "awsvpc", ecsParams.TaskDefinition.NetworkMode, "Expected network mode to use the default value")
+		subnets := ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration.Subnets
+		assert.Equal(t, []string{"subnet-feedface", "subnet-feedface-b"}, subnets, "Expected subnets to be interpolated")
+	}
+}
+
+func TestReadECSParams_ErrorWithUnsetEnvironmentVariable(t *testing.T) {
+	ecsParamsString := `version: 1
+task_definition:
+  task_role_arn: ${ECS_PARAMS_TEST_UNSET_ROLE}`
+
+	content := []byte(ecsParamsString)
+
+	tmpfile, err := ioutil.TempFile("", "ecs-params")
+	assert.NoError(t, err, "Could not create ecs-params tempfile")
+
+	ecsParamsFileName := tmpfile.Name()
+	defer os.Remove(ecsParamsFileName)
+
+	_, err = tmpfile.Write(content)
+	assert.NoError(t, err, "Could not write data to ecs-params tempfile")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Could not close tempfile")
+
+	_, err = ReadECSParams(ecsParamsFileName)
+	if assert.Error(t, err, "Expected error when an env var is not set") {
+		assert.Contains(t, err.Error(), ecsParamsFileName+":3: variable ECS_PARAMS_TEST_UNSET_ROLE is not set")
+	}
+}
+
+func TestReadECSParamsFiles_MergesFilesInOrder(t *testing.T) {
+	baseString := `version: 1
+task_definition:
+  ecs_network_mode: awsvpc
+  task_execution_role: base-execution-role
+  services:
+    web:
+      cpu_shares: 100
+      mem_limit: 512m
+    worker:
+      mem_limit: 256m
+run_params:
+  network_configuration:
+    awsvpc_configuration:
+      subnets:
+        - subnet-base-1
+        - subnet-base-2
+      assign_public_ip: ENABLED
+  task_placement:
+    strategy:
+      - type: spread
+        field: attribute:ecs.availability-zone
+  service_discovery:
+    container_name: web
+    container_port: 80`
+
+	overlayString := `version: 1
+task_definition:
+  services:
+    web:
+      mem_limit: 1g
+      essential: false
+run_params:
+  network_configuration:
+    awsvpc_configuration:
+      subnets:
+        - subnet-prod
+  task_placement:
+    constraints:
+      - type: memberOf
+        expression: attribute:stage == prod
+  service_discovery:
+    private_dns_namespace:
+      name: prod.corp`
+
+	baseFile, err := ioutil.TempFile("", "ecs-params")
+	assert.NoError(t, err, "Could not create ecs-params tempfile")
+	defer os.Remove(baseFile.Name())
+	_, err = baseFile.Write([]byte(baseString))
+	assert.NoError(t, err, "Could not write data to ecs-params tempfile")
+	assert.NoError(t, baseFile.Close(), "Could not close tempfile")
+
+	overlayFile, err := ioutil.TempFile("", "ecs-params-prod")
+	assert.NoError(t, err, "Could not create ecs-params tempfile")
+	defer os.Remove(overlayFile.Name())
+	_, err = overlayFile.Write([]byte(overlayString))
+	assert.NoError(t, err, "Could not write data to ecs-params tempfile")
+	assert.NoError(t, overlayFile.Close(), "Could not close tempfile")
+
+	ecsParams, err := ReadECSParamsFiles([]string{baseFile.Name(), overlayFile.Name()}, false)
+
+	if assert.NoError(t, err) {
+		taskDef := ecsParams.TaskDefinition
+		assert.Equal(t, "awsvpc", taskDef.NetworkMode, "Expected network mode from the base file")
+		assert.Equal(t, "base-execution-role", taskDef.ExecutionRole, "Expected execution role from the base file")
+
+		web := taskDef.ContainerDefinitions["web"]
+		assert.Equal(t, int64(100), web.Cpu, "Expected cpu shares from the base file")
+		assert.Equal(t, yaml.MemStringorInt(1073741824), web.Memory, "Expected memory from the overlay")
+		assert.False(t, web.Essential, "Expected essential from the overlay")
+		worker := taskDef.ContainerDefinitions["worker"]
+		assert.Equal(t, yaml.MemStringorInt(268435456), worker.Memory, "Expected worker from the base file")
+		assert.True(t, worker.Essential, "Expected essential to default to true")
+
+		awsvpcConfig := ecsParams.RunParams.NetworkConfiguration.AwsVpcConfiguration
+		assert.Equal(t, []string{"subnet-prod"}, awsvpcConfig.Subnets, "Expected lists to be replaced by the overlay")
+		assert.Equal(t, Enabled, awsvpcConfig.AssignPublicIp, "Expected assign public IP from the base file")
+
+		taskPlacement := ecsParams.RunParams.TaskPlacement
+		assert.Equal(t, []Strategy{{Type: "spread", Field: "attribute:ecs.availability-zone"}}, taskPlacement.Strategies, "Expected strategy from the base file")
+		assert.Equal(t, []Constraint{{Type: "memberOf", Expression: "attribute:stage == prod"}}, taskPlacement.Constraints, "Expected constraints from the overlay")
+
+		serviceDiscovery := ecsParams.RunParams.ServiceDiscovery
+		assert.Equal(t, "web", serviceDiscovery.ContainerName, "Expected container name from the base file")
+		assert.Equal(t, aws.Int64(80), serviceDiscovery.ContainerPort, "Expected container port from the base file")
+		assert.Equal(t, "prod.corp", serviceDiscovery.PrivateDNSNamespace.Name, "Expected namespace from the overlay")
+	}
+}
+
+func TestReadECSParamsFiles_ValidatesEachFile(t *testing.T) {
+	baseFile, err := ioutil.TempFile("", "ecs-params")
+	assert.NoError(t, err, "Could not create ecs-params tempfile")
+	defer os.Remove(baseFile.Name())
+	_, err = baseFile.Write([]byte("version: 1\ntask_definition:\n  ecs_network_mode: host\n"))
+	assert.NoError(t, err, "Could not write data to ecs-params tempfile")
+	assert.NoError(t, baseFile.Close(), "Could not close tempfile")
+
+	overlayFile, err := ioutil.TempFile("", "ecs-params-prod")
+	assert.NoError(t, err, "Could not create ecs-params tempfile")
+	defer os.Remove(overlayFile.Name())
+	_, err = overlayFile.Write([]byte("task_definition:\n  ecs_network_mode: hots\n"))
+	assert.NoError(t, err, "Could not write data to ecs-params tempfile")
+	assert.NoError(t, overlayFile.Close(), "Could not close tempfile")
+
+	_, err = ReadECSParamsFiles([]string{baseFile.Name(), overlayFile.Name()}, false)
+	if assert.Error(t, err, "Expected error when an overlay is invalid") {
+		assert.Contains(t, err.Error(), overlayFile.Name()+`:2:21: task_definition.ecs_network_mode must be one of`)
+	}
+}
+
+func TestReadECSParams_WithServiceOverrides(t *testing.T) {
+	ecsParamsString := `version: 1
+task_definition:
+  ecs_network_mode: awsvpc
+  task_size:
+    cpu_limit: 256
+    mem_limit: 512
+run_params:
+  network_configuration:
+    awsvpc_configuration:
+      subnets:
+        - subnet-project
+service_overrides:
+  web:
+    desired_count: 3
+    task_size:
+      cpu_limit: 1024
+      mem_limit: 2GB
+    network_configuration:
+      awsvpc_configuration:
+        subnets:
+          - subnet-public
+        assign_public_ip: ENABLED
+  worker:
+    task_placement:
+      constraints:
+        - type: memberOf
+          expression: attribute:queue == jobs`
+
+	content := []byte(ecsParamsString)
+
+	tmpfile, err := ioutil.TempFile("", "ecs-params")
+	assert.NoError(t, err, "Could not create ecs-params tempfile")
+
+	ecsParamsFileName := tmpfile.Name()
+	defer os.Remove(ecsParamsFileName)
+
+	_, err = tmpfile.Write(content)
+	assert.NoError(t, err, "Could not write data to ecs-params tempfile")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Could not close tempfile")
+
+	ecsParams, err := ReadECSParams(ecsParamsFileName)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, int64(3), aws.Int64Value(ecsParams.DesiredCount("web")))
+	assert.Nil(t, ecsParams.DesiredCount("worker"), "Expected no desired count for worker")
+
+	web := ecsParams.ForComposeService("web")
+	assert.Equal(t, TaskSize{Cpu: "1024", Memory: "2GB"}, web.TaskDefinition.TaskSize)
+	assert.Equal(t, []string{"subnet-public"}, web.RunParams.NetworkConfiguration.AwsVpcConfiguration.Subnets)
+	assert.Equal(t, Enabled, web.RunParams.NetworkConfiguration.AwsVpcConfiguration.AssignPublicIp)
+
+	worker := ecsParams.ForComposeService("worker")
+	assert.Equal(t, TaskSize{Cpu: "256", Memory: "512"}, worker.TaskDefinition.TaskSize, "Expected project task size for worker")
+	assert.Equal(t, []string{"subnet-project"}, worker.RunParams.NetworkConfiguration.AwsVpcConfiguration.Subnets)
+	assert.Len(t, worker.RunParams.TaskPlacement.Constraints, 1)
+
+	assert.Equal(t, TaskSize{Cpu: "256", Memory: "512"}, ecsParams.TaskDefinition.TaskSize, "Expected the original ecs params to be unchanged")
+	assert.Equal(t, "awsvpc", ecsParams.ForComposeService("db").TaskDefinition.NetworkMode)
+
+	var nilParams *ECSParams
+	assert.Nil(t, nilParams.ForComposeService("web"))
+	assert.Nil(t, nilParams.DesiredCount("web"))
+}
+
+func TestReadECSParams_WithDefaultAWSLogs(t *testing.T) {
+	ecsParamsString := `version: 1
+task_definition:
+  default_awslogs:
+    log_group: "/ecs/{project}/{container}"
+    retention_in_days: 30`
+
+	content := []byte(ecsParamsString)
+
+	tmpfile, err := ioutil.TempFile("", "ecs-params")
+	assert.NoError(t, err, "Could not create ecs-params tempfile")
+
+	ecsParamsFileName := tmpfile.Name()
+	defer os.Remove(ecsParamsFileName)
+
+	_, err = tmpfile.Write(content)
+	assert.NoError(t, err, "Could not write data to ecs-params tempfile")
+
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Could not close tempfile")
+
+	ecsParams, err := ReadECSParams(ecsParamsFileName)
+	if assert.NoError(t, err) {
+		expected := &DefaultAWSLogs{
+			LogGroup:        "/ecs/{project}/{container}",
+			RetentionInDays: 30,
+		}
+		assert.Equal(t, expected, ecsParams.TaskDefinition.DefaultAWSLogs)
+	}
+}
@@ -0,0 +1,379 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	cliUtils "github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	libYaml "github.com/docker/libcompose/yaml"
+	"gopkg.in/yaml.v2"
+)
+
+// ecsParamsEnums lists the accepted values of the ecs-params fields that map
+// to an enum in the ECS or Service Discovery APIs, keyed by the schema type
+// and yaml key of the field
+var ecsParamsEnums = map[reflect.Type]map[string][]string{
+	reflect.TypeOf(EcsTaskDef{}): {
+		"ecs_network_mode": {ecs.NetworkModeBridge, ecs.NetworkModeHost, ecs.NetworkModeAwsvpc, ecs.NetworkModeNone},
+	},
+	reflect.TypeOf(AwsVpcConfiguration{}): {
+		"assign_public_ip": {string(Enabled), string(Disabled)},
+	},
+	reflect.TypeOf(Strategy{}): {
+		"type": {ecs.PlacementStrategyTypeRandom, ecs.PlacementStrategyTypeSpread, ecs.PlacementStrategyTypeBinpack},
+	},
+	reflect.TypeOf(Constraint{}): {
+		"type": {ecs.PlacementConstraintTypeDistinctInstance, ecs.PlacementConstraintTypeMemberOf},
+	},
+	reflect.TypeOf(TaskDefinitionPlacementConstraint{}): {
+		"type": {ecs.TaskDefinitionPlacementConstraintTypeMemberOf},
+	},
+	reflect.TypeOf(ProxyConfiguration{}): {
+		"type": {ecs.ProxyConfigurationTypeAppmesh},
+	},
+	reflect.TypeOf(DefaultAWSLogs{}): {
+		"retention_in_days": LogRetentionInDays,
+	},
+	reflect.TypeOf(DNSConfig{}): {
+		"type": {servicediscovery.RecordTypeA, servicediscovery.RecordTypeSrv},
+	},
+}
+
+// ecsParamsScalarStructs lists the schema structs that can also be written as
+// one of the given scalar values
+var ecsParamsScalarStructs = map[reflect.Type][]string{
+	reflect.TypeOf(TaskSize{}): {TaskSizeAuto},
+}
+
+// ecsParamsCustomTypes validates the schema types that have their own
+// UnmarshalYAML and accept more than one YAML shape. Each returns a
+// description of the expected value, or "" if the value is valid.
+var ecsParamsCustomTypes = map[reflect.Type]func(value interface{}) string{
+	reflect.TypeOf(Sysctls{}): func(value interface{}) string {
+		const expected = "must be a mapping or a list of name=value strings"
+		switch sysctls := value.(type) {
+		case yaml.MapSlice:
+			for _, item := range sysctls {
+				if item.Value == nil || !isYAMLScalar(item.Value) {
+					return expected
+				}
+			}
+		case []interface{}:
+			for _, entry := range sysctls {
+				if s, ok := entry.(string); !ok || !strings.Contains(s, "=") || strings.HasPrefix(s, "=") {
+					return expected
+				}
+			}
+		default:
+			return expected
+		}
+		return ""
+	},
+	reflect.TypeOf(libYaml.MemStringorInt(0)): func(value interface{}) string {
+		if _, ok := value.(string); ok || isYAMLInteger(value) {
+			return ""
+		}
+		return "must be an integer or a string such as 512m"
+	},
+	reflect.TypeOf(libYaml.Stringorslice{}): func(value interface{}) string {
+		if list, ok := value.([]interface{}); ok {
+			for _, entry := range list {
+				if !isYAMLScalar(entry) {
+					return "must be a string or a list of strings"
+				}
+			}
+			return ""
+		}
+		if !isYAMLScalar(value) {
+			return "must be a string or a list of strings"
+		}
+		return ""
+	},
+}
+
+// ECSParamsValidationError is returned when an ecs-params file does not
+// match the ECSParams schema
+type ECSParamsValidationError struct {
+	Filename string
+	// Problems are formatted as file:line:column: message
+	Problems []string
+}
+
+func (e *ECSParamsValidationError) Error() string {
+	return fmt.Sprintf("Error validating ECS params file %s:\n%s", e.Filename, strings.Join(e.Problems, "\n"))
+}
+
+type ecsParamsValidator struct {
+	filename  string
+	positions yamlPositions
+	problems  []string
+}
+
+// validateECSParams checks that data only contains the keys of the ECSParams
+// schema, that every value has the type of its field, and that enum fields
+// hold one of the values accepted by ECS. It returns an
+// *ECSParamsValidationError listing every problem found.
+func validateECSParams(filename string, data []byte) error {
+	document := yaml.MapSlice{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return err
+	}
+
+	validator := &ecsParamsValidator{
+		filename:  filename,
+		positions: locateYAMLNodes(data),
+	}
+	validator.validate(yamlPath{}, document, reflect.TypeOf(ECSParams{}))
+	if len(validator.problems) > 0 {
+		return &ECSParamsValidationError{
+			Filename: filename,
+			Problems: validator.problems,
+		}
+	}
+	return nil
+}
+
+func (v *ecsParamsValidator) validate(path yamlPath, value interface{}, t reflect.Type) {
+	if value == nil {
+		return
+	}
+	if check, ok := ecsParamsCustomTypes[t]; ok {
+		if expected := check(value); expected != "" {
+			v.addValueProblem(path, "%s %s", path, expected)
+		}
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		v.validate(path, value, t.Elem())
+	case reflect.Struct:
+		if scalars, ok := ecsParamsScalarStructs[t]; ok && isYAMLScalar(value) {
+			if !cliUtils.InSlice(fmt.Sprint(value), scalars) {
+				v.addValueProblem(path, "%s must be a mapping or one of %s, found %s", path, strings.Join(scalars, ", "), describeYAMLValue(value))
+			}
+			return
+		}
+		mapping, ok := value.(yaml.MapSlice)
+		if !ok {
+			v.addValueProblem(path, "%s must be a mapping", path)
+			return
+		}
+		fields := yamlFields(t)
+		for _, item := range mapping {
+			key := fmt.Sprint(item.Key)
+			field, ok := fields[key]
+			if !ok {
+				v.addUnknownKeyProblem(path, key, fields)
+				continue
+			}
+			v.validate(path.key(key), item.Value, field.Type)
+			v.validateEnum(path.key(key), item.Value, ecsParamsEnums[t][key])
+		}
+	case reflect.Map:
+		mapping, ok := value.(yaml.MapSlice)
+		if !ok {
+			v.addValueProblem(path, "%s must be a mapping", path)
+			return
+		}
+		for _, item := range mapping {
+			key := fmt.Sprint(item.Key)
+			v.validate(path.key(key), item.Value, t.Elem())
+		}
+	case reflect.Slice:
+		list, ok := value.([]interface{})
+		if !ok {
+			v.addValueProblem(path, "%s must be a list", path)
+			return
+		}
+		for i, entry := range list {
+			v.validate(path.index(i), entry, t.Elem())
+		}
+	case reflect.String:
+		if !isYAMLScalar(value) {
+			v.addValueProblem(path, "%s must be a string", path)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !isYAMLInteger(value) {
+			v.addValueProblem(path, "%s must be an integer, found %s", path, describeYAMLValue(value))
+		}
+	case reflect.Bool:
+		if _, ok := value.(bool); !ok {
+			v.addValueProblem(path, "%s must be true or false, found %s", path, describeYAMLValue(value))
+		}
+	}
+}
+
+func (v *ecsParamsValidator) validateEnum(path yamlPath, value interface{}, allowed []string) {
+	if len(allowed) == 0 || value == nil || !isYAMLScalar(value) {
+		return
+	}
+	s := fmt.Sprint(value)
+	if s == "" {
+		return
+	}
+	for _, a := range allowed {
+		if s == a {
+			return
+		}
+	}
+	v.addValueProblem(path, "%s must be one of %s, found %q", path, strings.Join(allowed, ", "), s)
+}
+
+func (v *ecsParamsValidator) addUnknownKeyProblem(path yamlPath, key string, fields map[string]reflect.StructField) {
+	var message string
+	if len(path) == 0 {
+		message = fmt.Sprintf("unknown field %q", key)
+	} else {
+		message = fmt.Sprintf("unknown field %q in %s", key, path)
+	}
+	candidates := make([]string, 0, len(fields))
+	for name := range fields {
+		candidates = append(candidates, name)
+	}
+	if suggestion := closestMatch(key, candidates); suggestion != "" {
+		message += fmt.Sprintf(", did you mean %q?", suggestion)
+	}
+	v.addProblem(path.key(key), false, message)
+}
+
+func (v *ecsParamsValidator) addValueProblem(path yamlPath, format string, args ...interface{}) {
+	v.addProblem(path, true, fmt.Sprintf(format, args...))
+}
+
+func (v *ecsParamsValidator) addProblem(path yamlPath, atValue bool, message string) {
+	position, exact, ok := v.positions.find(path)
+	if !ok {
+		v.problems = append(v.problems, fmt.Sprintf("%s: %s", v.filename, message))
+		return
+	}
+	column := position.column
+	if atValue && exact && position.valueColumn > 0 {
+		column = position.valueColumn
+	}
+	v.problems = append(v.problems, fmt.Sprintf("%s:%d:%d: %s", v.filename, position.line, column, message))
+}
+
+// yamlFields returns the fields of a struct keyed by the name yaml.v2 uses
+// for them, including the fields of inlined structs
+func yamlFields(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+		tag := field.Tag.Get("yaml")
+		if tag == "-" {
+			continue
+		}
+		options := strings.Split(tag, ",")
+		name := options[0]
+		inline := false
+		for _, option := range options[1:] {
+			inline = inline || option == "inline"
+		}
+		if inline {
+			for inlineName, inlineField := range yamlFields(field.Type) {
+				fields[inlineName] = inlineField
+			}
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		fields[name] = field
+	}
+	return fields
+}
+
+func isYAMLScalar(value interface{}) bool {
+	switch value.(type) {
+	case yaml.MapSlice, []interface{}:
+		return false
+	}
+	return true
+}
+
+func isYAMLInteger(value interface{}) bool {
+	switch n := value.(type) {
+	case int, int64, uint64:
+		return true
+	case float64:
+		return n == math.Trunc(n)
+	}
+	return false
+}
+
+func describeYAMLValue(value interface{}) string {
+	switch value.(type) {
+	case yaml.MapSlice:
+		return "a mapping"
+	case []interface{}:
+		return "a list"
+	case string:
+		return fmt.Sprintf("%q", value)
+	}
+	return fmt.Sprint(value)
+}
+
+// closestMatch returns the candidate within two edits of name, if there is one
+func closestMatch(name string, candidates []string) string {
+	const maxDistance = 2
+	match := ""
+	best := maxDistance + 1
+	for _, candidate := range candidates {
+		if distance := editDistance(name, candidate); distance < best || (distance == best && candidate < match) {
+			match, best = candidate, distance
+		}
+	}
+	return match
+}
+
+// editDistance is the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	min := values[0]
+	for _, value := range values[1:] {
+		if value < min {
+			min = value
+		}
+	}
+	return min
+}
@@ -0,0 +1,263 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validateECSParamsProblems(t *testing.T, ecsParamsString string) []string {
+	err := validateECSParams("ecs-params.yml", []byte(ecsParamsString))
+	if err == nil {
+		return nil
+	}
+	validationErr, ok := err.(*ECSParamsValidationError)
+	if assert.True(t, ok, "Expected a validation error, got %v", err) {
+		return validationErr.Problems
+	}
+	return nil
+}
+
+func TestValidateECSParams_Valid(t *testing.T) {
+	ecsParamsString := `version: 1
+task_definition:
+  ecs_network_mode: awsvpc
+  task_execution_role: arn:aws:iam::123456789012:role/execution
+  task_size:
+    cpu_limit: 256
+    mem_limit: 0.5GB
+  services:
+    web:
+      essential: false
+      cpu_shares: 100
+      mem_limit: 512m
+      healthcheck:
+        test: ["CMD", "curl", "-f", "http://localhost"]
+        retries: 3
+      secrets:
+        - value_from: /db/password
+          name: DB_PASSWORD
+      sysctls:
+        - net.core.somaxconn=1024
+  docker_volumes:
+    - name: data
+      autoprovision: true
+      driver_opts:
+        type: nfs
+run_params:
+  network_configuration:
+    awsvpc_configuration:
+      subnets: [subnet-1, subnet-2]
+      assign_public_ip: ENABLED
+  task_placement:
+    strategy:
+    - type: spread
+      field: attribute:ecs.availability-zone
+    constraints:
+      - type: memberOf
+        expression: attribute:ecs.instance-type =~ t2.*
+  service_discovery:
+    private_dns_namespace:
+      name: corp
+      vpc: vpc-1
+    service_discovery_service:
+      dns_config:
+        type: SRV
+        ttl: 60`
+
+	assert.Empty(t, validateECSParamsProblems(t, ecsParamsString))
+}
+
+func TestValidateECSParams_UnknownKeys(t *testing.T) {
+	ecsParamsString := `version: 1
+task_definition:
+  task_execution_rol: arn:aws:iam::123456789012:role/execution
+  services:
+    web:
+      mem_limt: 512m
+run_params:
+  task_placement:
+    strategy:
+      - type: spread
+        feild: instanceId
+deploy: true`
+
+	expected := []string{
+		`ecs-params.yml:3:3: unknown field "task_execution_rol" in task_definition, did you mean "task_execution_role"?`,
+		`ecs-params.yml:6:7: unknown field "mem_limt" in task_definition.services.web, did you mean "mem_limit"?`,
+		`ecs-params.yml:11:9: unknown field "feild" in run_params.task_placement.strategy[0], did you mean "field"?`,
+		`ecs-params.yml:12:1: unknown field "deploy"`,
+	}
+	assert.Equal(t, expected, validateECSParamsProblems(t, ecsParamsString))
+}
+
+func TestValidateECSParams_TypeMismatches(t *testing.T) {
+	ecsParamsString := `version: 1
+task_definition:
+  services:
+    web:
+      essential: maybe
+      cpu_shares: lots
+      mem_limit: [512]
+      sysctls: net.core.somaxconn=1024
+  docker_volumes:
+    name: data
+run_params:
+  network_configuration:
+    awsvpc_configuration:
+      subnets: subnet-1`
+
+	expected := []string{
+		`ecs-params.yml:5:18: task_definition.services.web.essential must be true or false, found "maybe"`,
+		`ecs-params.yml:6:19: task_definition.services.web.cpu_shares must be an integer, found "lots"`,
+		`ecs-params.yml:7:18: task_definition.services.web.mem_limit must be an integer or a string such as 512m`,
+		`ecs-params.yml:8:16: task_definition.services.web.sysctls must be a mapping or a list of name=value strings`,
+		`ecs-params.yml:9:3: task_definition.docker_volumes must be a list`,
+		`ecs-params.yml:14:16: run_params.network_configuration.awsvpc_configuration.subnets must be a list`,
+	}
+	assert.Equal(t, expected, validateECSParamsProblems(t, ecsParamsString))
+}
+
+func TestValidateECSParams_InvalidEnumValues(t *testing.T) {
+	ecsParamsString := `version: 1
+task_definition:
+  ecs_network_mode: awsvcp
+run_params:
+  network_configuration:
+    awsvpc_configuration:
+      subnets:
+        - subnet-1
+      assign_public_ip: enabled
+  task_placement:
+    strategy:
+      - type: binpack
+      - type: pack
+    constraints: [{type: memberof}]
+  service_discovery:
+    service_discovery_service:
+      dns_config:
+        type: CNAME`
+
+	expected := []string{
+		`ecs-params.yml:3:21: task_definition.ecs_network_mode must be one of bridge, host, awsvpc, none, found "awsvcp"`,
+		`ecs-params.yml:9:25: run_params.network_configuration.awsvpc_configuration.assign_public_ip must be one of ENABLED, DISABLED, found "enabled"`,
+		`ecs-params.yml:13:15: run_params.task_placement.strategy[1].type must be one of random, spread, binpack, found "pack"`,
+		`ecs-params.yml:14:5: run_params.task_placement.constraints[0].type must be one of distinctInstance, memberOf, found "memberof"`,
+		`ecs-params.yml:18:15: run_params.service_discovery.service_discovery_service.dns_config.type must be one of A, SRV, found "CNAME"`,
+	}
+	assert.Equal(t, expected, validateECSParamsProblems(t, ecsParamsString))
+}
+
+func TestValidateECSParams_InvalidYAML(t *testing.T) {
+	err := validateECSParams("ecs-params.yml", []byte("task_definition: [\n"))
+	assert.Error(t, err)
+	_, ok := err.(*ECSParamsValidationError)
+	assert.False(t, ok, "Expected a YAML syntax error")
+}
+
+func TestLocateYAMLNodes(t *testing.T) {
+	document := `# comment
+version: 1
+task_definition:
+  services:
+    "web":   # quoted key
+      healthcheck:
+        test: |
+          curl -f http://localhost
+          key: not a key
+      secrets:
+      - name: A
+        value_from: a
+      -   name: B
+  task_size: {cpu_limit: 256}
+`
+
+	positions := locateYAMLNodes([]byte(document))
+	expected := yamlPositions{
+		"version":                                            {line: 2, column: 1, valueColumn: 10},
+		"task_definition":                                    {line: 3, column: 1},
+		"task_definition.services":                           {line: 4, column: 3},
+		"task_definition.services.web":                       {line: 5, column: 5},
+		"task_definition.services.web.healthcheck":           {line: 6, column: 7},
+		"task_definition.services.web.healthcheck.test":      {line: 7, column: 9},
+		"task_definition.services.web.secrets":               {line: 10, column: 7},
+		"task_definition.services.web.secrets[0]":            {line: 11, column: 9, valueColumn: 9},
+		"task_definition.services.web.secrets[0].name":       {line: 11, column: 9, valueColumn: 15},
+		"task_definition.services.web.secrets[0].value_from": {line: 12, column: 9, valueColumn: 21},
+		"task_definition.services.web.secrets[1]":            {line: 13, column: 11, valueColumn: 11},
+		"task_definition.services.web.secrets[1].name":       {line: 13, column: 11, valueColumn: 17},
+		"task_definition.task_size":                          {line: 14, column: 3, valueColumn: 14},
+	}
+	assert.Equal(t, expected, positions)
+
+	position, exact, ok := positions.find(yamlPath{"task_definition", "task_size", "cpu_limit"})
+	assert.True(t, ok)
+	assert.False(t, exact, "Expected flow style keys to resolve to their parent")
+	assert.Equal(t, 14, position.line)
+}
+
+func TestValidateECSParams_TaskSize(t *testing.T) {
+	assert.Empty(t, validateECSParamsProblems(t, "task_definition:\n  task_size: auto\n"))
+
+	expected := []string{
+		`ecs-params.yml:2:14: task_definition.task_size must be a mapping or one of auto, found "large"`,
+	}
+	assert.Equal(t, expected, validateECSParamsProblems(t, "task_definition:\n  task_size: large\n"))
+}
+
+func TestValidateECSParams_ProxyConfigurationType(t *testing.T) {
+	expected := []string{
+		`ecs-params.yml:3:11: task_definition.proxy_configuration.type must be one of APPMESH, found "ISTIO"`,
+	}
+	assert.Equal(t, expected, validateECSParamsProblems(t, "task_definition:\n  proxy_configuration:\n    type: ISTIO\n"))
+}
+
+func TestValidateECSParams_TaskDefinitionPlacementConstraintType(t *testing.T) {
+	data := `task_definition:
+  placement_constraints:
+    - type: distinctInstance
+`
+	expected := []string{
+		`ecs-params.yml:3:13: task_definition.placement_constraints[0].type must be one of memberOf, found "distinctInstance"`,
+	}
+	assert.Equal(t, expected, validateECSParamsProblems(t, data))
+}
+
+func TestValidateECSParams_ServiceOverrides(t *testing.T) {
+	data := `service_overrides:
+  web:
+    desired_count: many
+    task_sise:
+      cpu_limit: 256
+`
+	problems := validateECSParamsProblems(t, data)
+	if assert.Len(t, problems, 2) {
+		assert.Contains(t, problems[0], "service_overrides.web.desired_count must be an integer")
+		assert.Contains(t, problems[1], `unknown field "task_sise" in service_overrides.web, did you mean "task_size"?`)
+	}
+}
+
+func TestValidateECSParams_DefaultAWSLogs(t *testing.T) {
+	data := `task_definition:
+  default_awslogs:
+    log_group: /ecs/{cluster}/{project}
+    retention_in_days: 10
+`
+	problems := validateECSParamsProblems(t, data)
+	if assert.Len(t, problems, 1) {
+		assert.Contains(t, problems[0], "task_definition.default_awslogs.retention_in_days must be one of 1, 3, 5, 7, 14")
+	}
+}
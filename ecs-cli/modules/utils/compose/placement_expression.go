@@ -0,0 +1,277 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+)
+
+// placementExpressionSubjects are the subjects of the cluster query language
+// that are not container instance attributes
+// https://docs.aws.amazon.com/AmazonECS/latest/developerguide/cluster-query-language.html
+var placementExpressionSubjects = []string{
+	"agentConnected",
+	"agentVersion",
+	"ec2InstanceId",
+	"registeredAt",
+	"runningTasksCount",
+	"task:group",
+}
+
+const placementExpressionAttributePrefix = "attribute:"
+
+// placementExpressionOperators maps each operator of the cluster query
+// language to whether it takes an argument
+var placementExpressionOperators = map[string]bool{
+	"==": true, "equals": true,
+	"!=": true, "not_equals": true,
+	">": true, "greater_than": true,
+	">=": true, "greater_than_equal": true,
+	"<": true, "less_than": true,
+	"<=": true, "less_than_equal": true,
+	"=~": true, "matches": true,
+	"!~": true, "not_matches": true,
+	"in": true, "not_in": true,
+	"exists": false, "!exists": false, "not_exists": false,
+}
+
+// ConvertToECSTaskDefinitionPlacementConstraints converts the placement
+// constraints of the task definition specified in the ecs-params into a
+// format that is compatible with ECSClient calls. The constraints are
+// registered with the task definition, so their expressions are validated
+// here rather than when the task is placed.
+func ConvertToECSTaskDefinitionPlacementConstraints(constraints []TaskDefinitionPlacementConstraint) ([]*ecs.TaskDefinitionPlacementConstraint, error) {
+	output := []*ecs.TaskDefinitionPlacementConstraint{}
+	for i, constraint := range constraints {
+		constraintType := constraint.Type
+		if constraintType == "" {
+			constraintType = ecs.TaskDefinitionPlacementConstraintTypeMemberOf
+		}
+		if constraintType != ecs.TaskDefinitionPlacementConstraintTypeMemberOf {
+			return nil, fmt.Errorf("task_definition.placement_constraints[%d].type must be %s, found %s", i, ecs.TaskDefinitionPlacementConstraintTypeMemberOf, constraintType)
+		}
+		if err := validatePlacementExpression(constraint.Expression); err != nil {
+			return nil, errors.Wrapf(err, "task_definition.placement_constraints[%d].expression", i)
+		}
+		output = append(output, &ecs.TaskDefinitionPlacementConstraint{
+			Type:       aws.String(constraintType),
+			Expression: aws.String(constraint.Expression),
+		})
+	}
+	return output, nil
+}
+
+// validatePlacementExpression checks that expression is well formed in the
+// cluster query language, e.g.
+//
+//	attribute:ecs.instance-type =~ t2.* and not(attribute:stack in [dev, test])
+func validatePlacementExpression(expression string) error {
+	if strings.TrimSpace(expression) == "" {
+		return errors.New("placement expression is required")
+	}
+	tokens, err := tokenizePlacementExpression(expression)
+	if err != nil {
+		return err
+	}
+	parser := &placementExpressionParser{expression: expression, tokens: tokens}
+	if err := parser.parseExpression(); err != nil {
+		return err
+	}
+	if token, ok := parser.peek(); ok {
+		return parser.errorf("unexpected %q", token)
+	}
+	return nil
+}
+
+// tokenizePlacementExpression splits an expression into words, quoted strings
+// and the punctuation characters ( ) [ ] and ,
+func tokenizePlacementExpression(expression string) ([]string, error) {
+	tokens := []string{}
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case strings.ContainsRune("()[],", r):
+			tokens = append(tokens, string(r))
+			i++
+		case r == '"' || r == '\'':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("unterminated quoted string in placement expression %q", expression)
+			}
+			tokens = append(tokens, string(runes[i:end+1]))
+			i = end + 1
+		default:
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && !strings.ContainsRune("()[],", runes[end]) {
+				end++
+			}
+			tokens = append(tokens, string(runes[i:end]))
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+// placementExpressionParser is a recursive descent parser for the grammar
+//
+//	expression := unary (("and" | "or" | "&&" | "||") unary)*
+//	unary      := ("not" | "!") unary | "(" expression ")" | subject operator [argument]
+//	argument   := value | "[" value ("," value)* "]" | "(" value ("," value)* ")"
+type placementExpressionParser struct {
+	expression string
+	tokens     []string
+	position   int
+}
+
+func (p *placementExpressionParser) peek() (string, bool) {
+	if p.position >= len(p.tokens) {
+		return "", false
+	}
+	return p.tokens[p.position], true
+}
+
+func (p *placementExpressionParser) next() (string, bool) {
+	token, ok := p.peek()
+	if ok {
+		p.position++
+	}
+	return token, ok
+}
+
+func (p *placementExpressionParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid placement expression %q: %s", p.expression, fmt.Sprintf(format, args...))
+}
+
+func (p *placementExpressionParser) parseExpression() error {
+	if err := p.parseUnary(); err != nil {
+		return err
+	}
+	for {
+		token, ok := p.peek()
+		if !ok || !isPlacementExpressionJoin(token) {
+			return nil
+		}
+		p.position++
+		if err := p.parseUnary(); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *placementExpressionParser) parseUnary() error {
+	token, ok := p.next()
+	if !ok {
+		return p.errorf("expected a condition at the end of the expression")
+	}
+	switch strings.ToLower(token) {
+	case "not", "!":
+		return p.parseUnary()
+	case "(":
+		if err := p.parseExpression(); err != nil {
+			return err
+		}
+		if closing, _ := p.next(); closing != ")" {
+			return p.errorf("missing closing parenthesis")
+		}
+		return nil
+	}
+	return p.parseCondition(token)
+}
+
+func (p *placementExpressionParser) parseCondition(subject string) error {
+	if !isPlacementExpressionSubject(subject) {
+		return p.errorf("unknown subject %q; expected %s<name> or one of %s", subject, placementExpressionAttributePrefix, strings.Join(placementExpressionSubjects, ", "))
+	}
+	operator, ok := p.next()
+	if !ok {
+		return p.errorf("expected an operator after %q", subject)
+	}
+	hasArgument, ok := placementExpressionOperators[strings.ToLower(operator)]
+	if !ok {
+		return p.errorf("unknown operator %q after %q", operator, subject)
+	}
+	if !hasArgument {
+		return nil
+	}
+
+	argument, ok := p.next()
+	if !ok {
+		return p.errorf("expected a value after %q", operator)
+	}
+	switch argument {
+	case "[", "(":
+		return p.parseList(argument)
+	case ")", "]", ",":
+		return p.errorf("expected a value after %q, found %q", operator, argument)
+	}
+	if isPlacementExpressionJoin(argument) {
+		return p.errorf("expected a value after %q, found %q", operator, argument)
+	}
+	return nil
+}
+
+func (p *placementExpressionParser) parseList(opening string) error {
+	closing := "]"
+	if opening == "(" {
+		closing = ")"
+	}
+	for {
+		value, ok := p.next()
+		if !ok || strings.ContainsAny(value, "()[],") {
+			return p.errorf("expected a value in the list")
+		}
+		separator, ok := p.next()
+		if !ok {
+			return p.errorf("missing closing %q", closing)
+		}
+		if separator == closing {
+			return nil
+		}
+		if separator != "," {
+			return p.errorf("expected , or %s in the list, found %q", closing, separator)
+		}
+	}
+}
+
+func isPlacementExpressionSubject(subject string) bool {
+	if strings.HasPrefix(subject, placementExpressionAttributePrefix) {
+		return len(subject) > len(placementExpressionAttributePrefix)
+	}
+	for _, name := range placementExpressionSubjects {
+		if subject == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isPlacementExpressionJoin(token string) bool {
+	switch strings.ToLower(token) {
+	case "and", "or", "&&", "||":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePlacementExpression(t *testing.T) {
+	expressions := []string{
+		"attribute:ecs.instance-type == t2.small",
+		"attribute:ecs.instance-type =~ t2.*",
+		"attribute:ecs.availability-zone in [us-east-1a, us-east-1b]",
+		"attribute:ecs.os-type exists",
+		"agentConnected == true and attribute:stack != 'prod'",
+		"not(attribute:ecs.instance-type =~ g2.*)",
+		"(attribute:team equals web || attribute:team equals api) && task:group == service:web",
+		"runningTasksCount < 10",
+		"ec2InstanceId not_in (i-1234, i-5678)",
+	}
+
+	for _, expression := range expressions {
+		assert.NoError(t, validatePlacementExpression(expression), "Unexpected error validating %s", expression)
+	}
+}
+
+func TestValidatePlacementExpression_Errors(t *testing.T) {
+	testCases := map[string]string{
+		"":                                      "placement expression is required",
+		"instance-type == t2.small":             `invalid placement expression "instance-type == t2.small": unknown subject "instance-type"; expected attribute:<name> or one of agentConnected, agentVersion, ec2InstanceId, registeredAt, runningTasksCount, task:group`,
+		"attribute:ecs.instance-type":           `invalid placement expression "attribute:ecs.instance-type": expected an operator after "attribute:ecs.instance-type"`,
+		"attribute:ecs.instance-type is t2":     `invalid placement expression "attribute:ecs.instance-type is t2": unknown operator "is" after "attribute:ecs.instance-type"`,
+		"attribute:ecs.instance-type ==":        `invalid placement expression "attribute:ecs.instance-type ==": expected a value after "=="`,
+		"attribute:zone in [a, b":               `invalid placement expression "attribute:zone in [a, b": missing closing "]"`,
+		"(attribute:zone == a":                  `invalid placement expression "(attribute:zone == a": missing closing parenthesis`,
+		"attribute:zone == a and":               `invalid placement expression "attribute:zone == a and": expected a condition at the end of the expression`,
+		"attribute:zone == a attribute:os == b": `invalid placement expression "attribute:zone == a attribute:os == b": unexpected "attribute:os"`,
+		"attribute:zone == 'a":                  `unterminated quoted string in placement expression "attribute:zone == 'a"`,
+	}
+
+	for expression, expected := range testCases {
+		err := validatePlacementExpression(expression)
+		if assert.Error(t, err, "Expected error validating %s", expression) {
+			assert.Equal(t, expected, err.Error(), "Unexpected error validating %s", expression)
+		}
+	}
+}
+
+func TestConvertToECSTaskDefinitionPlacementConstraints(t *testing.T) {
+	constraints := []TaskDefinitionPlacementConstraint{
+		{Expression: "attribute:ecs.instance-type =~ t2.*"},
+		{Type: ecs.TaskDefinitionPlacementConstraintTypeMemberOf, Expression: "attribute:stack == prod"},
+	}
+	expected := []*ecs.TaskDefinitionPlacementConstraint{
+		{Type: aws.String(ecs.TaskDefinitionPlacementConstraintTypeMemberOf), Expression: aws.String("attribute:ecs.instance-type =~ t2.*")},
+		{Type: aws.String(ecs.TaskDefinitionPlacementConstraintTypeMemberOf), Expression: aws.String("attribute:stack == prod")},
+	}
+
+	actual, err := ConvertToECSTaskDefinitionPlacementConstraints(constraints)
+	if assert.NoError(t, err) {
+		assert.Equal(t, expected, actual)
+	}
+}
+
+func TestConvertToECSTaskDefinitionPlacementConstraints_Errors(t *testing.T) {
+	_, err := ConvertToECSTaskDefinitionPlacementConstraints([]TaskDefinitionPlacementConstraint{
+		{Type: ecs.PlacementConstraintTypeDistinctInstance},
+	})
+	assert.EqualError(t, err, "task_definition.placement_constraints[0].type must be memberOf, found distinctInstance")
+
+	_, err = ConvertToECSTaskDefinitionPlacementConstraints([]TaskDefinitionPlacementConstraint{
+		{Expression: "attribute:stack == prod"},
+		{Expression: "stack == prod"},
+	})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "task_definition.placement_constraints[1].expression: invalid placement expression")
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+)
+
+// Names of the proxy configuration properties accepted by ECS
+const (
+	proxyPropertyIgnoredUID         = "IgnoredUID"
+	proxyPropertyIgnoredGID         = "IgnoredGID"
+	proxyPropertyAppPorts           = "AppPorts"
+	proxyPropertyProxyIngressPort   = "ProxyIngressPort"
+	proxyPropertyProxyEgressPort    = "ProxyEgressPort"
+	proxyPropertyEgressIgnoredPorts = "EgressIgnoredPorts"
+	proxyPropertyEgressIgnoredIPs   = "EgressIgnoredIPs"
+)
+
+// ConvertToECSProxyConfiguration converts the proxy configuration specified in
+// the ecs-params into a format that is compatible with ECSClient calls. The
+// properties are always listed in the same order so that the task definition
+// cache sees identical configurations as equal.
+func (p *ProxyConfiguration) ConvertToECSProxyConfiguration() (*ecs.ProxyConfiguration, error) {
+	if p.ContainerName == "" {
+		return nil, errors.New("proxy_configuration.container_name is required")
+	}
+	if len(p.AppPorts) == 0 {
+		return nil, errors.New("proxy_configuration.app_ports must list at least one port")
+	}
+	if p.ProxyIngressPort == nil || p.ProxyEgressPort == nil {
+		return nil, errors.New("proxy_configuration.proxy_ingress_port and proxy_configuration.proxy_egress_port are required")
+	}
+	if p.IgnoredUID == nil && p.IgnoredGID == nil {
+		return nil, errors.New("proxy_configuration requires ignored_uid or ignored_gid so that the proxy ignores its own traffic")
+	}
+
+	proxyType := p.Type
+	if proxyType == "" {
+		proxyType = ecs.ProxyConfigurationTypeAppmesh
+	}
+
+	properties := []*ecs.KeyValuePair{}
+	addProperty := func(name, value string) {
+		properties = append(properties, &ecs.KeyValuePair{
+			Name:  aws.String(name),
+			Value: aws.String(value),
+		})
+	}
+	if p.IgnoredUID != nil {
+		addProperty(proxyPropertyIgnoredUID, strconv.FormatInt(aws.Int64Value(p.IgnoredUID), 10))
+	}
+	if p.IgnoredGID != nil {
+		addProperty(proxyPropertyIgnoredGID, strconv.FormatInt(aws.Int64Value(p.IgnoredGID), 10))
+	}
+	addProperty(proxyPropertyAppPorts, joinPorts(p.AppPorts))
+	addProperty(proxyPropertyProxyIngressPort, strconv.FormatInt(aws.Int64Value(p.ProxyIngressPort), 10))
+	addProperty(proxyPropertyProxyEgressPort, strconv.FormatInt(aws.Int64Value(p.ProxyEgressPort), 10))
+	if len(p.EgressIgnoredPorts) > 0 {
+		addProperty(proxyPropertyEgressIgnoredPorts, joinPorts(p.EgressIgnoredPorts))
+	}
+	if len(p.EgressIgnoredIPs) > 0 {
+		addProperty(proxyPropertyEgressIgnoredIPs, strings.Join(p.EgressIgnoredIPs, ","))
+	}
+
+	return &ecs.ProxyConfiguration{
+		Type:          aws.String(proxyType),
+		ContainerName: aws.String(p.ContainerName),
+		Properties:    properties,
+	}, nil
+}
+
+// convertFromECSProxyConfiguration transforms the proxy configuration of a
+// task definition into its ecs-params equivalent. Properties that cannot be
+// parsed are skipped with a warning.
+func convertFromECSProxyConfiguration(proxyConfig *ecs.ProxyConfiguration) *ProxyConfiguration {
+	output := &ProxyConfiguration{
+		ContainerName: aws.StringValue(proxyConfig.ContainerName),
+	}
+	if proxyType := aws.StringValue(proxyConfig.Type); proxyType != ecs.ProxyConfigurationTypeAppmesh {
+		output.Type = proxyType
+	}
+
+	for _, property := range proxyConfig.Properties {
+		name := aws.StringValue(property.Name)
+		value := aws.StringValue(property.Value)
+		var err error
+		switch name {
+		case proxyPropertyIgnoredUID:
+			output.IgnoredUID, err = parseProxyInt(value)
+		case proxyPropertyIgnoredGID:
+			output.IgnoredGID, err = parseProxyInt(value)
+		case proxyPropertyAppPorts:
+			output.AppPorts, err = parsePorts(value)
+		case proxyPropertyProxyIngressPort:
+			output.ProxyIngressPort, err = parseProxyInt(value)
+		case proxyPropertyProxyEgressPort:
+			output.ProxyEgressPort, err = parseProxyInt(value)
+		case proxyPropertyEgressIgnoredPorts:
+			output.EgressIgnoredPorts, err = parsePorts(value)
+		case proxyPropertyEgressIgnoredIPs:
+			output.EgressIgnoredIPs = splitProxyList(value)
+		default:
+			err = errors.New("unknown property")
+		}
+		if err != nil {
+			logWarningForImportField("proxyConfiguration."+name, "")
+		}
+	}
+	return output
+}
+
+func joinPorts(ports []int64) string {
+	values := make([]string, len(ports))
+	for i, port := range ports {
+		values[i] = strconv.FormatInt(port, 10)
+	}
+	return strings.Join(values, ",")
+}
+
+func parsePorts(value string) ([]int64, error) {
+	ports := []int64{}
+	for _, entry := range splitProxyList(value) {
+		port, err := strconv.ParseInt(entry, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
+
+func parseProxyInt(value string) (*int64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return aws.Int64(n), nil
+}
+
+// splitProxyList splits a comma separated property value, ignoring blank entries
+func splitProxyList(value string) []string {
+	entries := []string{}
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,99 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func appMeshProxyConfiguration() *ProxyConfiguration {
+	return &ProxyConfiguration{
+		ContainerName:    "envoy",
+		AppPorts:         []int64{8080, 8081},
+		ProxyIngressPort: aws.Int64(15000),
+		ProxyEgressPort:  aws.Int64(15001),
+		EgressIgnoredIPs: []string{"169.254.170.2", "169.254.169.254"},
+		IgnoredUID:       aws.Int64(1337),
+	}
+}
+
+func TestConvertToECSProxyConfiguration(t *testing.T) {
+	expected := &ecs.ProxyConfiguration{
+		Type:          aws.String(ecs.ProxyConfigurationTypeAppmesh),
+		ContainerName: aws.String("envoy"),
+		Properties: []*ecs.KeyValuePair{
+			{Name: aws.String("IgnoredUID"), Value: aws.String("1337")},
+			{Name: aws.String("AppPorts"), Value: aws.String("8080,8081")},
+			{Name: aws.String("ProxyIngressPort"), Value: aws.String("15000")},
+			{Name: aws.String("ProxyEgressPort"), Value: aws.String("15001")},
+			{Name: aws.String("EgressIgnoredIPs"), Value: aws.String("169.254.170.2,169.254.169.254")},
+		},
+	}
+
+	actual, err := appMeshProxyConfiguration().ConvertToECSProxyConfiguration()
+	if assert.NoError(t, err) {
+		assert.Equal(t, expected, actual)
+	}
+}
+
+func TestConvertToECSProxyConfiguration_ErrorWithMissingFields(t *testing.T) {
+	noContainer := appMeshProxyConfiguration()
+	noContainer.ContainerName = ""
+	noAppPorts := appMeshProxyConfiguration()
+	noAppPorts.AppPorts = nil
+	noIngressPort := appMeshProxyConfiguration()
+	noIngressPort.ProxyIngressPort = nil
+	noIgnoredUser := appMeshProxyConfiguration()
+	noIgnoredUser.IgnoredUID = nil
+
+	for _, proxyConfig := range []*ProxyConfiguration{noContainer, noAppPorts, noIngressPort, noIgnoredUser} {
+		_, err := proxyConfig.ConvertToECSProxyConfiguration()
+		assert.Error(t, err, "Expected error converting %+v", proxyConfig)
+	}
+}
+
+func TestReadECSParams_WithProxyConfiguration(t *testing.T) {
+	ecsParamsString := `version: 1
+task_definition:
+  proxy_configuration:
+    type: APPMESH
+    container_name: envoy
+    app_ports: [8080, 8081]
+    proxy_ingress_port: 15000
+    proxy_egress_port: 15001
+    egress_ignored_ips:
+      - 169.254.170.2
+      - 169.254.169.254
+    ignored_uid: 1337`
+
+	ecsParams := &ECSParams{}
+	assert.Empty(t, validateECSParamsProblems(t, ecsParamsString))
+	if assert.NoError(t, yaml.Unmarshal([]byte(ecsParamsString), ecsParams)) {
+		expected := appMeshProxyConfiguration()
+		expected.Type = ecs.ProxyConfigurationTypeAppmesh
+		assert.Equal(t, expected, ecsParams.TaskDefinition.ProxyConfiguration)
+	}
+}
+
+func TestConvertFromECSProxyConfiguration(t *testing.T) {
+	proxyConfig, err := appMeshProxyConfiguration().ConvertToECSProxyConfiguration()
+	assert.NoError(t, err)
+
+	assert.Equal(t, appMeshProxyConfiguration(), convertFromECSProxyConfiguration(proxyConfig), "Expected proxy configuration to round trip")
+}
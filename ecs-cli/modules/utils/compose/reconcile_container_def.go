@@ -15,9 +15,12 @@ package utils
 
 import (
 	"errors"
+	"sort"
+
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/adapter"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	log "github.com/sirupsen/logrus"
 )
 
 const (
@@ -56,6 +59,14 @@ func reconcileContainerDef(inputCfg *adapter.ContainerConfig, ecsConDef *Contain
 	outputContDef.SetReadonlyRootFilesystem(inputCfg.ReadOnly)
 	outputContDef.SetUlimits(inputCfg.Ulimits)
 
+	if len(inputCfg.DependsOn) > 0 {
+		dependsOn, err := convertToECSContainerDependencies(inputCfg.DependsOn)
+		if err != nil {
+			return nil, err
+		}
+		outputContDef.SetDependsOn(dependsOn)
+	}
+
 	if inputCfg.User != "" {
 		outputContDef.SetUser(inputCfg.User)
 	}
@@ -95,6 +106,8 @@ func reconcileContainerDef(inputCfg *adapter.ContainerConfig, ecsConDef *Contain
 	memLimit := inputCfg.Memory
 	memRes := inputCfg.MemoryReservation
 	healthCheck := inputCfg.HealthCheck
+	stopTimeout := inputCfg.StopTimeout
+	sysctls := inputCfg.Sysctls
 	var resourceRequirements []*ecs.ResourceRequirement
 
 	if ecsConDef != nil {
@@ -127,6 +140,14 @@ func reconcileContainerDef(inputCfg *adapter.ContainerConfig, ecsConDef *Contain
 			return nil, err
 		}
 
+		ecsStopTimeout, err := adapter.ConvertToStopTimeout(ecsConDef.StopGracePeriod)
+		if err != nil {
+			return nil, err
+		}
+		stopTimeout = resolveIntPointerResourceOverride(inputCfg.Name, stopTimeout, ecsStopTimeout, "StopTimeout")
+
+		sysctls = resolveSysctlsOverride(inputCfg.Name, sysctls, ecsConDef.Sysctls)
+
 		if ecsConDef.GPU != "" {
 			resourceType := ecs.ResourceTypeGpu
 			resourceRequirement := ecs.ResourceRequirement{
@@ -166,5 +187,53 @@ func reconcileContainerDef(inputCfg *adapter.ContainerConfig, ecsConDef *Contain
 		outputContDef.SetResourceRequirements(resourceRequirements)
 	}
 
+	if stopTimeout != nil {
+		outputContDef.SetStopTimeout(*stopTimeout)
+	}
+
+	if len(sysctls) > 0 {
+		outputContDef.SetSystemControls(convertToECSSystemControls(sysctls))
+	}
+
 	return outputContDef, nil
 }
+
+// resolveSysctlsOverride merges the sysctls from the ecs-params file into
+// those from the compose file, with the ecs-params values taking precedence
+func resolveSysctlsOverride(serviceName string, composeVal, ecsParamsVal map[string]string) map[string]string {
+	if len(ecsParamsVal) == 0 {
+		return composeVal
+	}
+	sysctls := make(map[string]string)
+	for name, value := range composeVal {
+		sysctls[name] = value
+	}
+	for name, value := range ecsParamsVal {
+		if composeValue, ok := sysctls[name]; ok && composeValue != value {
+			log.WithFields(log.Fields{
+				"option name":  "sysctls." + name,
+				"service name": serviceName,
+			}).Infof("Using ecs-params value as override (was %v but is now %v)", composeValue, value)
+		}
+		sysctls[name] = value
+	}
+	return sysctls
+}
+
+// convertToECSSystemControls transforms sysctls into ECS SystemControls, sorted by namespace
+func convertToECSSystemControls(sysctls map[string]string) []*ecs.SystemControl {
+	namespaces := make([]string, 0, len(sysctls))
+	for namespace := range sysctls {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	systemControls := []*ecs.SystemControl{}
+	for _, namespace := range namespaces {
+		systemControls = append(systemControls, &ecs.SystemControl{
+			Namespace: aws.String(namespace),
+			Value:     aws.String(sysctls[namespace]),
+		})
+	}
+	return systemControls
+}
@@ -0,0 +1,214 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// TaskSizeAuto can be given as the task_size in an ecs-params file to pick the
+// smallest Fargate task size that fits the containers of the task
+const TaskSizeAuto = "auto"
+
+const mibPerGiB = 1024
+
+// fargateTaskSize is a range of memory values, in MiB, that Fargate supports
+// for a number of CPU units
+type fargateTaskSize struct {
+	cpu       int64
+	minMemory int64
+	maxMemory int64
+	increment int64
+}
+
+// fargateTaskSizes lists the supported Fargate CPU and memory combinations, in
+// increasing order of CPU.
+// https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task-cpu-memory-error.html
+var fargateTaskSizes = []fargateTaskSize{
+	{cpu: 256, minMemory: 512, maxMemory: 512, increment: 512},
+	{cpu: 256, minMemory: 1024, maxMemory: 2048, increment: 1024},
+	{cpu: 512, minMemory: 1024, maxMemory: 4096, increment: 1024},
+	{cpu: 1024, minMemory: 2048, maxMemory: 8192, increment: 1024},
+	{cpu: 2048, minMemory: 4096, maxMemory: 16384, increment: 1024},
+	{cpu: 4096, minMemory: 8192, maxMemory: 30720, increment: 1024},
+	{cpu: 8192, minMemory: 16384, maxMemory: 61440, increment: 4096},
+	{cpu: 16384, minMemory: 32768, maxMemory: 122880, increment: 8192},
+}
+
+// UnmarshalYAML accepts the task size as either "auto" or a mapping with
+// cpu_limit and mem_limit
+func (t *TaskSize) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value string
+	if err := unmarshal(&value); err == nil {
+		if value != TaskSizeAuto {
+			return fmt.Errorf("task_size must be either %s or a mapping with cpu_limit and mem_limit, found '%s'", TaskSizeAuto, value)
+		}
+		*t = TaskSize{Auto: true}
+		return nil
+	}
+
+	type rawTaskSize TaskSize
+	raw := rawTaskSize{}
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+	*t = TaskSize(raw)
+	return nil
+}
+
+// MarshalYAML writes an automatic task size back as "auto"
+func (t TaskSize) MarshalYAML() (interface{}, error) {
+	if t.Auto {
+		return TaskSizeAuto, nil
+	}
+	type rawTaskSize TaskSize
+	return rawTaskSize(t), nil
+}
+
+// ValidateFargateTaskSize returns an error if the cpu and memory of a task
+// are not a combination supported by Fargate. Both can be written in any of
+// the formats accepted by RegisterTaskDefinition, such as "1024" or "1 vCPU"
+// for CPU and "2048" or "2GB" for memory.
+func ValidateFargateTaskSize(cpu, memory string) error {
+	if cpu == "" || memory == "" {
+		return fmt.Errorf("task_size must set both cpu_limit and mem_limit for Fargate, or be set to %s", TaskSizeAuto)
+	}
+	cpuUnits, err := parseTaskCPU(cpu)
+	if err != nil {
+		return err
+	}
+	memoryMiB, err := parseTaskMemory(memory)
+	if err != nil {
+		return err
+	}
+	for _, size := range fargateTaskSizes {
+		if size.supports(cpuUnits, memoryMiB) {
+			return nil
+		}
+	}
+	return fmt.Errorf("task_size cpu_limit %s and mem_limit %s are not a valid Fargate combination; %s", cpu, memory, describeFargateMemory(cpuUnits))
+}
+
+// resolveAutoTaskSize returns the smallest Fargate CPU and memory that fit the
+// sum of the CPU and memory of the containers. The memory of a container is
+// its hard limit, or its reservation if there is no hard limit.
+func resolveAutoTaskSize(containerDefs []*ecs.ContainerDefinition) (cpu string, memory string, err error) {
+	var cpuUnits, memoryMiB int64
+	for _, containerDef := range containerDefs {
+		cpuUnits += aws.Int64Value(containerDef.Cpu)
+		if containerDef.Memory != nil {
+			memoryMiB += aws.Int64Value(containerDef.Memory)
+		} else {
+			memoryMiB += aws.Int64Value(containerDef.MemoryReservation)
+		}
+	}
+
+	for _, size := range fargateTaskSizes {
+		if size.cpu < cpuUnits || size.maxMemory < memoryMiB {
+			continue
+		}
+		selected := size.minMemory
+		for selected < memoryMiB {
+			selected += size.increment
+		}
+		cpu = strconv.FormatInt(size.cpu, 10)
+		memory = strconv.FormatInt(selected, 10)
+		log.WithFields(log.Fields{
+			"containerCPU":    cpuUnits,
+			"containerMemory": memoryMiB,
+			"cpu":             cpu,
+			"memory":          memory,
+		}).Info("Using automatic Fargate task size")
+		return cpu, memory, nil
+	}
+	return "", "", fmt.Errorf("the containers of the task need %d CPU units and %d MiB of memory, which is more than the largest Fargate task size", cpuUnits, memoryMiB)
+}
+
+func (s fargateTaskSize) supports(cpuUnits, memoryMiB int64) bool {
+	return s.cpu == cpuUnits &&
+		memoryMiB >= s.minMemory &&
+		memoryMiB <= s.maxMemory &&
+		(memoryMiB-s.minMemory)%s.increment == 0
+}
+
+// describeFargateMemory lists the memory values Fargate supports for a number
+// of CPU units, or the supported CPU values if there are none
+func describeFargateMemory(cpuUnits int64) string {
+	ranges := []string{}
+	for _, size := range fargateTaskSizes {
+		if size.cpu != cpuUnits {
+			continue
+		}
+		if size.minMemory == size.maxMemory {
+			ranges = append(ranges, strconv.FormatInt(size.minMemory, 10))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d to %d in increments of %d", size.minMemory, size.maxMemory, size.increment))
+		}
+	}
+	if len(ranges) > 0 {
+		return fmt.Sprintf("a cpu_limit of %d supports a mem_limit of %s MiB", cpuUnits, strings.Join(ranges, " or "))
+	}
+
+	cpus := []string{}
+	for _, size := range fargateTaskSizes {
+		cpu := strconv.FormatInt(size.cpu, 10)
+		if len(cpus) == 0 || cpus[len(cpus)-1] != cpu {
+			cpus = append(cpus, cpu)
+		}
+	}
+	return fmt.Sprintf("cpu_limit must be one of %s", strings.Join(cpus, ", "))
+}
+
+// parseTaskCPU returns the CPU units of a task level cpu value, which is
+// either a number of CPU units or a number of vCPUs such as "0.5 vCPU"
+func parseTaskCPU(cpu string) (int64, error) {
+	value := strings.ToLower(strings.TrimSpace(cpu))
+	if strings.HasSuffix(value, "vcpu") {
+		vcpus, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(value, "vcpu")), 64)
+		if err != nil {
+			return 0, errors.Errorf("unable to parse task_size cpu_limit '%s'", cpu)
+		}
+		return int64(vcpus * cpuUnitsPerCPU), nil
+	}
+	units, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("unable to parse task_size cpu_limit '%s'", cpu)
+	}
+	return units, nil
+}
+
+// parseTaskMemory returns the MiB of a task level memory value, which is
+// either a number of MiB or a number of GB such as "0.5GB"
+func parseTaskMemory(memory string) (int64, error) {
+	value := strings.ToLower(strings.TrimSpace(memory))
+	if strings.HasSuffix(value, "gb") {
+		gib, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(value, "gb")), 64)
+		if err != nil {
+			return 0, errors.Errorf("unable to parse task_size mem_limit '%s'", memory)
+		}
+		return int64(gib * mibPerGiB), nil
+	}
+	mib, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("unable to parse task_size mem_limit '%s'", memory)
+	}
+	return mib, nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestValidateFargateTaskSize(t *testing.T) {
+	valid := [][2]string{
+		{"256", "512"},
+		{"256", "2048"},
+		{"0.25 vCPU", "0.5GB"},
+		{"512", "3GB"},
+		{"1 vcpu", "8192"},
+		{"4096", "30720"},
+		{"8192", "16GB"},
+		{"16384", "122880"},
+	}
+	for _, size := range valid {
+		assert.NoError(t, ValidateFargateTaskSize(size[0], size[1]), "Expected cpu %s and memory %s to be valid", size[0], size[1])
+	}
+
+	invalid := [][2]string{
+		{"256", "4096"},
+		{"512", "512"},
+		{"1024", "2500"},
+		{"200", "512"},
+		{"8192", "18432"},
+		{"256", ""},
+		{"", "512"},
+		{"one", "512"},
+		{"256", "10MB"},
+	}
+	for _, size := range invalid {
+		assert.Error(t, ValidateFargateTaskSize(size[0], size[1]), "Expected cpu %s and memory %s to be invalid", size[0], size[1])
+	}
+}
+
+func TestValidateFargateTaskSize_ErrorDescribesValidMemory(t *testing.T) {
+	err := ValidateFargateTaskSize("512", "5GB")
+	if assert.Error(t, err) {
+		assert.Equal(t, "task_size cpu_limit 512 and mem_limit 5GB are not a valid Fargate combination; a cpu_limit of 512 supports a mem_limit of 1024 to 4096 in increments of 1024 MiB", err.Error())
+	}
+
+	err = ValidateFargateTaskSize("300", "1GB")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "cpu_limit must be one of 256, 512, 1024, 2048, 4096, 8192, 16384")
+	}
+}
+
+func TestResolveAutoTaskSize(t *testing.T) {
+	testCases := []struct {
+		containerDefs  []*ecs.ContainerDefinition
+		expectedCPU    string
+		expectedMemory string
+	}{
+		{
+			containerDefs:  []*ecs.ContainerDefinition{{Memory: aws.Int64(512)}},
+			expectedCPU:    "256",
+			expectedMemory: "512",
+		},
+		{
+			containerDefs: []*ecs.ContainerDefinition{
+				{Cpu: aws.Int64(128), Memory: aws.Int64(512)},
+				{Cpu: aws.Int64(128), MemoryReservation: aws.Int64(256)},
+			},
+			expectedCPU:    "256",
+			expectedMemory: "1024",
+		},
+		{
+			containerDefs: []*ecs.ContainerDefinition{
+				{Cpu: aws.Int64(256), Memory: aws.Int64(1024)},
+				{Cpu: aws.Int64(10), Memory: aws.Int64(128)},
+			},
+			expectedCPU:    "512",
+			expectedMemory: "2048",
+		},
+		{
+			containerDefs:  []*ecs.ContainerDefinition{{Cpu: aws.Int64(256), Memory: aws.Int64(3000)}},
+			expectedCPU:    "512",
+			expectedMemory: "3072",
+		},
+		{
+			containerDefs:  []*ecs.ContainerDefinition{{Cpu: aws.Int64(5000), Memory: aws.Int64(1024)}},
+			expectedCPU:    "8192",
+			expectedMemory: "16384",
+		},
+	}
+
+	for _, testCase := range testCases {
+		cpu, memory, err := resolveAutoTaskSize(testCase.containerDefs)
+		if assert.NoError(t, err) {
+			assert.Equal(t, testCase.expectedCPU, cpu, "Expected CPU to match")
+			assert.Equal(t, testCase.expectedMemory, memory, "Expected memory to match")
+			assert.NoError(t, ValidateFargateTaskSize(cpu, memory), "Expected automatic task size to be valid")
+		}
+	}
+}
+
+func TestResolveAutoTaskSize_ErrorWhenTooLarge(t *testing.T) {
+	_, _, err := resolveAutoTaskSize([]*ecs.ContainerDefinition{{Cpu: aws.Int64(1024), Memory: aws.Int64(200000)}})
+	assert.Error(t, err, "Expected error when the containers need more memory than Fargate supports")
+}
+
+func TestTaskSizeYAML(t *testing.T) {
+	taskDef := EcsTaskDef{}
+	err := yaml.Unmarshal([]byte("task_size: auto\n"), &taskDef)
+	if assert.NoError(t, err) {
+		assert.Equal(t, TaskSize{Auto: true}, taskDef.TaskSize)
+	}
+	data, err := yaml.Marshal(taskDef)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "task_size: auto\n", string(data))
+	}
+
+	err = yaml.Unmarshal([]byte("task_size: large\n"), &taskDef)
+	assert.Error(t, err, "Expected error when task_size is a string other than auto")
+}
@@ -0,0 +1,220 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"strconv"
+	"strings"
+)
+
+// The vendored YAML library does not expose node positions, so the ecs-params
+// validator locates keys by scanning the document line by line. Only block
+// style collections are indexed; a node that is written in flow style
+// ([a, b] or {a: b}) is reported at the position of its closest indexed
+// ancestor.
+
+// yamlPath is the list of keys and sequence indexes leading to a node.
+// Sequence indexes are stored as "[i]".
+type yamlPath []string
+
+func (p yamlPath) key(name string) yamlPath {
+	return append(p[:len(p):len(p)], name)
+}
+
+func (p yamlPath) index(i int) yamlPath {
+	return append(p[:len(p):len(p)], "["+strconv.Itoa(i)+"]")
+}
+
+// String returns the path as it would be written in documentation, for
+// example run_params.task_placement.strategy[0].type
+func (p yamlPath) String() string {
+	return strings.Replace(strings.Join(p, "."), ".[", "[", -1)
+}
+
+// yamlPosition is the 1-based line and column of a mapping key or sequence
+// item. valueColumn is set when a scalar value starts on the same line.
+type yamlPosition struct {
+	line        int
+	column      int
+	valueColumn int
+}
+
+// yamlPositions maps the String() form of a yamlPath to its position
+type yamlPositions map[string]yamlPosition
+
+// find returns the position of the node at path, or of its closest indexed
+// ancestor. ok is false if no ancestor was indexed.
+func (p yamlPositions) find(path yamlPath) (position yamlPosition, exact bool, ok bool) {
+	for i := len(path); i > 0; i-- {
+		if position, ok := p[path[:i].String()]; ok {
+			return position, i == len(path), true
+		}
+	}
+	return yamlPosition{}, false, false
+}
+
+type yamlFrame struct {
+	indent   int
+	path     yamlPath
+	sequence bool
+	items    int
+}
+
+type yamlScanner struct {
+	positions yamlPositions
+	stack     []*yamlFrame
+	// pending is set when the last key or sequence item had no value on its
+	// own line, so the next more indented line starts a nested collection
+	pending *yamlFrame
+	// blockIndent is the indentation of a key whose value is a literal or
+	// folded block scalar; more indented lines belong to the scalar
+	blockIndent int
+	line        int
+}
+
+// locateYAMLNodes returns the positions of the keys and sequence items in a
+// block style YAML document.
+func locateYAMLNodes(data []byte) yamlPositions {
+	scanner := &yamlScanner{
+		positions:   make(yamlPositions),
+		stack:       []*yamlFrame{{indent: -1}},
+		blockIndent: -1,
+	}
+	for i, line := range strings.Split(string(data), "\n") {
+		scanner.line = i + 1
+		line = strings.TrimRight(line, " \t\r")
+		content := strings.TrimLeft(line, " ")
+		indent := len(line) - len(content)
+
+		if scanner.blockIndent >= 0 {
+			if content == "" || indent > scanner.blockIndent {
+				continue
+			}
+			scanner.blockIndent = -1
+		}
+		if content == "" || strings.HasPrefix(content, "#") || strings.HasPrefix(content, "%") ||
+			content == "---" || content == "..." {
+			continue
+		}
+		scanner.scan(content, indent)
+	}
+	return scanner.positions
+}
+
+func (s *yamlScanner) top() *yamlFrame {
+	return s.stack[len(s.stack)-1]
+}
+
+// scan indexes the node that starts at the given indentation
+func (s *yamlScanner) scan(content string, indent int) {
+	if content == "-" || strings.HasPrefix(content, "- ") {
+		s.scanSequenceItem(content, indent)
+		return
+	}
+
+	key, value, valueOffset, isKey := parseYAMLKey(content)
+	if !isKey {
+		s.pending = nil
+		return
+	}
+
+	if s.pending != nil && indent > s.pending.indent {
+		s.push(&yamlFrame{indent: indent, path: s.pending.path})
+	} else {
+		for s.top().indent > indent || (s.top().sequence && s.top().indent >= indent) {
+			s.stack = s.stack[:len(s.stack)-1]
+		}
+	}
+	s.pending = nil
+
+	path := s.top().path.key(key)
+	position := yamlPosition{line: s.line, column: indent + 1}
+	switch {
+	case value == "" || strings.HasPrefix(value, "#"):
+		s.pending = &yamlFrame{indent: indent, path: path}
+	case strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">"):
+		s.blockIndent = indent
+	default:
+		position.valueColumn = indent + valueOffset + 1
+	}
+	s.positions[path.String()] = position
+}
+
+func (s *yamlScanner) scanSequenceItem(content string, indent int) {
+	var frame *yamlFrame
+	if s.pending != nil && indent >= s.pending.indent {
+		frame = &yamlFrame{indent: indent, path: s.pending.path, sequence: true}
+		s.push(frame)
+	} else {
+		for s.top().indent > indent {
+			s.stack = s.stack[:len(s.stack)-1]
+		}
+		if !s.top().sequence || s.top().indent != indent {
+			// not valid YAML; yaml.Unmarshal will report it
+			s.pending = nil
+			return
+		}
+		frame = s.top()
+		frame.items++
+	}
+
+	path := frame.path.index(frame.items)
+	rest := strings.TrimLeft(content[1:], " ")
+	restIndent := indent + len(content) - len(rest)
+	s.positions[path.String()] = yamlPosition{line: s.line, column: restIndent + 1, valueColumn: restIndent + 1}
+
+	s.pending = &yamlFrame{indent: indent, path: path}
+	if rest != "" && !strings.HasPrefix(rest, "#") {
+		s.scan(rest, restIndent)
+	}
+}
+
+func (s *yamlScanner) push(frame *yamlFrame) {
+	s.stack = append(s.stack, frame)
+}
+
+// parseYAMLKey splits a "key: value" line. valueOffset is the offset of the
+// value from the start of content.
+func parseYAMLKey(content string) (key, value string, valueOffset int, ok bool) {
+	end := -1
+	if content[0] == '"' || content[0] == '\'' {
+		closing := strings.IndexByte(content[1:], content[0])
+		if closing < 0 {
+			return "", "", 0, false
+		}
+		key = content[1 : closing+1]
+		end = closing + 2
+		if end >= len(content) || content[end] != ':' {
+			return "", "", 0, false
+		}
+	} else {
+		if content[0] == '[' || content[0] == '{' {
+			return "", "", 0, false
+		}
+		for i := 0; i < len(content); i++ {
+			if content[i] == ':' && (i+1 == len(content) || content[i+1] == ' ') {
+				end = i
+				break
+			}
+		}
+		if end <= 0 {
+			return "", "", 0, false
+		}
+		key = strings.TrimSpace(content[:end])
+	}
+
+	rest := content[end+1:]
+	value = strings.TrimLeft(rest, " ")
+	return key, value, len(content) - len(value), true
+}
@@ -0,0 +1,99 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/docker/cli/cli/compose/template"
+)
+
+// variablePattern is the pattern used by template.Substitute for compose
+// files. template.SubstituteWith reads the names of the capture groups from
+// its own pattern, so this must keep the same groups in the same order.
+var variablePattern = regexp.MustCompile(
+	`\$(?i:(?P<escaped>\$)|(?P<named>[_a-z][_a-z0-9]*(?::?[-?][^}]*)?)|{(?P<braced>[_a-z][_a-z0-9]*(?::?[-?][^}]*)?)}|(?P<invalid>))`,
+)
+
+// InterpolateVariables substitutes variables in value with the same rules as
+// compose files: $VAR and ${VAR} are replaced with the value of VAR,
+// ${VAR:-default} and ${VAR-default} fall back to default when VAR is unset
+// or empty (respectively unset), ${VAR:?message} and ${VAR?message} fail with
+// message when VAR is unset or empty (respectively unset), and $$ is a
+// literal $. Unlike compose files, a plain reference to a variable that is not
+// set is an error rather than an empty string.
+func InterpolateVariables(value string, mapping template.Mapping) (string, error) {
+	var substitutionErr error
+	recordError := func(substitute template.SubstituteFunc) template.SubstituteFunc {
+		return func(substitution string, mapping template.Mapping) (string, bool, error) {
+			value, applied, err := substitute(substitution, mapping)
+			if err != nil && substitutionErr == nil {
+				substitutionErr = err
+			}
+			return value, applied, err
+		}
+	}
+
+	substituteFuncs := make([]template.SubstituteFunc, 0, len(template.DefaultSubstituteFuncs)+1)
+	for _, substitute := range template.DefaultSubstituteFuncs {
+		substituteFuncs = append(substituteFuncs, recordError(substitute))
+	}
+	substituteFuncs = append(substituteFuncs, recordError(requireSet))
+
+	// SubstituteWith only returns the error of the last substitution in value
+	result, err := template.SubstituteWith(value, mapping, variablePattern, substituteFuncs...)
+	if substitutionErr != nil {
+		err = substitutionErr
+	}
+	if err != nil {
+		if templateErr, ok := err.(*template.InvalidTemplateError); ok {
+			if strings.HasPrefix(templateErr.Template, "required variable") {
+				return "", fmt.Errorf("%s", templateErr.Template)
+			}
+			return "", fmt.Errorf("invalid interpolation format in %q; use $$ for a literal $", templateErr.Template)
+		}
+		return "", err
+	}
+	return result, nil
+}
+
+// InterpolateFileVariables runs InterpolateVariables on each line of a YAML
+// file, so that errors can be reported with the line they occur on. Comment
+// lines are left as they are.
+func InterpolateFileVariables(filename string, data []byte, mapping template.Mapping) ([]byte, error) {
+	lines := strings.Split(string(data), "\n")
+	for i, line := range lines {
+		if !strings.Contains(line, "$") || strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		interpolated, err := InterpolateVariables(line, mapping)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", filename, i+1, err)
+		}
+		lines[i] = interpolated
+	}
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// requireSet is applied after the default substitutions, to references
+// without a default value or an error message
+func requireSet(name string, mapping template.Mapping) (string, bool, error) {
+	value, ok := mapping(name)
+	if !ok {
+		return "", true, fmt.Errorf("variable %s is not set; use ${%s:-default} to provide a default value", name, name)
+	}
+	return value, true, nil
+}
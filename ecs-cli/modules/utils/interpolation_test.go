@@ -0,0 +1,98 @@
+// Copyright 2015-2018 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testMapping(name string) (string, bool) {
+	values := map[string]string{
+		"SUBNET": "subnet-1",
+		"EMPTY":  "",
+	}
+	value, ok := values[name]
+	return value, ok
+}
+
+func TestInterpolateVariables(t *testing.T) {
+	testCases := map[string]string{
+		"no variables":                    "no variables",
+		"$SUBNET":                         "subnet-1",
+		"${SUBNET}-b":                     "subnet-1-b",
+		"${UNSET:-subnet-2}":              "subnet-2",
+		"${EMPTY:-subnet-2}":              "subnet-2",
+		"${EMPTY-subnet-2}":               "",
+		"${UNSET-subnet-2}":               "subnet-2",
+		"${SUBNET:?required}":             "subnet-1",
+		"${EMPTY?required}":               "",
+		"c00l$$tuff":                      "c00l$tuff",
+		"${SUBNET} and ${UNSET:-default}": "subnet-1 and default",
+	}
+
+	for input, expected := range testCases {
+		actual, err := InterpolateVariables(input, testMapping)
+		if assert.NoError(t, err, "Unexpected error interpolating %s", input) {
+			assert.Equal(t, expected, actual, "Unexpected result interpolating %s", input)
+		}
+	}
+}
+
+func TestInterpolateVariables_Errors(t *testing.T) {
+	testCases := map[string]string{
+		"${UNSET}":                 "variable UNSET is not set; use ${UNSET:-default} to provide a default value",
+		"$UNSET and ${SUBNET}":     "variable UNSET is not set; use ${UNSET:-default} to provide a default value",
+		"${UNSET:?set the subnet}": "required variable UNSET is missing a value: set the subnet",
+		"${EMPTY:?set the subnet}": "required variable EMPTY is missing a value: set the subnet",
+		"${UNSET?set the subnet}":  "required variable UNSET is missing a value: set the subnet",
+		"cost: $5":                 `invalid interpolation format in "cost: $5"; use $$ for a literal $`,
+	}
+
+	for input, expected := range testCases {
+		_, err := InterpolateVariables(input, testMapping)
+		if assert.Error(t, err, "Expected error interpolating %s", input) {
+			assert.Equal(t, expected, err.Error(), "Unexpected error interpolating %s", input)
+		}
+	}
+}
+
+func TestInterpolateFileVariables(t *testing.T) {
+	data := `# uses $SUBNET
+subnets:
+  - ${SUBNET}
+  - ${UNSET:-subnet-2}`
+
+	expected := `# uses $SUBNET
+subnets:
+  - subnet-1
+  - subnet-2`
+
+	actual, err := InterpolateFileVariables("ecs-params.yml", []byte(data), testMapping)
+	if assert.NoError(t, err) {
+		assert.Equal(t, expected, string(actual))
+	}
+}
+
+func TestInterpolateFileVariables_ErrorIncludesLine(t *testing.T) {
+	data := `subnets:
+  - ${SUBNET}
+  - ${SECOND_SUBNET:?the second subnet is required}`
+
+	_, err := InterpolateFileVariables("ecs-params.yml", []byte(data), testMapping)
+	if assert.Error(t, err) {
+		assert.Equal(t, "ecs-params.yml:3: required variable SECOND_SUBNET is missing a value: the second subnet is required", err.Error())
+	}
+}
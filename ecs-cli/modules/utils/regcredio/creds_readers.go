@@ -20,6 +20,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/docker/cli/cli/compose/template"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
@@ -38,9 +40,16 @@ func ReadCredsInput(filename string) (*ECSRegCredsInput, error) {
 		return nil, errors.Wrapf(err, "Error unmarshalling yaml data from credential input file: %s", filename)
 	}
 
+	mapping, err := utils.GetDefaultEnvironmentMapping()
+	if err != nil {
+		return nil, err
+	}
 	expandedCredsInput := RegistryCreds{}
 	for regName, credEntry := range credsInput.RegistryCredentials {
-		expandedCredEntry := expandCredEntry(credEntry)
+		expandedCredEntry, err := expandCredEntry(credEntry, mapping)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error interpolating environment variables for registry %s in credential input file: %s", regName, filename)
+		}
 		expandedCredsInput[regName] = expandedCredEntry
 	}
 
@@ -114,28 +123,34 @@ func getTimeFromCredOutputFile(filename string) time.Time {
 }
 
 // expandCredEntry checks if individual fields are env vars and if so, retrieves & sets that value
-func expandCredEntry(credEntry RegistryCredEntry) RegistryCredEntry {
-	expandedSecretARN := getValueOrEnvVar(credEntry.SecretManagerARN)
-	expandedUsername := getValueOrEnvVar(credEntry.Username)
-	expandedPassword := getValueOrEnvVar(credEntry.Password)
-	expandedKmsKeyID := getValueOrEnvVar(credEntry.KmsKeyID)
+func expandCredEntry(credEntry RegistryCredEntry, mapping template.Mapping) (RegistryCredEntry, error) {
+	fields := []struct {
+		name  string
+		value *string
+	}{
+		{"secrets_manager_arn", &credEntry.SecretManagerARN},
+		{"username", &credEntry.Username},
+		{"password", &credEntry.Password},
+		{"kms_key_id", &credEntry.KmsKeyID},
+	}
 	//TODO: look for env vars in container names?
-
-	expandedCredEntry := RegistryCredEntry{
-		SecretManagerARN: expandedSecretARN,
-		Username:         expandedUsername,
-		Password:         expandedPassword,
-		KmsKeyID:         expandedKmsKeyID,
-		ContainerNames:   credEntry.ContainerNames,
+	for _, field := range fields {
+		expanded, err := getValueOrEnvVar(*field.value, mapping)
+		if err != nil {
+			return credEntry, errors.Wrapf(err, "invalid value for %s", field.name)
+		}
+		*field.value = expanded
 	}
-	return expandedCredEntry
+	return credEntry, nil
 }
 
-// selectively runs ExpandEnv() to avoid indescriminant replacement of substrings with '$'
+// selectively interpolates values to avoid indescriminant replacement of substrings with '$'
 // e.g., password='c00l$tuff2018' -> return same; password='${MY_PASSWORD}' -> return env value.
-func getValueOrEnvVar(s string) string {
+// Values of the form ${...} follow the same rules as compose files, including
+// ${VAR:-default} and ${VAR:?message}, and variables set in a '.env' file.
+func getValueOrEnvVar(s string, mapping template.Mapping) (string, error) {
 	if strings.HasPrefix(s, "${") && strings.HasSuffix(s, "}") {
-		return os.ExpandEnv(s)
+		return utils.InterpolateVariables(s, mapping)
 	}
-	return s
+	return s, nil
 }
@@ -16,6 +16,7 @@ package regcredio
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -261,3 +262,90 @@ func TestFindLatestRegCredsOutputFile(t *testing.T) {
 		})
 	}
 }
+
+func TestReadCredsInputWithEnvVarDefaultsAndRequiredValues(t *testing.T) {
+	os.Setenv("MY_REG_USRNAME", "myname@example.net")
+	defer os.Unsetenv("MY_REG_USRNAME")
+
+	inputFileString := `version: 1
+registry_credentials:
+  myrepo.someregistry.io:
+    username: ${MY_REG_USRNAME:?username is required}
+    password: c00l$tuff
+    kms_key_id: ${MY_KEY_ARN:-aws:arn:kms:key/default}
+    container_names:
+      - test`
+
+	tmpfile, err := ioutil.TempFile("", "test")
+	assert.NoError(t, err, "Unexpected error in creating test file")
+	defer os.Remove(tmpfile.Name())
+
+	_, err = tmpfile.Write([]byte(inputFileString))
+	assert.NoError(t, err, "Unexpected error writing file")
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Unexpected error closing file")
+
+	credsResult, err := ReadCredsInput(tmpfile.Name())
+	assert.NoError(t, err, "Unexpected error reading file")
+
+	credEntry := credsResult.RegistryCredentials["myrepo.someregistry.io"]
+	assert.Equal(t, "myname@example.net", credEntry.Username)
+	assert.Equal(t, "c00l$tuff", credEntry.Password)
+	assert.Equal(t, "aws:arn:kms:key/default", credEntry.KmsKeyID)
+}
+
+func TestReadCredsInputWithEnvFile(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "test")
+	assert.NoError(t, err, "Unexpected error creating the temporary directory")
+	defer os.RemoveAll(tempDirName)
+	wd, err := os.Getwd()
+	assert.NoError(t, err, "Unexpected error getting the working directory")
+	defer os.Chdir(wd)
+	assert.NoError(t, os.Chdir(tempDirName), "Unexpected error changing the working directory")
+
+	envFileString := `MY_ENVFILE_REG_USRNAME=envfile@example.net
+MY_ENVFILE_REG_PASSWORD=p4$$w0rd`
+	err = ioutil.WriteFile(filepath.Join(tempDirName, ".env"), []byte(envFileString), 0644)
+	assert.NoError(t, err, "Unexpected error writing .env file")
+
+	inputFileString := `version: 1
+registry_credentials:
+  myrepo.someregistry.io:
+    username: ${MY_ENVFILE_REG_USRNAME}
+    password: ${MY_ENVFILE_REG_PASSWORD}
+    container_names:
+      - test`
+	inputFileName := filepath.Join(tempDirName, "creds.yml")
+	err = ioutil.WriteFile(inputFileName, []byte(inputFileString), 0644)
+	assert.NoError(t, err, "Unexpected error writing file")
+
+	credsResult, err := ReadCredsInput(inputFileName)
+	assert.NoError(t, err, "Unexpected error reading file")
+
+	credEntry := credsResult.RegistryCredentials["myrepo.someregistry.io"]
+	assert.Equal(t, "envfile@example.net", credEntry.Username)
+	assert.Equal(t, "p4$$w0rd", credEntry.Password)
+}
+
+func TestReadCredsInput_ErrorUnsetEnvVar(t *testing.T) {
+	inputFileString := `version: 1
+registry_credentials:
+  myrepo.someregistry.io:
+    secrets_manager_arn: ${MY_UNSET_SECRET_ARN}
+    container_names:
+      - test`
+
+	tmpfile, err := ioutil.TempFile("", "test")
+	assert.NoError(t, err, "Unexpected error in creating test file")
+	defer os.Remove(tmpfile.Name())
+
+	_, err = tmpfile.Write([]byte(inputFileString))
+	assert.NoError(t, err, "Unexpected error writing file")
+	err = tmpfile.Close()
+	assert.NoError(t, err, "Unexpected error closing file")
+
+	_, err = ReadCredsInput(tmpfile.Name())
+	if assert.Error(t, err, "Expected error when an env var is not set") {
+		assert.Contains(t, err.Error(), "invalid value for secrets_manager_arn: variable MY_UNSET_SECRET_ARN is not set")
+	}
+}
@@ -13,16 +13,27 @@
 
 package utils
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // Sleeper interface implements the sleep() method. Useful for testing Wait* methods.
 type Sleeper interface {
-	Sleep(time.Duration)
+	Sleep(ctx context.Context, d time.Duration) error
 }
 
-// timeSleeper implements sleeper interface by calling the time.Sleep method for sleeping.
+// timeSleeper implements sleeper interface by waiting on a timer, or until the context is done.
 type TimeSleeper struct{}
 
-func (t *TimeSleeper) Sleep(d time.Duration) {
-	time.Sleep(d)
+// Sleep waits for d, or returns the error of ctx if it is done first
+func (t *TimeSleeper) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
@@ -14,42 +14,49 @@
 package waiters
 
 import (
-	"fmt"
+	"context"
+	"math/rand"
 	"time"
 
 	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/pkg/errors"
 )
 
 const (
-	// tasksWaitDelay is the delay between successive ECS DescribeTasks API calls
-	// while determining if the task is running or stopped was created. This value
-	// reflects the values set in the ecs waiters json file in the aws-go-sdk.
-	tasksWaitDelay = 6 * time.Second
-
-	// tasksMaxRetries is the maximum number of ECS DescribeTasks API will be invoked by the WaitUntilComplete method
-	// to determine if the task is running or stopped before giving up. This value reflects the values set in the
-	// ecs waiters json file in the aws-go-sdk.
-	tasksMaxRetries = 100
-
-	// servicesWaitDelay is the delay between successive ECS DescribeServices API calls
-	// while determining if the service is stable or inactive. This value
-	// reflects the values set in the ecs waiters json file in the aws-go-sdk.
-	servicesWaitDelay = 15 * time.Second
-
-	// servicesMaxRetries is the maximum number of ECS DescribeServices API will be invoked by the WaitUntilComplete method
-	// to determine if the task is running or stopped before giving up. This value reflects the values set in the
-	// ecs waiters json file in the aws-go-sdk.
-	servicesMaxRetries = 40
+	// tasksMinWaitDelay and tasksMaxWaitDelay bound the delay between successive ECS DescribeTasks
+	// API calls while determining if the task is running or stopped. The delay doubles from the
+	// minimum after every call, up to the maximum.
+	tasksMinWaitDelay = 2 * time.Second
+	tasksMaxWaitDelay = 15 * time.Second
+
+	// tasksWaitTimeout is how long the TaskWaitUntilTimeout method sleeps between attempts while waiting
+	// for the task to be running or stopped before giving up.
+	tasksWaitTimeout = 10 * time.Minute
+
+	// servicesMinWaitDelay and servicesMaxWaitDelay bound the delay between successive ECS
+	// DescribeServices API calls while determining if the service is stable or inactive.
+	servicesMinWaitDelay = 5 * time.Second
+	servicesMaxWaitDelay = 30 * time.Second
 )
 
+// make the jitter of the delays easily mockable in tests
+var jitter = rand.Int63n
+
 // waiterAction defines an action performed on the project entity
 // and returns a bool to stop the wait or error if something unexpected happens
 type waiterAction func(retryCount int) (bool, error)
 
-// ServiceWaitUntilComplete runs the action in a for true loop, until the action returns true or an error
-func ServiceWaitUntilComplete(action waiterAction, entity entity.ProjectEntity) error {
-	// run the loop until done or error thrown
+// ServiceWaitUntilComplete runs the action until it returns true or an error, or until ctx is done
+// or the wait is interrupted
+func ServiceWaitUntilComplete(ctx context.Context, action waiterAction, entity entity.ProjectEntity) error {
+	ctx, cancel := utils.WithInterrupt(ctx)
+	defer cancel()
+
 	for retryCount := 0; true; retryCount++ {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "Stopped waiting for the service")
+		}
 		done, err := action(retryCount)
 		if err != nil {
 			return err
@@ -57,17 +64,53 @@ func ServiceWaitUntilComplete(action waiterAction, entity entity.ProjectEntity)
 		if done {
 			return nil
 		}
-		entity.Sleeper().Sleep(servicesWaitDelay)
+		if err := entity.Sleeper().Sleep(ctx, backoff(retryCount, servicesMinWaitDelay, servicesMaxWaitDelay)); err != nil {
+			return errors.Wrap(err, "Stopped waiting for the service")
+		}
 	}
 
 	return nil
+}
 
+// TaskWaitUntilComplete runs the action until it returns true or an error, or until ctx is done
+// or the wait is interrupted.
+// Unlike TaskWaitUntilTimeout, it waits for as long as the task runs.
+func TaskWaitUntilComplete(ctx context.Context, action waiterAction, entity entity.ProjectEntity) error {
+	ctx, cancel := utils.WithInterrupt(ctx)
+	defer cancel()
+
+	for retryCount := 0; true; retryCount++ {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "Stopped waiting for the task")
+		}
+		done, err := action(retryCount)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		if err := entity.Sleeper().Sleep(ctx, backoff(retryCount, tasksMinWaitDelay, tasksMaxWaitDelay)); err != nil {
+			return errors.Wrap(err, "Stopped waiting for the task")
+		}
+	}
+
+	return nil
 }
 
-// WaitUntilTimeout executes the waiterAction for maxRetries number of times, waiting for delayWait time between execution
-func TaskWaitUntilTimeout(action waiterAction, entity entity.ProjectEntity, timeoutMessage string) error {
+// TaskWaitUntilTimeout runs the action until it returns true or an error, or until ctx is done
+// or the wait is interrupted.
+// It returns an error with timeoutMessage once it has slept for tasksWaitTimeout between attempts.
+func TaskWaitUntilTimeout(ctx context.Context, action waiterAction, entity entity.ProjectEntity, timeoutMessage string) error {
+	ctx, cancel := utils.WithInterrupt(ctx)
+	defer cancel()
 
-	for retryCount := 0; retryCount < tasksMaxRetries; retryCount++ {
+	// bound the wait by the time slept rather than by the clock, so that it does not depend on how long the action takes
+	var waited time.Duration
+	for retryCount := 0; waited < tasksWaitTimeout; retryCount++ {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "Stopped waiting for the tasks")
+		}
 		done, err := action(retryCount)
 		if err != nil {
 			return err
@@ -75,8 +118,27 @@ func TaskWaitUntilTimeout(action waiterAction, entity entity.ProjectEntity, time
 		if done {
 			return nil
 		}
-		entity.Sleeper().Sleep(tasksWaitDelay)
+		delay := backoff(retryCount, tasksMinWaitDelay, tasksMaxWaitDelay)
+		if err := entity.Sleeper().Sleep(ctx, delay); err != nil {
+			return errors.Wrap(err, "Stopped waiting for the tasks")
+		}
+		waited += delay
 	}
 
-	return fmt.Errorf(timeoutMessage)
+	return errors.New(timeoutMessage)
+}
+
+// backoff returns the delay before the next attempt after retryCount attempts. The delay
+// doubles from minDelay after every attempt, up to maxDelay, and is randomized by up to
+// half of it so that concurrent waits do not poll in lockstep.
+func backoff(retryCount int, minDelay, maxDelay time.Duration) time.Duration {
+	delay := minDelay
+	for i := 0; i < retryCount && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	half := delay / 2
+	return half + time.Duration(jitter(int64(half)+1))
 }
@@ -0,0 +1,133 @@
+// Copyright 2015-2019 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package waiters
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/cli/compose/entity/mock"
+	"github.com/aws/amazon-ecs-cli/ecs-cli/modules/utils"
+	"github.com/golang/mock/gomock"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockSleeper records the time it was asked to sleep without sleeping
+type mockSleeper struct {
+	slept time.Duration
+}
+
+func (s *mockSleeper) Sleep(ctx context.Context, d time.Duration) error {
+	s.slept += d
+	return ctx.Err()
+}
+
+func TestBackoff(t *testing.T) {
+	defaultJitter := jitter
+	defer func() { jitter = defaultJitter }()
+
+	// without jitter the delay is half of the backoff
+	jitter = func(n int64) int64 { return 0 }
+	assert.Equal(t, 1*time.Second, backoff(0, 2*time.Second, 15*time.Second))
+	assert.Equal(t, 2*time.Second, backoff(1, 2*time.Second, 15*time.Second))
+	assert.Equal(t, 4*time.Second, backoff(2, 2*time.Second, 15*time.Second))
+	assert.Equal(t, 7500*time.Millisecond, backoff(3, 2*time.Second, 15*time.Second))
+	assert.Equal(t, 7500*time.Millisecond, backoff(50, 2*time.Second, 15*time.Second))
+
+	// with the most jitter the delay is the whole backoff
+	jitter = func(n int64) int64 { return n - 1 }
+	assert.Equal(t, 2*time.Second, backoff(0, 2*time.Second, 15*time.Second))
+	assert.Equal(t, 15*time.Second, backoff(50, 2*time.Second, 15*time.Second))
+}
+
+func TestServiceWaitUntilComplete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEntity := mock_entity.NewMockProjectEntity(ctrl)
+
+	calls := 0
+	err := ServiceWaitUntilComplete(context.Background(), func(retryCount int) (bool, error) {
+		calls++
+		return true, nil
+	}, mockEntity)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestServiceWaitUntilCompleteActionError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEntity := mock_entity.NewMockProjectEntity(ctrl)
+
+	actionErr := errors.New("something failed")
+	err := ServiceWaitUntilComplete(context.Background(), func(retryCount int) (bool, error) {
+		return false, actionErr
+	}, mockEntity)
+	assert.Equal(t, actionErr, err)
+}
+
+func TestServiceWaitUntilCompleteStopsWhenContextIsCancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEntity := mock_entity.NewMockProjectEntity(ctrl)
+	mockEntity.EXPECT().Sleeper().Return(&utils.TimeSleeper{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := ServiceWaitUntilComplete(ctx, func(retryCount int) (bool, error) {
+		calls++
+		cancel()
+		return false, nil
+	}, mockEntity)
+	assert.Equal(t, context.Canceled, pkgerrors.Cause(err))
+	assert.True(t, utils.IsInterrupted(err))
+	assert.Equal(t, 1, calls)
+}
+
+func TestTaskWaitUntilTimeoutStopsWhenContextIsDone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEntity := mock_entity.NewMockProjectEntity(ctrl)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	<-ctx.Done()
+
+	err := TaskWaitUntilTimeout(ctx, func(retryCount int) (bool, error) {
+		t.Error("Expected the action not to run once the context is done")
+		return true, nil
+	}, mockEntity, "timeout")
+	assert.Equal(t, context.DeadlineExceeded, pkgerrors.Cause(err))
+}
+
+func TestTaskWaitUntilTimeoutTimesOut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEntity := mock_entity.NewMockProjectEntity(ctrl)
+	sleeper := &mockSleeper{}
+	mockEntity.EXPECT().Sleeper().Return(sleeper).AnyTimes()
+
+	calls := 0
+	err := TaskWaitUntilTimeout(context.Background(), func(retryCount int) (bool, error) {
+		calls++
+		return false, nil
+	}, mockEntity, "timeout")
+	assert.EqualError(t, err, "timeout")
+	assert.True(t, sleeper.slept >= tasksWaitTimeout, "Expected the wait to sleep for its timeout")
+	assert.True(t, sleeper.slept < tasksWaitTimeout+tasksMaxWaitDelay, "Expected the wait to stop once it has slept for its timeout")
+	assert.True(t, calls >= int(tasksWaitTimeout/tasksMaxWaitDelay), "Expected the action to run until the timeout")
+}